@@ -172,6 +172,100 @@ func GenerateMiniBlogModels(g *gen.Generator) {
 			return tag
 		}),
 	)
+	g.GenerateModelAs(
+		"user_token",
+		"UserTokenM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("tokenHash", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_user_token_tokenHash")
+			return tag
+		}),
+		gen.FieldGORMTag("userID", func(tag field.GormTag) field.GormTag {
+			tag.Set("index", "idx_user_token_userID_purpose,priority:1")
+			return tag
+		}),
+		gen.FieldGORMTag("purpose", func(tag field.GormTag) field.GormTag {
+			tag.Set("index", "idx_user_token_userID_purpose,priority:2")
+			return tag
+		}),
+	)
+	g.GenerateModelAs(
+		"user_session",
+		"UserSessionM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("sessionID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_user_session_sessionID")
+			return tag
+		}),
+		gen.FieldGORMTag("userID", func(tag field.GormTag) field.GormTag {
+			tag.Set("index", "idx_user_session_userID")
+			return tag
+		}),
+	)
+	g.GenerateModelAs(
+		"access_token",
+		"AccessTokenM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("tokenID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_access_token_tokenID")
+			return tag
+		}),
+		gen.FieldGORMTag("tokenHash", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_access_token_tokenHash")
+			return tag
+		}),
+		gen.FieldGORMTag("userID", func(tag field.GormTag) field.GormTag {
+			tag.Set("index", "idx_access_token_userID")
+			return tag
+		}),
+	)
+	g.GenerateModelAs(
+		"user_identity",
+		"UserIdentityM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("provider", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_user_identity_provider_subject,priority:1")
+			return tag
+		}),
+		gen.FieldGORMTag("subject", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_user_identity_provider_subject,priority:2")
+			return tag
+		}),
+		gen.FieldGORMTag("userID", func(tag field.GormTag) field.GormTag {
+			tag.Set("index", "idx_user_identity_userID")
+			return tag
+		}),
+	)
+	g.GenerateModelAs(
+		"refresh_token",
+		"RefreshTokenM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("tokenHash", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_refresh_token_tokenHash")
+			return tag
+		}),
+		gen.FieldGORMTag("familyID", func(tag field.GormTag) field.GormTag {
+			tag.Set("index", "idx_refresh_token_familyID")
+			return tag
+		}),
+		gen.FieldGORMTag("userID", func(tag field.GormTag) field.GormTag {
+			tag.Set("index", "idx_refresh_token_userID")
+			return tag
+		}),
+	)
+	g.GenerateModelAs(
+		"revoked_token",
+		"RevokedTokenM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("tokenID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_revoked_token_tokenID")
+			return tag
+		}),
+		gen.FieldGORMTag("expiresAt", func(tag field.GormTag) field.GormTag {
+			tag.Set("index", "idx_revoked_token_expiresAt")
+			return tag
+		}),
+	)
 	g.GenerateModelAs(
 		"post",
 		"PostM",
@@ -181,6 +275,167 @@ func GenerateMiniBlogModels(g *gen.Generator) {
 			return tag
 		}),
 	)
+	g.GenerateModelAs(
+		"post_reaction",
+		"PostReactionM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("postID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_reaction_postID_userID_reaction,priority:1")
+			return tag
+		}),
+		gen.FieldGORMTag("userID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_reaction_postID_userID_reaction,priority:2")
+			return tag
+		}),
+		gen.FieldGORMTag("reaction", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_reaction_postID_userID_reaction,priority:3")
+			return tag
+		}),
+	)
+	g.GenerateModelAs(
+		"post_reaction_count",
+		"PostReactionCountM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("postID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_reaction_count_postID_reaction,priority:1")
+			return tag
+		}),
+		gen.FieldGORMTag("reaction", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_reaction_count_postID_reaction,priority:2")
+			return tag
+		}),
+	)
+	g.GenerateModelAs(
+		"post_mention",
+		"PostMentionM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("postID", func(tag field.GormTag) field.GormTag {
+			tag.Set("index", "idx_post_mention_postID")
+			return tag
+		}),
+		gen.FieldGORMTag("userID", func(tag field.GormTag) field.GormTag {
+			tag.Set("index", "idx_post_mention_userID")
+			return tag
+		}),
+	)
+	g.GenerateModelAs(
+		"post_pin",
+		"PostPinM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("userID", func(tag field.GormTag) field.GormTag {
+			tag.Set("index", "idx_post_pin_userID")
+			return tag
+		}),
+		gen.FieldGORMTag("postID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_pin_postID")
+			return tag
+		}),
+	)
+	g.GenerateModelAs(
+		"post_feature",
+		"PostFeatureM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("postID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_feature_postID")
+			return tag
+		}),
+	)
+	g.GenerateModelAs(
+		"post_fingerprint",
+		"PostFingerprintM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("postID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_fingerprint_postID")
+			return tag
+		}),
+		gen.FieldGORMTag("userID", func(tag field.GormTag) field.GormTag {
+			tag.Set("index", "idx_post_fingerprint_userID_createdAt,priority:1")
+			return tag
+		}),
+		gen.FieldGORMTag("createdAt", func(tag field.GormTag) field.GormTag {
+			tag.Set("index", "idx_post_fingerprint_userID_createdAt,priority:2")
+			return tag
+		}),
+	)
+	g.GenerateModelAs(
+		"post_translation",
+		"PostTranslationM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("postID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_translation_postID_locale,priority:1")
+			return tag
+		}),
+		gen.FieldGORMTag("locale", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_translation_postID_locale,priority:2")
+			tag.Set("index", "idx_post_translation_locale")
+			return tag
+		}),
+	)
+	g.GenerateModelAs(
+		"post_template",
+		"PostTemplateM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("templateID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_template_templateID")
+			return tag
+		}),
+		gen.FieldGORMTag("userID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_template_userID_name,priority:1")
+			return tag
+		}),
+		gen.FieldGORMTag("name", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_template_userID_name,priority:2")
+			return tag
+		}),
+	)
+	g.GenerateModelAs(
+		"post_preview_link",
+		"PreviewLinkM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("linkID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_preview_link_linkID")
+			return tag
+		}),
+		gen.FieldGORMTag("postID", func(tag field.GormTag) field.GormTag {
+			tag.Set("index", "idx_post_preview_link_postID")
+			return tag
+		}),
+	)
+	g.GenerateModelAs(
+		"bookmark",
+		"BookmarkM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("userID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_bookmark_userID_postID,priority:1")
+			return tag
+		}),
+		gen.FieldGORMTag("postID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_bookmark_userID_postID,priority:2")
+			return tag
+		}),
+	)
+	g.GenerateModelAs(
+		"post_daily_stat",
+		"PostDailyStatM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("day", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_daily_stat_day_userID,priority:1")
+			return tag
+		}),
+		gen.FieldGORMTag("userID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_daily_stat_day_userID,priority:2")
+			return tag
+		}),
+	)
+	g.GenerateModelAs(
+		"site_daily_stat",
+		"SiteDailyStatM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("day", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_site_daily_stat_day")
+			return tag
+		}),
+	)
 	g.GenerateModelAs(
 		"casbin_rule",
 		"CasbinRuleM",
@@ -0,0 +1,82 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	"github.com/ra1n6ow/miniblog/cmd/mb-apiserver/app/options"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/site"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/store"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+)
+
+// newExportSiteCommand 创建 export-site 子命令，用于将博客导出为只读的静态站点.
+func newExportSiteCommand(opts *options.ServerOptions) *cobra.Command {
+	siteOpts := options.NewExportSiteOptions()
+
+	cmd := &cobra.Command{
+		Use:   "export-site",
+		Short: "Export all posts as a read-only static HTML site",
+		Long: `Export all posts as a read-only static HTML site.
+
+The generated site contains an index, per-author pages, per-post pages and an
+Atom feed. Posts are rendered with Go templates from the embedded default theme,
+which can be overridden file by file with --theme-dir. Only posts that changed
+since the previous export are re-rendered unless --full is given.`,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runExportSite(opts, siteOpts)
+		},
+		Args: cobra.NoArgs,
+	}
+
+	siteOpts.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+// runExportSite 连接数据库，并通过 PostStore 读取博客生成静态站点.
+func runExportSite(opts *options.ServerOptions, siteOpts *options.ExportSiteOptions) error {
+	log.Init(logOptions())
+	defer log.Sync()
+
+	// 复用服务器的数据库配置
+	if err := viper.Unmarshal(opts); err != nil {
+		return err
+	}
+
+	if err := utilerrors.NewAggregate(opts.MySQLOptions.Validate()); err != nil {
+		return err
+	}
+	if err := siteOpts.Validate(); err != nil {
+		return err
+	}
+
+	db, err := opts.MySQLOptions.NewDB()
+	if err != nil {
+		return err
+	}
+	ds := store.NewStore(db)
+
+	generator, err := site.New(siteOpts.Config(), ds.Post(), ds.User(), ds.Feature())
+	if err != nil {
+		return err
+	}
+
+	result, err := generator.Generate(context.Background())
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Exported %d posts to %s (%d rendered, %d removed)\n", result.Posts, siteOpts.OutputDir, result.Rendered, result.Removed)
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+package options
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/spf13/pflag"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/site"
+)
+
+// ExportSiteOptions 包含导出静态站点的配置选项.
+type ExportSiteOptions struct {
+	// OutputDir 定义静态站点的输出目录.
+	OutputDir string `json:"output-dir" mapstructure:"output-dir"`
+	// ThemeDir 定义自定义主题目录，为空时使用内置主题.
+	ThemeDir string `json:"theme-dir" mapstructure:"theme-dir"`
+	// BaseURL 定义站点的访问地址.
+	BaseURL string `json:"base-url" mapstructure:"base-url"`
+	// Title 定义站点标题.
+	Title string `json:"title" mapstructure:"title"`
+	// PageSize 定义列表页每页展示的博客数量.
+	PageSize int `json:"page-size" mapstructure:"page-size"`
+	// Full 定义是否忽略增量信息，全量重新生成站点.
+	Full bool `json:"full" mapstructure:"full"`
+}
+
+// NewExportSiteOptions 创建带有默认值的 ExportSiteOptions 实例.
+func NewExportSiteOptions() *ExportSiteOptions {
+	return &ExportSiteOptions{
+		OutputDir: "_output/site",
+		BaseURL:   "/",
+		Title:     "miniblog",
+		PageSize:  10,
+	}
+}
+
+// AddFlags 将 ExportSiteOptions 的选项绑定到命令行标志.
+func (o *ExportSiteOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.OutputDir, "output-dir", o.OutputDir, "Directory to write the generated static site to.")
+	fs.StringVar(&o.ThemeDir, "theme-dir", o.ThemeDir, "Directory of a custom theme. Templates in it override the embedded default theme.")
+	fs.StringVar(&o.BaseURL, "base-url", o.BaseURL, "Base URL the static site is served from, e.g. https://blog.example.com/.")
+	fs.StringVar(&o.Title, "title", o.Title, "Title of the static site.")
+	fs.IntVar(&o.PageSize, "page-size", o.PageSize, "Number of posts per page on list pages.")
+	fs.BoolVar(&o.Full, "full", o.Full, "Ignore the previous build and re-render every post.")
+}
+
+// Validate 校验 ExportSiteOptions 中的选项是否合法.
+func (o *ExportSiteOptions) Validate() error {
+	errs := []error{}
+
+	if o.OutputDir == "" {
+		errs = append(errs, errors.New("output-dir cannot be empty"))
+	}
+
+	if o.PageSize <= 0 {
+		errs = append(errs, errors.New("page-size must be greater than 0"))
+	}
+
+	if _, err := url.Parse(o.BaseURL); err != nil || o.BaseURL == "" {
+		errs = append(errs, errors.New("base-url must be a valid URL"))
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// Config 基于 ExportSiteOptions 构建静态站点生成配置.
+func (o *ExportSiteOptions) Config() *site.Config {
+	return &site.Config{
+		OutputDir: o.OutputDir,
+		ThemeDir:  o.ThemeDir,
+		BaseURL:   o.BaseURL,
+		Title:     o.Title,
+		PageSize:  o.PageSize,
+		Full:      o.Full,
+	}
+}
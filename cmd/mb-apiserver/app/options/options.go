@@ -8,6 +8,9 @@ package options
 import (
 	"errors"
 	"fmt"
+	"net/url"
+	"os"
+	"strings"
 	"time"
 
 	genericoptions "github.com/ra1n6ow/gpkg/options"
@@ -17,6 +20,16 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/ra1n6ow/miniblog/internal/apiserver"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/biz/v1/post"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/biz/v1/preview"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/biz/v1/user"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/locale"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/validation"
+	"github.com/ra1n6ow/miniblog/internal/pkg/clientip"
+	"github.com/ra1n6ow/miniblog/internal/pkg/known"
+	"github.com/ra1n6ow/miniblog/internal/pkg/mailer"
+	"github.com/ra1n6ow/miniblog/internal/pkg/oidc"
+	"github.com/ra1n6ow/miniblog/pkg/token"
 )
 
 // 定义支持的服务器模式集合.
@@ -26,14 +39,41 @@ var availableServerModes = sets.New(
 	apiserver.GRPCGatewayServerMode,
 )
 
+// 定义支持的重复博客处理策略集合.
+var availableDuplicatePolicies = sets.New(
+	post.DuplicatePolicyOff,
+	post.DuplicatePolicyWarn,
+	post.DuplicatePolicyReject,
+)
+
+// 定义可以授予单点登录自动创建的用户的角色集合.
+var availableOIDCRoles = sets.New(known.RoleUser, known.RoleAdmin)
+
+// 定义邮箱未验证的用户可以被限制的操作.
+const (
+	// RestrictLogin 禁止邮箱未验证的用户登录.
+	RestrictLogin = "login"
+	// RestrictPost 禁止邮箱未验证的用户创建和修改博客.
+	RestrictPost = "post"
+)
+
+// 定义支持的邮箱未验证用户限制集合.
+var availableUnverifiedRestrictions = sets.New(RestrictLogin, RestrictPost)
+
 // ServerOptions 包含服务器配置选项.
 type ServerOptions struct {
 	// ServerMode 定义服务器模式：gRPC、Gin HTTP、HTTP Reverse Proxy.
 	ServerMode string `json:"server-mode" mapstructure:"server-mode"`
-	// JWTKey 定义 JWT 密钥.
+	// JWTKey 定义签发 HS256 token 的 JWT 密钥. 配置了 JWTSigningKeys 时只用于校验之前签发的 HS256 token.
 	JWTKey string `json:"jwt-key" mapstructure:"jwt-key"`
-	// Expiration 定义 JWT Token 的过期时间.
+	// JWTSigningKeys 定义签发 token 的非对称密钥，按 not-before 轮换，只能在配置文件中配置.
+	JWTSigningKeys []*token.KeyOptions `json:"jwt-signing-keys" mapstructure:"jwt-signing-keys"`
+	// JWTKeyOverlap 定义被替换的签名密钥继续用于校验 token 的时间，不能短于访问令牌的有效期.
+	JWTKeyOverlap time.Duration `json:"jwt-key-overlap" mapstructure:"jwt-key-overlap"`
+	// Expiration 定义访问令牌（JWT Token）的过期时间.
 	Expiration time.Duration `json:"expiration" mapstructure:"expiration"`
+	// RefreshExpiration 定义刷新令牌的过期时间.
+	RefreshExpiration time.Duration `json:"refresh-expiration" mapstructure:"refresh-expiration"`
 
 	// TLSOptions 包含 TLS 配置选项.
 	TLSOptions  *genericoptions.TLSOptions  `json:"tls" mapstructure:"tls"`
@@ -41,18 +81,105 @@ type ServerOptions struct {
 	GRPCOptions *genericoptions.GRPCOptions `json:"grpc" mapstructure:"grpc"`
 	// MySQLOptions 包含 MySQL 配置选项.
 	MySQLOptions *genericoptions.MySQLOptions `json:"mysql" mapstructure:"mysql"`
+	// MailerOptions 包含发送邮件的配置选项.
+	MailerOptions *mailer.Options `json:"mailer" mapstructure:"mailer"`
+	// TrustedProxies 定义可信反向代理的 IP 或者 CIDR，只有请求来自可信代理时才使用 X-Forwarded-For 中的客户端 IP.
+	TrustedProxies []string `json:"trusted-proxies" mapstructure:"trusted-proxies"`
+	// EnableWeb 定义是否启用内置的 HTML 前端，仅在 Gin 和 gRPC-Gateway 模式下生效.
+	EnableWeb bool `json:"enable-web" mapstructure:"enable-web"`
+	// WebThemeDir 定义 HTML 前端的自定义主题目录，为空时使用内置主题.
+	WebThemeDir string `json:"web-theme-dir" mapstructure:"web-theme-dir"`
+	// Reactions 定义允许对博客使用的反应类型（表情）.
+	Reactions []string `json:"reactions" mapstructure:"reactions"`
+	// MaxPinnedPosts 定义每个用户最多可以置顶的博客数量.
+	MaxPinnedPosts int `json:"max-pinned-posts" mapstructure:"max-pinned-posts"`
+	// DuplicatePolicy 定义创建博客时检测到重复博客的处理策略：off、warn、reject.
+	DuplicatePolicy string `json:"duplicate-policy" mapstructure:"duplicate-policy"`
+	// DuplicateWindow 定义检测重复博客的时间窗口.
+	DuplicateWindow time.Duration `json:"duplicate-window" mapstructure:"duplicate-window"`
+	// DuplicateMaxDistance 定义判定近似重复时 SimHash 签名允许的最大汉明距离，为 -1 时只检测完全相同的博客.
+	DuplicateMaxDistance int `json:"duplicate-max-distance" mapstructure:"duplicate-max-distance"`
+	// AnalyticsInterval 定义后台任务汇总统计数据的时间间隔.
+	AnalyticsInterval time.Duration `json:"analytics-interval" mapstructure:"analytics-interval"`
+	// DefaultLocale 定义创建博客时未指定语言时使用的默认语言.
+	DefaultLocale string `json:"default-locale" mapstructure:"default-locale"`
+	// PreviewKey 定义签名预览链接使用的密钥，为空时使用 JWTKey.
+	PreviewKey string `json:"preview-key" mapstructure:"preview-key"`
+	// PreviewTTL 定义未指定有效期时预览链接的有效期.
+	PreviewTTL time.Duration `json:"preview-ttl" mapstructure:"preview-ttl"`
+	// PreviewMaxTTL 定义预览链接的最长有效期.
+	PreviewMaxTTL time.Duration `json:"preview-max-ttl" mapstructure:"preview-max-ttl"`
+	// PreviewRateLimit 定义每个客户端 IP 每分钟最多可以访问预览链接的次数.
+	PreviewRateLimit int `json:"preview-rate-limit" mapstructure:"preview-rate-limit"`
+	// ExternalURL 定义用户访问服务的地址，用于生成邮件中的链接.
+	ExternalURL string `json:"external-url" mapstructure:"external-url"`
+	// EmailVerificationTTL 定义邮箱验证链接的有效期.
+	EmailVerificationTTL time.Duration `json:"email-verification-ttl" mapstructure:"email-verification-ttl"`
+	// UnverifiedRestrictions 定义邮箱未验证的用户受到的限制：login、post.
+	UnverifiedRestrictions []string `json:"unverified-restrictions" mapstructure:"unverified-restrictions"`
+	// PasswordResetTTL 定义重置密码令牌的有效期.
+	PasswordResetTTL time.Duration `json:"password-reset-ttl" mapstructure:"password-reset-ttl"`
+	// PasswordResetRateLimit 定义每个客户端 IP 每分钟最多可以调用重置密码接口的次数.
+	PasswordResetRateLimit int `json:"password-reset-rate-limit" mapstructure:"password-reset-rate-limit"`
+	// PasswordResetsPerEmail 定义每个邮箱每小时最多可以申请重置密码的次数.
+	PasswordResetsPerEmail int `json:"password-resets-per-email" mapstructure:"password-resets-per-email"`
+	// LoginMaxFailures 定义同一用户名连续登录失败多少次后锁定账号.
+	LoginMaxFailures int `json:"login-max-failures" mapstructure:"login-max-failures"`
+	// LoginMaxFailuresPerIP 定义同一客户端 IP 连续登录失败多少次后禁止该 IP 登录.
+	LoginMaxFailuresPerIP int `json:"login-max-failures-per-ip" mapstructure:"login-max-failures-per-ip"`
+	// LoginLockoutDuration 定义登录锁定的时长.
+	LoginLockoutDuration time.Duration `json:"login-lockout-duration" mapstructure:"login-lockout-duration"`
+	// LoginDelay 定义第一次登录失败后需要等待的时间，之后每次失败翻倍.
+	LoginDelay time.Duration `json:"login-delay" mapstructure:"login-delay"`
+	// TwoFactorIssuer 定义验证器应用中显示的服务名称.
+	TwoFactorIssuer string `json:"two-factor-issuer" mapstructure:"two-factor-issuer"`
+	// TwoFactorChallengeTTL 定义两步验证挑战令牌的有效期.
+	TwoFactorChallengeTTL time.Duration `json:"two-factor-challenge-ttl" mapstructure:"two-factor-challenge-ttl"`
+	// OIDCProviders 定义单点登录的身份提供方，只能在配置文件中配置.
+	OIDCProviders []*oidc.Options `json:"oidc-providers" mapstructure:"oidc-providers"`
+	// OIDCDefaultRole 定义通过单点登录自动创建的用户被授予的角色.
+	OIDCDefaultRole string `json:"oidc-default-role" mapstructure:"oidc-default-role"`
 }
 
 // NewServerOptions 创建带有默认值的 ServerOptions 实例.
 func NewServerOptions() *ServerOptions {
 	opts := &ServerOptions{
-		ServerMode:   "grpc-gateway",
-		JWTKey:       "Rtg8BPKNEf2mB4mgvKONGPZZQSaJWNLijxR42qRgq0iBb5",
-		Expiration:   2 * time.Hour,
-		TLSOptions:   genericoptions.NewTLSOptions(),
-		GRPCOptions:  genericoptions.NewGRPCOptions(),
-		HTTPOptions:  genericoptions.NewHTTPOptions(),
-		MySQLOptions: genericoptions.NewMySQLOptions(),
+		ServerMode: "grpc-gateway",
+		// 访问令牌的有效期较短，过期后使用刷新令牌换取新的访问令牌
+		Expiration:        15 * time.Minute,
+		RefreshExpiration: 30 * 24 * time.Hour,
+		JWTKeyOverlap:     time.Hour,
+		TLSOptions:        genericoptions.NewTLSOptions(),
+		GRPCOptions:       genericoptions.NewGRPCOptions(),
+		HTTPOptions:       genericoptions.NewHTTPOptions(),
+		MySQLOptions:      genericoptions.NewMySQLOptions(),
+		MailerOptions:     mailer.NewOptions(),
+		EnableWeb:         true,
+		Reactions:         []string{"👍", "👎", "😄", "🎉", "😕", "❤️", "🚀", "👀"},
+		MaxPinnedPosts:    3,
+		// 默认只记录重复博客，避免误判时拒绝用户的正常请求
+		DuplicatePolicy:      string(post.DuplicatePolicyWarn),
+		DuplicateWindow:      24 * time.Hour,
+		DuplicateMaxDistance: 3,
+		AnalyticsInterval:    10 * time.Minute,
+		DefaultLocale:        "zh-CN",
+		PreviewTTL:           24 * time.Hour,
+		PreviewMaxTTL:        7 * 24 * time.Hour,
+		PreviewRateLimit:     60,
+		ExternalURL:          "http://127.0.0.1:8880",
+		EmailVerificationTTL: 24 * time.Hour,
+		// 默认允许未验证邮箱的用户登录和浏览，但不能发布内容
+		UnverifiedRestrictions: []string{RestrictPost},
+		PasswordResetTTL:       30 * time.Minute,
+		PasswordResetRateLimit: 10,
+		PasswordResetsPerEmail: 3,
+		LoginMaxFailures:       5,
+		LoginMaxFailuresPerIP:  50,
+		LoginLockoutDuration:   15 * time.Minute,
+		LoginDelay:             time.Second,
+		TwoFactorIssuer:        "miniblog",
+		TwoFactorChallengeTTL:  5 * time.Minute,
+		OIDCDefaultRole:        known.RoleUser,
 	}
 	opts.HTTPOptions.Addr = ":8880"
 	opts.GRPCOptions.Addr = ":8881"
@@ -63,14 +190,44 @@ func NewServerOptions() *ServerOptions {
 // 通过使用 pflag 包，可以实现从命令行中解析这些选项的功能.
 func (o *ServerOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.ServerMode, "server-mode", o.ServerMode, fmt.Sprintf("Server mode, available options: %v", availableServerModes.UnsortedList()))
-	fs.StringVar(&o.JWTKey, "jwt-key", o.JWTKey, "JWT signing key. Must be at least 6 characters long.")
+	fs.StringVar(&o.JWTKey, "jwt-key", o.JWTKey, "HS256 JWT signing key. Must be at least 6 characters long. Required unless jwt-signing-keys are configured, in which case it is only used to verify previously issued HS256 tokens.")
+	fs.DurationVar(&o.JWTKeyOverlap, "jwt-key-overlap", o.JWTKeyOverlap, "How long a replaced JWT signing key keeps verifying tokens and stays published in the JWKS. Must not be shorter than expiration.")
 	// 绑定 JWT Token 的过期时间选项到命令行标志。
 	// 参数名称为 `--expiration`，默认值为 o.Expiration
-	fs.DurationVar(&o.Expiration, "expiration", o.Expiration, "The expiration duration of JWT tokens.")
+	fs.DurationVar(&o.Expiration, "expiration", o.Expiration, "The expiration duration of JWT access tokens.")
+	fs.DurationVar(&o.RefreshExpiration, "refresh-expiration", o.RefreshExpiration, "The expiration duration of refresh tokens. Each refresh token can only be used once.")
+	fs.StringSliceVar(&o.TrustedProxies, "trusted-proxies", o.TrustedProxies, "IPs or CIDRs of reverse proxies trusted to set X-Forwarded-For. By default no proxy is trusted and the client IP is the peer address.")
+	fs.BoolVar(&o.EnableWeb, "enable-web", o.EnableWeb, "Serve the built-in HTML frontend. Only takes effect in gin and grpc-gateway server modes.")
+	fs.StringVar(&o.WebThemeDir, "web-theme-dir", o.WebThemeDir, "Directory of a custom theme for the HTML frontend. Files in it override the embedded default theme.")
+	fs.StringSliceVar(&o.Reactions, "reactions", o.Reactions, "Reactions (emoji) users are allowed to add to posts.")
+	fs.IntVar(&o.MaxPinnedPosts, "max-pinned-posts", o.MaxPinnedPosts, "The maximum number of posts each user can pin to the top of their profile.")
+	fs.StringVar(&o.DuplicatePolicy, "duplicate-policy", o.DuplicatePolicy, fmt.Sprintf("How to handle a new post that duplicates a recent post by the same author, available options: %v", availableDuplicatePolicies.UnsortedList()))
+	fs.DurationVar(&o.DuplicateWindow, "duplicate-window", o.DuplicateWindow, "Only posts created within this window are checked for duplicates.")
+	fs.IntVar(&o.DuplicateMaxDistance, "duplicate-max-distance", o.DuplicateMaxDistance, "The maximum SimHash Hamming distance (0-64) for two posts to be considered near-duplicates. -1 only detects exact duplicates.")
+	fs.StringVar(&o.DefaultLocale, "default-locale", o.DefaultLocale, "The BCP 47 language tag of posts created without an explicit locale.")
+	fs.DurationVar(&o.AnalyticsInterval, "analytics-interval", o.AnalyticsInterval, "How often post and user statistics are rolled up for the analytics API.")
+	fs.StringVar(&o.PreviewKey, "preview-key", o.PreviewKey, "Key used to sign post preview links. Defaults to the JWT signing key. Changing it invalidates all existing preview links.")
+	fs.DurationVar(&o.PreviewTTL, "preview-ttl", o.PreviewTTL, "How long a post preview link is valid when the author does not specify a TTL.")
+	fs.DurationVar(&o.PreviewMaxTTL, "preview-max-ttl", o.PreviewMaxTTL, "The maximum TTL an author can request for a post preview link.")
+	fs.IntVar(&o.PreviewRateLimit, "preview-rate-limit", o.PreviewRateLimit, "The maximum number of preview link requests per minute from a single client IP.")
+	fs.StringVar(&o.ExternalURL, "external-url", o.ExternalURL, "The URL users reach this server at. Used to build links in emails.")
+	fs.DurationVar(&o.EmailVerificationTTL, "email-verification-ttl", o.EmailVerificationTTL, "How long an email verification link is valid.")
+	fs.StringSliceVar(&o.UnverifiedRestrictions, "unverified-restrictions", o.UnverifiedRestrictions, fmt.Sprintf("What users with an unverified email address cannot do, available options: %v", sets.List(availableUnverifiedRestrictions)))
+	fs.DurationVar(&o.PasswordResetTTL, "password-reset-ttl", o.PasswordResetTTL, "How long a password reset token is valid.")
+	fs.IntVar(&o.PasswordResetRateLimit, "password-reset-rate-limit", o.PasswordResetRateLimit, "The maximum number of password reset requests per minute from a single client IP.")
+	fs.IntVar(&o.PasswordResetsPerEmail, "password-resets-per-email", o.PasswordResetsPerEmail, "The maximum number of password reset emails sent to a single email address per hour.")
+	fs.IntVar(&o.LoginMaxFailures, "login-max-failures", o.LoginMaxFailures, "Number of consecutive failed logins for a username before the account is temporarily locked.")
+	fs.IntVar(&o.LoginMaxFailuresPerIP, "login-max-failures-per-ip", o.LoginMaxFailuresPerIP, "Number of consecutive failed logins from a client IP before the IP is temporarily blocked from logging in.")
+	fs.DurationVar(&o.LoginLockoutDuration, "login-lockout-duration", o.LoginLockoutDuration, "How long an account or client IP stays locked after too many failed logins.")
+	fs.DurationVar(&o.LoginDelay, "login-delay", o.LoginDelay, "How long a username must wait after its first failed login. Doubles with each further failure. 0 disables the delay.")
+	fs.StringVar(&o.TwoFactorIssuer, "two-factor-issuer", o.TwoFactorIssuer, "Service name shown in authenticator apps for two-factor authentication.")
+	fs.DurationVar(&o.TwoFactorChallengeTTL, "two-factor-challenge-ttl", o.TwoFactorChallengeTTL, "How long the challenge token returned by login is valid for completing two-factor authentication.")
+	fs.StringVar(&o.OIDCDefaultRole, "oidc-default-role", o.OIDCDefaultRole, fmt.Sprintf("Role granted to users created on their first OIDC login, available options: %v", sets.List(availableOIDCRoles)))
 	o.TLSOptions.AddFlags(fs)
 	o.GRPCOptions.AddFlags(fs)
 	o.HTTPOptions.AddFlags(fs)
 	o.MySQLOptions.AddFlags(fs)
+	o.MailerOptions.AddFlags(fs)
 }
 
 // Validate 校验 ServerOptions 中的选项是否合法.
@@ -82,14 +239,163 @@ func (o *ServerOptions) Validate() error {
 		errs = append(errs, fmt.Errorf("invalid server mode: must be one of %v", availableServerModes.UnsortedList()))
 	}
 
-	// 校验 JWTKey 长度
-	if len(o.JWTKey) < 6 {
+	// 校验 JWTKey 长度，未配置非对称密钥时必须配置 JWTKey
+	if o.JWTKey != "" && len(o.JWTKey) < 6 {
 		errs = append(errs, errors.New("JWTKey must be at least 6 characters long"))
 	}
+	if o.JWTKey == "" && len(o.JWTSigningKeys) == 0 {
+		errs = append(errs, errors.New("either jwt-key or jwt-signing-keys must be configured"))
+	}
+
+	// 校验非对称签名密钥，被替换的密钥需要在重叠期内继续校验之前签发的 token
+	keyIDs := sets.New[string]()
+	for _, key := range o.JWTSigningKeys {
+		errs = append(errs, key.Validate()...)
+		if keyIDs.Has(key.ID) {
+			errs = append(errs, fmt.Errorf("duplicate jwt signing key id %q", key.ID))
+		}
+		keyIDs.Insert(key.ID)
+	}
+	if len(o.JWTSigningKeys) > 0 && o.JWTKeyOverlap < o.Expiration {
+		errs = append(errs, errors.New("jwt-key-overlap cannot be shorter than expiration"))
+	}
+
+	// 校验令牌的过期时间，刷新令牌的有效期不能短于访问令牌
+	if o.Expiration <= 0 {
+		errs = append(errs, errors.New("expiration must be positive"))
+	}
+	if o.RefreshExpiration < o.Expiration {
+		errs = append(errs, errors.New("refresh-expiration cannot be shorter than expiration"))
+	}
+
+	// 校验可信代理的格式
+	if _, err := clientip.New(o.TrustedProxies); err != nil {
+		errs = append(errs, err)
+	}
+
+	// 校验 HTML 前端的主题目录是否存在
+	if o.EnableWeb && o.WebThemeDir != "" {
+		if info, err := os.Stat(o.WebThemeDir); err != nil || !info.IsDir() {
+			errs = append(errs, fmt.Errorf("web-theme-dir %s is not a directory", o.WebThemeDir))
+		}
+	}
+
+	// 校验反应类型，反应类型会作为数据库中的字段保存
+	if len(o.Reactions) == 0 {
+		errs = append(errs, errors.New("reactions cannot be empty"))
+	}
+	for _, reaction := range o.Reactions {
+		if reaction == "" || len(reaction) > 32 {
+			errs = append(errs, fmt.Errorf("invalid reaction %q: must be 1 to 32 bytes long", reaction))
+		}
+	}
+
+	// 校验置顶博客数量上限
+	if o.MaxPinnedPosts < 0 {
+		errs = append(errs, errors.New("max-pinned-posts cannot be negative"))
+	}
+
+	// 校验重复博客检测配置
+	if !availableDuplicatePolicies.Has(post.DuplicatePolicy(o.DuplicatePolicy)) {
+		errs = append(errs, fmt.Errorf("invalid duplicate policy: must be one of %v", availableDuplicatePolicies.UnsortedList()))
+	}
+	if o.DuplicateWindow <= 0 {
+		errs = append(errs, errors.New("duplicate-window must be positive"))
+	}
+	if o.DuplicateMaxDistance < -1 || o.DuplicateMaxDistance > 64 {
+		errs = append(errs, errors.New("duplicate-max-distance must be between -1 and 64"))
+	}
+
+	// 校验博客默认语言
+	if _, err := locale.Canonicalize(o.DefaultLocale); err != nil {
+		errs = append(errs, fmt.Errorf("invalid default locale %q: %w", o.DefaultLocale, err))
+	}
+
+	// 校验统计数据汇总间隔
+	if o.AnalyticsInterval <= 0 {
+		errs = append(errs, errors.New("analytics-interval must be positive"))
+	}
+
+	// 校验预览链接配置
+	if o.PreviewKey != "" && len(o.PreviewKey) < 6 {
+		errs = append(errs, errors.New("preview-key must be at least 6 characters long"))
+	}
+	if o.PreviewKey == "" && o.JWTKey == "" {
+		errs = append(errs, errors.New("preview-key must be configured when jwt-key is empty"))
+	}
+	if o.PreviewMaxTTL <= 0 {
+		errs = append(errs, errors.New("preview-max-ttl must be positive"))
+	}
+	if o.PreviewTTL <= 0 || o.PreviewTTL > o.PreviewMaxTTL {
+		errs = append(errs, errors.New("preview-ttl must be positive and cannot exceed preview-max-ttl"))
+	}
+	if o.PreviewRateLimit <= 0 {
+		errs = append(errs, errors.New("preview-rate-limit must be positive"))
+	}
+
+	// 校验邮箱验证配置
+	if u, err := url.Parse(o.ExternalURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errs = append(errs, fmt.Errorf("invalid external-url %q: must be an absolute http or https URL", o.ExternalURL))
+	}
+	if o.EmailVerificationTTL <= 0 {
+		errs = append(errs, errors.New("email-verification-ttl must be positive"))
+	}
+	for _, restriction := range o.UnverifiedRestrictions {
+		if !availableUnverifiedRestrictions.Has(restriction) {
+			errs = append(errs, fmt.Errorf("invalid unverified restriction %q: must be one of %v", restriction, sets.List(availableUnverifiedRestrictions)))
+		}
+	}
+
+	// 校验重置密码配置
+	if o.PasswordResetTTL <= 0 {
+		errs = append(errs, errors.New("password-reset-ttl must be positive"))
+	}
+	if o.PasswordResetRateLimit <= 0 {
+		errs = append(errs, errors.New("password-reset-rate-limit must be positive"))
+	}
+	if o.PasswordResetsPerEmail <= 0 {
+		errs = append(errs, errors.New("password-resets-per-email must be positive"))
+	}
+
+	// 校验登录保护配置
+	if o.LoginMaxFailures <= 0 {
+		errs = append(errs, errors.New("login-max-failures must be positive"))
+	}
+	if o.LoginMaxFailuresPerIP <= 0 {
+		errs = append(errs, errors.New("login-max-failures-per-ip must be positive"))
+	}
+	if o.LoginLockoutDuration <= 0 {
+		errs = append(errs, errors.New("login-lockout-duration must be positive"))
+	}
+	if o.LoginDelay < 0 {
+		errs = append(errs, errors.New("login-delay cannot be negative"))
+	}
+
+	// 校验两步验证配置，服务名称会出现在 otpauth URI 的标签中，不能包含冒号
+	if o.TwoFactorIssuer == "" || strings.Contains(o.TwoFactorIssuer, ":") {
+		errs = append(errs, errors.New("two-factor-issuer cannot be empty or contain ':'"))
+	}
+	if o.TwoFactorChallengeTTL <= 0 {
+		errs = append(errs, errors.New("two-factor-challenge-ttl must be positive"))
+	}
+
+	// 校验单点登录配置，身份提供方名称会出现在回调地址中，并作为外部身份的一部分保存
+	providerNames := sets.New[string]()
+	for _, provider := range o.OIDCProviders {
+		errs = append(errs, provider.Validate()...)
+		if providerNames.Has(provider.Name) {
+			errs = append(errs, fmt.Errorf("duplicate oidc provider name %q", provider.Name))
+		}
+		providerNames.Insert(provider.Name)
+	}
+	if !availableOIDCRoles.Has(o.OIDCDefaultRole) {
+		errs = append(errs, fmt.Errorf("invalid oidc-default-role: must be one of %v", sets.List(availableOIDCRoles)))
+	}
 
 	errs = append(errs, o.TLSOptions.Validate()...)
 	errs = append(errs, o.HTTPOptions.Validate()...)
 	errs = append(errs, o.MySQLOptions.Validate()...)
+	errs = append(errs, o.MailerOptions.Validate()...)
 
 	// 如果是 gRPC 或 gRPC-Gateway 模式，校验 gRPC 配置
 	if stringsutil.StringIn(o.ServerMode, []string{apiserver.GRPCServerMode, apiserver.GRPCGatewayServerMode}) {
@@ -102,13 +408,101 @@ func (o *ServerOptions) Validate() error {
 
 // Config 基于 ServerOptions 构建运行时配置 apiserver.Config.
 func (o *ServerOptions) Config() (*apiserver.Config, error) {
+	defaultLocale, err := locale.Canonicalize(o.DefaultLocale)
+	if err != nil {
+		return nil, err
+	}
+
+	// 未配置单独的预览链接密钥时复用 JWT 密钥，签名时会按用途派生出不同的密钥
+	previewKey := o.PreviewKey
+	if previewKey == "" {
+		previewKey = o.JWTKey
+	}
+
+	restrictions := sets.New(o.UnverifiedRestrictions...)
+
+	jwtKeys, err := o.jwtKeySet()
+	if err != nil {
+		return nil, err
+	}
+
+	resolver, err := clientip.New(o.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+
 	return &apiserver.Config{
 		ServerMode:   o.ServerMode,
 		JWTKey:       o.JWTKey,
+		JWTKeys:      jwtKeys,
 		Expiration:   o.Expiration,
 		TLSOptions:   o.TLSOptions,
 		HTTPOptions:  o.HTTPOptions,
 		GRPCOptions:  o.GRPCOptions,
 		MySQLOptions: o.MySQLOptions,
+		ClientIP:     resolver,
+		EnableWeb:    o.EnableWeb,
+		WebThemeDir:  o.WebThemeDir,
+		Reactions:    validation.ReactionSet(o.Reactions),
+		PostOptions: &post.Options{
+			MaxPinned:            o.MaxPinnedPosts,
+			DuplicatePolicy:      post.DuplicatePolicy(o.DuplicatePolicy),
+			DuplicateWindow:      o.DuplicateWindow,
+			DuplicateMaxDistance: o.DuplicateMaxDistance,
+			DefaultLocale:        defaultLocale,
+			RequireVerifiedEmail: restrictions.Has(RestrictPost),
+		},
+		AnalyticsInterval: o.AnalyticsInterval,
+		PreviewOptions: &preview.Options{
+			Key:        previewKey,
+			DefaultTTL: o.PreviewTTL,
+			MaxTTL:     o.PreviewMaxTTL,
+		},
+		PreviewRateLimit: o.PreviewRateLimit,
+		UserOptions: &user.Options{
+			ExternalURL:                 o.ExternalURL,
+			VerificationTTL:             o.EmailVerificationTTL,
+			RequireVerifiedEmailToLogin: restrictions.Has(RestrictLogin),
+			PasswordResetTTL:            o.PasswordResetTTL,
+			PasswordResetsPerEmail:      o.PasswordResetsPerEmail,
+			LoginMaxFailures:            o.LoginMaxFailures,
+			LoginMaxFailuresPerIP:       o.LoginMaxFailuresPerIP,
+			LoginLockoutDuration:        o.LoginLockoutDuration,
+			LoginDelay:                  o.LoginDelay,
+			TwoFactorIssuer:             o.TwoFactorIssuer,
+			TwoFactorChallengeTTL:       o.TwoFactorChallengeTTL,
+			RefreshTokenTTL:             o.RefreshExpiration,
+			OIDCProviders:               o.OIDCProviders,
+			OIDCDefaultRole:             o.OIDCDefaultRole,
+		},
+		PasswordResetRateLimit: o.PasswordResetRateLimit,
+		MailerOptions:          o.MailerOptions,
 	}, nil
 }
+
+// jwtKeySet 从私钥文件中加载非对称签名密钥，未配置时返回 nil.
+func (o *ServerOptions) jwtKeySet() (*token.KeySet, error) {
+	if len(o.JWTSigningKeys) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]*token.Key, 0, len(o.JWTSigningKeys))
+	for _, opts := range o.JWTSigningKeys {
+		key, err := opts.Load()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load jwt signing key %s: %w", opts.ID, err)
+		}
+		keys = append(keys, key)
+	}
+
+	keySet, err := token.NewKeySet(o.JWTKeyOverlap, keys...)
+	if err != nil {
+		return nil, err
+	}
+	// 启动时至少需要一个已经生效的密钥，否则无法签发 token
+	if _, ok := keySet.SigningKey(time.Now()); !ok {
+		return nil, errors.New("none of the jwt signing keys is active yet")
+	}
+
+	return keySet, nil
+}
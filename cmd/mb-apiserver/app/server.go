@@ -59,6 +59,9 @@ The project features include:
 	// 添加 --version 标志
 	version.AddFlags(cmd.PersistentFlags())
 
+	// 添加子命令
+	cmd.AddCommand(newExportSiteCommand(opts))
+
 	return cmd
 }
 
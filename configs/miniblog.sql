@@ -31,6 +31,70 @@ CREATE DATABASE /*!32312 IF NOT EXISTS*/ `miniblog` /*!40100 DEFAULT CHARACTER S
 
 USE `miniblog`;
 
+--
+-- Table structure for table `access_token`
+--
+
+DROP TABLE IF EXISTS `access_token`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `access_token` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `tokenID` varchar(36) NOT NULL DEFAULT '' COMMENT '令牌唯一 ID，用于列出和撤销令牌',
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '令牌所属用户的唯一 ID',
+  `name` varchar(64) NOT NULL DEFAULT '' COMMENT '令牌名称，便于用户区分令牌的用途',
+  `scopes` varchar(255) NOT NULL DEFAULT '' COMMENT '令牌的权限范围，多个权限范围以逗号分隔，例如 posts:write,users:read',
+  `tokenHash` char(64) NOT NULL DEFAULT '' COMMENT '令牌的 SHA-256 摘要（十六进制），不保存令牌明文',
+  `expiresAt` datetime NOT NULL COMMENT '令牌过期时间',
+  `lastUsedAt` datetime DEFAULT NULL COMMENT '令牌最后一次使用的时间，为空表示从未使用',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '令牌创建时间',
+  `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '令牌最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_access_token_tokenID` (`tokenID`),
+  UNIQUE KEY `idx_access_token_tokenHash` (`tokenHash`),
+  KEY `idx_access_token_userID` (`userID`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='个人访问令牌表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `access_token`
+--
+
+LOCK TABLES `access_token` WRITE;
+/*!40000 ALTER TABLE `access_token` DISABLE KEYS */;
+/*!40000 ALTER TABLE `access_token` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `bookmark`
+--
+
+DROP TABLE IF EXISTS `bookmark`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `bookmark` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '用户唯一 ID',
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `listName` varchar(64) NOT NULL DEFAULT '' COMMENT '阅读列表名称，为空表示未归类',
+  `position` bigint(20) NOT NULL DEFAULT 0 COMMENT '书签在阅读列表中的位置',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '书签创建时间',
+  `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '书签最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_bookmark_userID_postID` (`userID`,`postID`),
+  KEY `idx_bookmark_postID` (`postID`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='书签表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `bookmark`
+--
+
+LOCK TABLES `bookmark` WRITE;
+/*!40000 ALTER TABLE `bookmark` DISABLE KEYS */;
+/*!40000 ALTER TABLE `bookmark` ENABLE KEYS */;
+UNLOCK TABLES;
+
 --
 -- Table structure for table `casbin_rule`
 --
@@ -64,7 +128,39 @@ INSERT INTO `casbin_rule` VALUES
 (7,'p','role::user','/v1.MiniBlog/DeleteUser','CALL','deny','',''),
 (8,'p','role::user','/v1.MiniBlog/ListUser','CALL','deny','',''),
 (9,'p','role::user','/v1/users','GET','deny','',''),
-(10,'p','role::user','/v1/users/*','DELETE','deny','','');
+(10,'p','role::user','/v1/users/*','DELETE','deny','',''),
+(22,'p','role::user','/v1.MiniBlog/FeaturePost','CALL','deny','',''),
+(23,'p','role::user','/v1.MiniBlog/UnfeaturePost','CALL','deny','',''),
+(24,'p','role::user','/v1/posts/*/feature','POST','deny','',''),
+(25,'p','role::user','/v1/posts/*/feature','DELETE','deny','',''),
+(26,'p','role::user','/v1.MiniBlog/ListPostActivity','CALL','deny','',''),
+(27,'p','role::user','/v1.MiniBlog/ListTopAuthors','CALL','deny','',''),
+(28,'p','role::user','/v1.MiniBlog/ListSiteStats','CALL','deny','',''),
+(29,'p','role::user','/v1/analytics/posts','GET','deny','',''),
+(30,'p','role::user','/v1/analytics/top-authors','GET','deny','',''),
+(31,'p','role::user','/v1/analytics/site','GET','deny','',''),
+(32,'p','role::user','/v1.MiniBlog/UnlockUser','CALL','deny','',''),
+(33,'p','role::user','/v1/users/*/unlock','POST','deny','',''),
+(34,'p','role::user','/v1.MiniBlog/ResetTwoFactor','CALL','deny','',''),
+(35,'p','role::user','/v1/users/*/two-factor','DELETE','deny','',''),
+(36,'p','role::user','/v1.MiniBlog/ListPolicies','CALL','deny','',''),
+(37,'p','role::user','/v1.MiniBlog/CreatePolicy','CALL','deny','',''),
+(38,'p','role::user','/v1.MiniBlog/DeletePolicy','CALL','deny','',''),
+(39,'p','role::user','/v1.MiniBlog/ReplacePolicies','CALL','deny','',''),
+(40,'p','role::user','/v1.MiniBlog/ListRoleAssignments','CALL','deny','',''),
+(41,'p','role::user','/v1.MiniBlog/AssignRole','CALL','deny','',''),
+(42,'p','role::user','/v1.MiniBlog/UnassignRole','CALL','deny','',''),
+(43,'p','role::user','/v1.MiniBlog/ListUserRoles','CALL','deny','',''),
+(44,'p','role::user','/v1.MiniBlog/ListRoleUsers','CALL','deny','',''),
+(45,'p','role::user','/v1/policies','GET','deny','',''),
+(46,'p','role::user','/v1/policies','POST','deny','',''),
+(47,'p','role::user','/v1/policies','DELETE','deny','',''),
+(48,'p','role::user','/v1/policies','PUT','deny','',''),
+(49,'p','role::user','/v1/role-assignments','GET','deny','',''),
+(50,'p','role::user','/v1/users/*/roles','POST','deny','',''),
+(51,'p','role::user','/v1/users/*/roles','DELETE','deny','',''),
+(52,'p','role::user','/v1/users/*/roles','GET','deny','',''),
+(53,'p','role::user','/v1/roles/*/users','GET','deny','','');
 /*!40000 ALTER TABLE `casbin_rule` ENABLE KEYS */;
 UNLOCK TABLES;
 
@@ -81,11 +177,17 @@ CREATE TABLE `post` (
   `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
   `title` varchar(256) NOT NULL DEFAULT '' COMMENT '博文标题',
   `content` longtext NOT NULL DEFAULT '' COMMENT '博文内容',
+  `wordCount` bigint(20) NOT NULL DEFAULT 0 COMMENT '博文字数',
+  `readingTime` bigint(20) NOT NULL DEFAULT 0 COMMENT '预计阅读时间，单位为分钟',
+  `excerpt` varchar(512) NOT NULL DEFAULT '' COMMENT '博文纯文本摘要',
+  `locale` varchar(35) NOT NULL DEFAULT '' COMMENT '博文默认语言的语言标签（BCP 47）',
   `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '博文创建时间',
   `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '博文最后修改时间',
   PRIMARY KEY (`id`),
   UNIQUE KEY `post.postID` (`postID`),
-  KEY `idx.post.userID` (`userID`)
+  KEY `idx.post.userID` (`userID`),
+  KEY `idx.post.createdAt` (`createdAt`),
+  KEY `idx.post.locale` (`locale`)
 ) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='博文表';
 /*!40101 SET character_set_client = @saved_cs_client */;
 
@@ -98,6 +200,381 @@ LOCK TABLES `post` WRITE;
 /*!40000 ALTER TABLE `post` ENABLE KEYS */;
 UNLOCK TABLES;
 
+--
+-- Table structure for table `post_daily_stat`
+--
+
+DROP TABLE IF EXISTS `post_daily_stat`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `post_daily_stat` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `day` date NOT NULL COMMENT '统计日期',
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '博文作者的用户唯一 ID',
+  `postCount` bigint(20) NOT NULL DEFAULT 0 COMMENT '当天创建的博文数量',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_post_daily_stat_day_userID` (`day`,`userID`),
+  KEY `idx_post_daily_stat_userID_day` (`userID`,`day`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='博文每日统计表，由后台任务汇总';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `post_daily_stat`
+--
+
+LOCK TABLES `post_daily_stat` WRITE;
+/*!40000 ALTER TABLE `post_daily_stat` DISABLE KEYS */;
+/*!40000 ALTER TABLE `post_daily_stat` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `post_feature`
+--
+
+DROP TABLE IF EXISTS `post_feature`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `post_feature` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `position` bigint(20) NOT NULL DEFAULT 0 COMMENT '精选顺序，从 0 开始',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '设为精选的时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_post_feature_postID` (`postID`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='博文精选表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `post_feature`
+--
+
+LOCK TABLES `post_feature` WRITE;
+/*!40000 ALTER TABLE `post_feature` DISABLE KEYS */;
+/*!40000 ALTER TABLE `post_feature` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `post_fingerprint`
+--
+
+DROP TABLE IF EXISTS `post_fingerprint`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `post_fingerprint` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '博文作者的用户唯一 ID',
+  `contentHash` char(64) NOT NULL DEFAULT '' COMMENT '规范化内容的 SHA-256 摘要',
+  `simHash` bigint(20) unsigned NOT NULL DEFAULT 0 COMMENT '规范化内容的 SimHash 签名',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '博文创建时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_post_fingerprint_postID` (`postID`),
+  KEY `idx_post_fingerprint_userID_createdAt` (`userID`,`createdAt`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='博文内容指纹表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `post_fingerprint`
+--
+
+LOCK TABLES `post_fingerprint` WRITE;
+/*!40000 ALTER TABLE `post_fingerprint` DISABLE KEYS */;
+/*!40000 ALTER TABLE `post_fingerprint` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `post_mention`
+--
+
+DROP TABLE IF EXISTS `post_mention`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `post_mention` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '被提及的用户唯一 ID',
+  `startOffset` bigint(20) NOT NULL DEFAULT 0 COMMENT '提及在博文内容中的起始偏移量',
+  `endOffset` bigint(20) NOT NULL DEFAULT 0 COMMENT '提及在博文内容中的结束偏移量',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '提及创建时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_post_mention_postID` (`postID`),
+  KEY `idx_post_mention_userID` (`userID`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='博文提及表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `post_mention`
+--
+
+LOCK TABLES `post_mention` WRITE;
+/*!40000 ALTER TABLE `post_mention` DISABLE KEYS */;
+/*!40000 ALTER TABLE `post_mention` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `post_pin`
+--
+
+DROP TABLE IF EXISTS `post_pin`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `post_pin` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '博文作者的用户唯一 ID',
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `position` bigint(20) NOT NULL DEFAULT 0 COMMENT '置顶顺序，从 0 开始',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '置顶时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_post_pin_postID` (`postID`),
+  KEY `idx_post_pin_userID` (`userID`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='博文置顶表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `post_pin`
+--
+
+LOCK TABLES `post_pin` WRITE;
+/*!40000 ALTER TABLE `post_pin` DISABLE KEYS */;
+/*!40000 ALTER TABLE `post_pin` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `post_preview_link`
+--
+
+DROP TABLE IF EXISTS `post_preview_link`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `post_preview_link` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '创建预览链接的用户唯一 ID',
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '预览的博文唯一 ID',
+  `linkID` varchar(36) NOT NULL DEFAULT '' COMMENT '预览链接唯一 ID',
+  `expiresAt` datetime NOT NULL COMMENT '预览链接过期时间',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '预览链接创建时间',
+  `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '预览链接最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_post_preview_link_linkID` (`linkID`),
+  KEY `idx_post_preview_link_postID` (`postID`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='博文预览链接表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `post_preview_link`
+--
+
+LOCK TABLES `post_preview_link` WRITE;
+/*!40000 ALTER TABLE `post_preview_link` DISABLE KEYS */;
+/*!40000 ALTER TABLE `post_preview_link` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `post_reaction`
+--
+
+DROP TABLE IF EXISTS `post_reaction`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `post_reaction` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '用户唯一 ID',
+  `reaction` varchar(32) NOT NULL DEFAULT '' COMMENT '反应类型',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '反应创建时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_post_reaction_postID_userID_reaction` (`postID`,`userID`,`reaction`),
+  KEY `idx_post_reaction_userID` (`userID`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='博文反应表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `post_reaction`
+--
+
+LOCK TABLES `post_reaction` WRITE;
+/*!40000 ALTER TABLE `post_reaction` DISABLE KEYS */;
+/*!40000 ALTER TABLE `post_reaction` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `post_reaction_count`
+--
+
+DROP TABLE IF EXISTS `post_reaction_count`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `post_reaction_count` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `reaction` varchar(32) NOT NULL DEFAULT '' COMMENT '反应类型',
+  `count` bigint(20) NOT NULL DEFAULT 0 COMMENT '反应数量',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_post_reaction_count_postID_reaction` (`postID`,`reaction`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='博文反应计数表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `post_reaction_count`
+--
+
+LOCK TABLES `post_reaction_count` WRITE;
+/*!40000 ALTER TABLE `post_reaction_count` DISABLE KEYS */;
+/*!40000 ALTER TABLE `post_reaction_count` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `post_template`
+--
+
+DROP TABLE IF EXISTS `post_template`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `post_template` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '模板所属用户的唯一 ID',
+  `templateID` varchar(36) NOT NULL DEFAULT '' COMMENT '模板唯一 ID',
+  `name` varchar(64) NOT NULL DEFAULT '' COMMENT '模板名称，同一用户的模板名称不能重复',
+  `title` varchar(256) NOT NULL DEFAULT '' COMMENT '博文标题模板',
+  `content` longtext NOT NULL DEFAULT '' COMMENT '博文内容模板',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '模板创建时间',
+  `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '模板最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_post_template_templateID` (`templateID`),
+  UNIQUE KEY `idx_post_template_userID_name` (`userID`,`name`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='博文模板表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `post_template`
+--
+
+LOCK TABLES `post_template` WRITE;
+/*!40000 ALTER TABLE `post_template` DISABLE KEYS */;
+/*!40000 ALTER TABLE `post_template` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `post_translation`
+--
+
+DROP TABLE IF EXISTS `post_translation`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `post_translation` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `locale` varchar(35) NOT NULL DEFAULT '' COMMENT '译文的语言标签（BCP 47）',
+  `title` varchar(256) NOT NULL DEFAULT '' COMMENT '译文标题',
+  `content` longtext NOT NULL DEFAULT '' COMMENT '译文内容',
+  `wordCount` bigint(20) NOT NULL DEFAULT 0 COMMENT '译文字数',
+  `readingTime` bigint(20) NOT NULL DEFAULT 0 COMMENT '预计阅读时间，单位为分钟',
+  `excerpt` varchar(512) NOT NULL DEFAULT '' COMMENT '译文纯文本摘要',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '译文创建时间',
+  `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '译文最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_post_translation_postID_locale` (`postID`,`locale`),
+  KEY `idx_post_translation_locale` (`locale`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='博文译文表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `post_translation`
+--
+
+LOCK TABLES `post_translation` WRITE;
+/*!40000 ALTER TABLE `post_translation` DISABLE KEYS */;
+/*!40000 ALTER TABLE `post_translation` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `refresh_token`
+--
+
+DROP TABLE IF EXISTS `refresh_token`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `refresh_token` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '令牌所属用户的唯一 ID',
+  `familyID` varchar(64) NOT NULL DEFAULT '' COMMENT '令牌族 ID，同一次登录轮换出的刷新令牌属于同一个令牌族',
+  `tokenHash` char(64) NOT NULL DEFAULT '' COMMENT '刷新令牌的 SHA-256 摘要（十六进制），不保存令牌明文',
+  `usedAt` datetime DEFAULT NULL COMMENT '令牌被轮换的时间，为空表示尚未使用',
+  `expiresAt` datetime NOT NULL COMMENT '令牌过期时间',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '令牌创建时间',
+  `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '令牌最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_refresh_token_tokenHash` (`tokenHash`),
+  KEY `idx_refresh_token_familyID` (`familyID`),
+  KEY `idx_refresh_token_userID` (`userID`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='刷新令牌表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `refresh_token`
+--
+
+LOCK TABLES `refresh_token` WRITE;
+/*!40000 ALTER TABLE `refresh_token` DISABLE KEYS */;
+/*!40000 ALTER TABLE `refresh_token` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `revoked_token`
+--
+
+DROP TABLE IF EXISTS `revoked_token`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `revoked_token` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `tokenID` varchar(36) NOT NULL DEFAULT '' COMMENT '被撤销的访问令牌的唯一标识（jti）',
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '令牌所属用户的唯一 ID',
+  `expiresAt` datetime NOT NULL COMMENT '令牌的过期时间，过期后撤销记录可以删除',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '撤销时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_revoked_token_tokenID` (`tokenID`),
+  KEY `idx_revoked_token_expiresAt` (`expiresAt`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='访问令牌撤销列表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `revoked_token`
+--
+
+LOCK TABLES `revoked_token` WRITE;
+/*!40000 ALTER TABLE `revoked_token` DISABLE KEYS */;
+/*!40000 ALTER TABLE `revoked_token` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `site_daily_stat`
+--
+
+DROP TABLE IF EXISTS `site_daily_stat`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `site_daily_stat` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `day` date NOT NULL COMMENT '统计日期',
+  `newUsers` bigint(20) NOT NULL DEFAULT 0 COMMENT '当天新增的用户数量',
+  `newPosts` bigint(20) NOT NULL DEFAULT 0 COMMENT '当天新增的博文数量',
+  `totalUsers` bigint(20) NOT NULL DEFAULT 0 COMMENT '截至当天结束的用户总数',
+  `totalPosts` bigint(20) NOT NULL DEFAULT 0 COMMENT '截至当天结束的博文总数',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_site_daily_stat_day` (`day`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='全站每日统计表，由后台任务汇总';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `site_daily_stat`
+--
+
+LOCK TABLES `site_daily_stat` WRITE;
+/*!40000 ALTER TABLE `site_daily_stat` DISABLE KEYS */;
+/*!40000 ALTER TABLE `site_daily_stat` ENABLE KEYS */;
+UNLOCK TABLES;
+
 --
 -- Table structure for table `user`
 --
@@ -112,13 +589,19 @@ CREATE TABLE `user` (
   `password` varchar(255) NOT NULL DEFAULT '' COMMENT '用户密码（加密后）',
   `nickname` varchar(30) NOT NULL DEFAULT '' COMMENT '用户昵称',
   `email` varchar(256) NOT NULL DEFAULT '' COMMENT '用户电子邮箱地址',
-  `phone` varchar(16) NOT NULL DEFAULT '' COMMENT '用户手机号',
+  `emailVerifiedAt` datetime DEFAULT NULL COMMENT '电子邮箱验证时间，为空表示未验证',
+  `phone` varchar(16) DEFAULT NULL COMMENT '用户手机号，通过单点登录创建的用户可以为空',
+  `totpSecret` varchar(64) DEFAULT NULL COMMENT '两步验证 TOTP 密钥（Base32），为空表示未绑定',
+  `totpEnabledAt` datetime DEFAULT NULL COMMENT '两步验证启用时间，为空表示未启用',
+  `totpLastStep` bigint(20) NOT NULL DEFAULT 0 COMMENT '最后一次使用的 TOTP 时间步，用于防止重放',
+  `tokensRevokedAt` datetime(3) DEFAULT NULL COMMENT '在该时间之前签发的访问令牌全部失效，修改密码时更新',
   `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '用户创建时间',
   `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '用户最后修改时间',
   PRIMARY KEY (`id`),
   UNIQUE KEY `user.userID` (`userID`),
   UNIQUE KEY `user.username` (`username`),
-  UNIQUE KEY `user.phone` (`phone`)
+  UNIQUE KEY `user.phone` (`phone`),
+  KEY `idx.user.createdAt` (`createdAt`)
 ) ENGINE=MyISAM AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='用户表';
 /*!40101 SET character_set_client = @saved_cs_client */;
 
@@ -129,9 +612,102 @@ CREATE TABLE `user` (
 LOCK TABLES `user` WRITE;
 /*!40000 ALTER TABLE `user` DISABLE KEYS */;
 INSERT INTO `user` VALUES
-(96,'user-000000','root','$2a$10$ctsFXEUAMd7rXXpmccNlO.ZRiYGYz0eOfj8EicPGWqiz64YBBgR1y','colin404','colin404@foxmail.com','18110000000','2024-12-12 03:55:25','2024-12-12 03:55:25');
+(96,'user-000000','root','$2a$10$ctsFXEUAMd7rXXpmccNlO.ZRiYGYz0eOfj8EicPGWqiz64YBBgR1y','colin404','colin404@foxmail.com','2024-12-12 03:55:25','18110000000',NULL,NULL,0,NULL,'2024-12-12 03:55:25','2024-12-12 03:55:25');
 /*!40000 ALTER TABLE `user` ENABLE KEYS */;
 UNLOCK TABLES;
+--
+-- Table structure for table `user_identity`
+--
+
+DROP TABLE IF EXISTS `user_identity`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `user_identity` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '身份所属用户的唯一 ID',
+  `provider` varchar(32) NOT NULL DEFAULT '' COMMENT '身份提供方名称，和配置中的 OIDC 提供方名称一致',
+  `subject` varchar(255) NOT NULL DEFAULT '' COMMENT '用户在身份提供方的唯一标识，即 ID Token 中的 sub',
+  `email` varchar(256) NOT NULL DEFAULT '' COMMENT '最后一次登录时身份提供方返回的电子邮箱地址',
+  `lastLoginAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '最后一次通过该身份登录的时间',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '身份关联时间',
+  `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '身份最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_user_identity_provider_subject` (`provider`,`subject`),
+  KEY `idx_user_identity_userID` (`userID`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='用户外部身份表，记录通过 OIDC 单点登录关联的身份';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `user_identity`
+--
+
+LOCK TABLES `user_identity` WRITE;
+/*!40000 ALTER TABLE `user_identity` DISABLE KEYS */;
+/*!40000 ALTER TABLE `user_identity` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `user_session`
+--
+
+DROP TABLE IF EXISTS `user_session`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `user_session` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `sessionID` varchar(36) NOT NULL DEFAULT '' COMMENT '会话唯一 ID，同时也是该会话刷新令牌的令牌族 ID',
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '会话所属用户的唯一 ID',
+  `clientIP` varchar(64) NOT NULL DEFAULT '' COMMENT '登录时的客户端 IP',
+  `userAgent` varchar(512) NOT NULL DEFAULT '' COMMENT '登录时的客户端 User-Agent',
+  `lastSeenAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '会话最后一次使用的时间',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '会话创建时间，即登录时间',
+  `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '会话最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_user_session_sessionID` (`sessionID`),
+  KEY `idx_user_session_userID` (`userID`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='用户登录会话表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `user_session`
+--
+
+LOCK TABLES `user_session` WRITE;
+/*!40000 ALTER TABLE `user_session` DISABLE KEYS */;
+/*!40000 ALTER TABLE `user_session` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `user_token`
+--
+
+DROP TABLE IF EXISTS `user_token`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `user_token` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '令牌所属用户的唯一 ID',
+  `purpose` varchar(32) NOT NULL DEFAULT '' COMMENT '令牌用途，例如 email-verification',
+  `tokenHash` char(64) NOT NULL DEFAULT '' COMMENT '令牌的 SHA-256 摘要（十六进制），不保存令牌明文',
+  `payload` varchar(256) NOT NULL DEFAULT '' COMMENT '令牌关联的数据，例如待验证的电子邮箱地址',
+  `expiresAt` datetime NOT NULL COMMENT '令牌过期时间',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '令牌创建时间',
+  `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '令牌最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_user_token_tokenHash` (`tokenHash`),
+  KEY `idx_user_token_userID_purpose` (`userID`,`purpose`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='用户一次性令牌表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `user_token`
+--
+
+LOCK TABLES `user_token` WRITE;
+/*!40000 ALTER TABLE `user_token` DISABLE KEYS */;
+/*!40000 ALTER TABLE `user_token` ENABLE KEYS */;
+UNLOCK TABLES;
+
 /*!40103 SET TIME_ZONE=@OLD_TIME_ZONE */;
 
 /*!40101 SET SQL_MODE=@OLD_SQL_MODE */;
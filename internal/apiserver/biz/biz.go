@@ -2,8 +2,19 @@ package biz
 
 import (
 	"github.com/google/wire"
+	analyticsv1 "github.com/ra1n6ow/miniblog/internal/apiserver/biz/v1/analytics"
+	bookmarkv1 "github.com/ra1n6ow/miniblog/internal/apiserver/biz/v1/bookmark"
+	policyv1 "github.com/ra1n6ow/miniblog/internal/apiserver/biz/v1/policy"
 	postv1 "github.com/ra1n6ow/miniblog/internal/apiserver/biz/v1/post"
+	previewv1 "github.com/ra1n6ow/miniblog/internal/apiserver/biz/v1/preview"
+	reactionv1 "github.com/ra1n6ow/miniblog/internal/apiserver/biz/v1/reaction"
+	templatev1 "github.com/ra1n6ow/miniblog/internal/apiserver/biz/v1/template"
 	userv1 "github.com/ra1n6ow/miniblog/internal/apiserver/biz/v1/user"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/related"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/revocation"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/usercache"
+	"github.com/ra1n6ow/miniblog/internal/pkg/mailer"
+	"github.com/ra1n6ow/miniblog/internal/pkg/oidc"
 	"github.com/ra1n6ow/miniblog/pkg/auth"
 
 	// Post V2 版本（未实现，仅展示用）
@@ -23,6 +34,18 @@ type IBiz interface {
 	UserV1() userv1.UserBiz
 	// 获取帖子业务接口.
 	PostV1() postv1.PostBiz
+	// 获取博客反应业务接口.
+	ReactionV1() reactionv1.ReactionBiz
+	// 获取书签业务接口.
+	BookmarkV1() bookmarkv1.BookmarkBiz
+	// 获取统计分析业务接口.
+	AnalyticsV1() analyticsv1.AnalyticsBiz
+	// 获取博客模板业务接口.
+	TemplateV1() templatev1.TemplateBiz
+	// 获取博客预览链接业务接口.
+	PreviewV1() previewv1.PreviewBiz
+	// 获取授权策略管理业务接口.
+	PolicyV1() policyv1.PolicyBiz
 	// 获取帖子业务接口（V2版本）.
 	// PostV2() post.PostBiz
 }
@@ -31,22 +54,92 @@ type IBiz interface {
 type biz struct {
 	store store.IStore
 	authz *auth.Authz
+	// index 为相关博客索引，在帖子创建、更新和删除时增量维护.
+	index *related.Index
+	// postOptions 为帖子业务的可配置项.
+	postOptions *postv1.Options
+	// previewOptions 为预览链接业务的可配置项.
+	previewOptions *previewv1.Options
+	// userOptions 为用户业务的可配置项.
+	userOptions *userv1.Options
+	// mailer 用于发送验证邮件等通知邮件.
+	mailer mailer.Mailer
+	// userGuards 为用户业务共享的限流器和登录失败记录.
+	userGuards *userv1.Guards
+	// revocations 为访问令牌的撤销列表，和认证中间件使用同一个实例.
+	revocations *revocation.List
+	// users 为用户信息缓存，和认证中间件使用同一个实例.
+	users *usercache.Cache
+	// oidcProviders 为单点登录的身份提供方，缓存了身份提供方的配置和公钥.
+	oidcProviders map[string]*oidc.Provider
 }
 
 // 确保 biz 实现了 IBiz 接口.
 var _ IBiz = (*biz)(nil)
 
 // NewBiz 创建一个 IBiz 类型的实例.
-func NewBiz(store store.IStore, authz *auth.Authz) *biz {
-	return &biz{store: store, authz: authz}
+func NewBiz(
+	store store.IStore,
+	authz *auth.Authz,
+	index *related.Index,
+	postOptions *postv1.Options,
+	previewOptions *previewv1.Options,
+	userOptions *userv1.Options,
+	mailer mailer.Mailer,
+	revocations *revocation.List,
+	users *usercache.Cache,
+) *biz {
+	return &biz{
+		store:          store,
+		authz:          authz,
+		index:          index,
+		postOptions:    postOptions,
+		previewOptions: previewOptions,
+		userOptions:    userOptions,
+		mailer:         mailer,
+		userGuards:     userv1.NewGuards(userOptions),
+		revocations:    revocations,
+		users:          users,
+		oidcProviders:  userv1.NewOIDCProviders(userOptions),
+	}
 }
 
 // UserV1 返回一个实现了 UserBiz 接口的实例.
 func (b *biz) UserV1() userv1.UserBiz {
-	return userv1.New(b.store, b.authz)
+	return userv1.New(b.store, b.authz, b.mailer, b.userGuards, b.revocations, b.users, b.oidcProviders, b.userOptions)
 }
 
 // PostV1 返回一个实现了 PostBiz 接口的实例.
 func (b *biz) PostV1() postv1.PostBiz {
-	return postv1.New(b.store)
+	return postv1.New(b.store, b.index, b.postOptions)
+}
+
+// ReactionV1 返回一个实现了 ReactionBiz 接口的实例.
+func (b *biz) ReactionV1() reactionv1.ReactionBiz {
+	return reactionv1.New(b.store)
+}
+
+// BookmarkV1 返回一个实现了 BookmarkBiz 接口的实例.
+func (b *biz) BookmarkV1() bookmarkv1.BookmarkBiz {
+	return bookmarkv1.New(b.store)
+}
+
+// AnalyticsV1 返回一个实现了 AnalyticsBiz 接口的实例.
+func (b *biz) AnalyticsV1() analyticsv1.AnalyticsBiz {
+	return analyticsv1.New(b.store)
+}
+
+// TemplateV1 返回一个实现了 TemplateBiz 接口的实例.
+func (b *biz) TemplateV1() templatev1.TemplateBiz {
+	return templatev1.New(b.store, b.PostV1())
+}
+
+// PreviewV1 返回一个实现了 PreviewBiz 接口的实例.
+func (b *biz) PreviewV1() previewv1.PreviewBiz {
+	return previewv1.New(b.store, b.PostV1(), b.previewOptions)
+}
+
+// PolicyV1 返回一个实现了 PolicyBiz 接口的实例.
+func (b *biz) PolicyV1() policyv1.PolicyBiz {
+	return policyv1.New(b.store, b.authz, b.users)
 }
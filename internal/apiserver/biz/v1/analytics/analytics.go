@@ -0,0 +1,277 @@
+package analytics
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/period"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/store"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// AnalyticsBiz 定义处理统计分析请求所需的方法.
+type AnalyticsBiz interface {
+	ListPostActivity(ctx context.Context, rq *apiv1.ListPostActivityRequest) (*apiv1.ListPostActivityResponse, error)
+	ListTopAuthors(ctx context.Context, rq *apiv1.ListTopAuthorsRequest) (*apiv1.ListTopAuthorsResponse, error)
+	ListSiteStats(ctx context.Context, rq *apiv1.ListSiteStatsRequest) (*apiv1.ListSiteStatsResponse, error)
+	GetMyPostStats(ctx context.Context, rq *apiv1.GetMyPostStatsRequest) (*apiv1.GetMyPostStatsResponse, error)
+
+	AnalyticsExpansion
+}
+
+// AnalyticsExpansion 定义额外的统计分析操作方法.
+type AnalyticsExpansion interface {
+	// Rollup 将 post 和 user 表中的数据汇总到统计表中，由后台任务定期调用.
+	Rollup(ctx context.Context) error
+}
+
+// defaultTopAuthorsLimit 为未指定数量时返回的作者数量.
+const defaultTopAuthorsLimit = 10
+
+// analyticsBiz 是 AnalyticsBiz 接口的实现.
+type analyticsBiz struct {
+	store store.IStore
+}
+
+// 确保 analyticsBiz 实现了 AnalyticsBiz 接口.
+var _ AnalyticsBiz = (*analyticsBiz)(nil)
+
+// New 创建 analyticsBiz 的实例.
+func New(store store.IStore) *analyticsBiz {
+	return &analyticsBiz{store: store}
+}
+
+// ListPostActivity 实现 AnalyticsBiz 接口中的 ListPostActivity 方法.
+func (b *analyticsBiz) ListPostActivity(ctx context.Context, rq *apiv1.ListPostActivityRequest) (*apiv1.ListPostActivityResponse, error) {
+	r, err := parseRange(rq.GetStartDate(), rq.GetEndDate())
+	if err != nil {
+		return nil, err
+	}
+
+	whr := where.NewWhere().Q("day BETWEEN ? AND ?", r.From, r.To)
+	if rq.UserID != nil {
+		whr = whr.F("userID", rq.GetUserID())
+	}
+	_, stats, err := b.store.Analytics().ListPostStats(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.ListPostActivityResponse{Activities: postActivities(stats, rq.GetGranularity())}, nil
+}
+
+// ListTopAuthors 实现 AnalyticsBiz 接口中的 ListTopAuthors 方法.
+func (b *analyticsBiz) ListTopAuthors(ctx context.Context, rq *apiv1.ListTopAuthorsRequest) (*apiv1.ListTopAuthorsResponse, error) {
+	r, err := parseRange(rq.GetStartDate(), rq.GetEndDate())
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(rq.GetLimit())
+	if limit <= 0 {
+		limit = defaultTopAuthorsLimit
+	}
+	counts, err := b.store.Analytics().TopAuthors(ctx, r.From, r.To, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(counts) == 0 {
+		return &apiv1.ListTopAuthorsResponse{Authors: []*apiv1.AuthorActivity{}}, nil
+	}
+
+	userIDs := make([]string, 0, len(counts))
+	for _, count := range counts {
+		userIDs = append(userIDs, count.UserID)
+	}
+	_, userList, err := b.store.User().List(ctx, where.F("userID", userIDs))
+	if err != nil {
+		return nil, err
+	}
+	usernames := make(map[string]string, len(userList))
+	for _, user := range userList {
+		usernames[user.UserID] = user.Username
+	}
+
+	authors := make([]*apiv1.AuthorActivity, 0, len(counts))
+	for _, count := range counts {
+		authors = append(authors, &apiv1.AuthorActivity{UserID: count.UserID, Username: usernames[count.UserID], PostCount: count.PostCount})
+	}
+
+	return &apiv1.ListTopAuthorsResponse{Authors: authors}, nil
+}
+
+// ListSiteStats 实现 AnalyticsBiz 接口中的 ListSiteStats 方法.
+func (b *analyticsBiz) ListSiteStats(ctx context.Context, rq *apiv1.ListSiteStatsRequest) (*apiv1.ListSiteStatsResponse, error) {
+	r, err := parseRange(rq.GetStartDate(), rq.GetEndDate())
+	if err != nil {
+		return nil, err
+	}
+
+	_, stats, err := b.store.Analytics().ListSiteStats(ctx, where.NewWhere().Q("day BETWEEN ? AND ?", r.From, r.To))
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.ListSiteStatsResponse{Stats: siteStats(stats, rq.GetGranularity())}, nil
+}
+
+// GetMyPostStats 实现 AnalyticsBiz 接口中的 GetMyPostStats 方法.
+func (b *analyticsBiz) GetMyPostStats(ctx context.Context, rq *apiv1.GetMyPostStatsRequest) (*apiv1.GetMyPostStatsResponse, error) {
+	r, err := parseRange(rq.GetStartDate(), rq.GetEndDate())
+	if err != nil {
+		return nil, err
+	}
+
+	whr := where.T(ctx).Q("day BETWEEN ? AND ?", r.From, r.To)
+	_, stats, err := b.store.Analytics().ListPostStats(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	var count int64
+	for _, stat := range stats {
+		count += stat.PostCount
+	}
+
+	return &apiv1.GetMyPostStatsResponse{PostCount: count, Activities: postActivities(stats, rq.GetGranularity())}, nil
+}
+
+// Rollup 实现 AnalyticsBiz 接口中的 Rollup 方法.
+// 每次汇总都会重新计算上次汇总的最后一天到今天的数据，因此可以安全地重复执行.
+// 已经汇总过的更早日期不会再重新计算，之后删除的博客和用户不影响这些日期的统计.
+func (b *analyticsBiz) Rollup(ctx context.Context) error {
+	today := period.Day(time.Now())
+
+	latest, err := b.store.Analytics().LatestSiteStat(ctx)
+	if err != nil {
+		return err
+	}
+
+	// 第一次汇总时从最早的用户创建日期开始，之后从上次汇总的最后一天开始
+	base := &model.SiteDailyStatM{}
+	since := today
+	if latest != nil {
+		since = period.Day(latest.Day)
+		base.TotalUsers = latest.TotalUsers - latest.NewUsers
+		base.TotalPosts = latest.TotalPosts - latest.NewPosts
+	} else {
+		first, err := b.store.Analytics().FirstUserCreatedAt(ctx)
+		if err != nil {
+			return err
+		}
+		if !first.IsZero() {
+			since = period.Day(first)
+		}
+	}
+
+	postStats, err := b.store.Analytics().CountPostsByDay(ctx, since)
+	if err != nil {
+		return err
+	}
+	newUsers, err := b.store.Analytics().CountUsersByDay(ctx, since)
+	if err != nil {
+		return err
+	}
+	dailyStats := rollupSiteStats(since, today, base, postStats, newUsers)
+
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.Analytics().ReplacePostStats(ctx, since, postStats); err != nil {
+			return err
+		}
+		return b.store.Analytics().ReplaceSiteStats(ctx, since, dailyStats)
+	})
+	if err != nil {
+		return err
+	}
+
+	log.W(ctx).Infow("Analytics rolled up", "since", since.Format(time.DateOnly), "days", len(dailyStats), "postStats", len(postStats))
+	return nil
+}
+
+// parseRange 解析请求中的日期范围.
+func parseRange(start string, end string) (period.Range, error) {
+	r, err := period.ParseRange(start, end, time.Now())
+	if err != nil {
+		return period.Range{}, errno.ErrInvalidArgument.WithMessage("%s", err.Error())
+	}
+	return r, nil
+}
+
+// rollupSiteStats 根据每天新增的博客和用户数量，计算 [since, today] 范围内每天的全站统计.
+// base 为 since 前一天结束时的用户总数和博客总数.
+func rollupSiteStats(since time.Time, today time.Time, base *model.SiteDailyStatM, postStats []*model.PostDailyStatM, newUsers map[string]int64) []*model.SiteDailyStatM {
+	newPosts := make(map[string]int64)
+	for _, stat := range postStats {
+		newPosts[period.Day(stat.Day).Format(time.DateOnly)] += stat.PostCount
+	}
+
+	var ret []*model.SiteDailyStatM
+	totalUsers, totalPosts := base.TotalUsers, base.TotalPosts
+	for day := since; !day.After(today); day = day.AddDate(0, 0, 1) {
+		key := day.Format(time.DateOnly)
+		totalUsers += newUsers[key]
+		totalPosts += newPosts[key]
+		ret = append(ret, &model.SiteDailyStatM{
+			Day:        day,
+			NewUsers:   newUsers[key],
+			NewPosts:   newPosts[key],
+			TotalUsers: totalUsers,
+			TotalPosts: totalPosts,
+		})
+	}
+	return ret
+}
+
+// postActivities 将博客每日统计按统计周期和用户合并，结果按日期和用户排序.
+func postActivities(stats []*model.PostDailyStatM, granularity string) []*apiv1.PostActivity {
+	type key struct {
+		date   string
+		userID string
+	}
+
+	counts := make(map[key]int64)
+	for _, stat := range stats {
+		counts[key{period.Bucket(stat.Day, granularity).Format(time.DateOnly), stat.UserID}] += stat.PostCount
+	}
+
+	ret := make([]*apiv1.PostActivity, 0, len(counts))
+	for k, count := range counts {
+		ret = append(ret, &apiv1.PostActivity{Date: k.date, UserID: k.userID, PostCount: count})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Date != ret[j].Date {
+			return ret[i].Date < ret[j].Date
+		}
+		return ret[i].UserID < ret[j].UserID
+	})
+	return ret
+}
+
+// siteStats 将全站每日统计按统计周期合并. 新增数量取周期内的总和，总数取周期内最后一天的值.
+func siteStats(stats []*model.SiteDailyStatM, granularity string) []*apiv1.SiteStat {
+	ret := make([]*apiv1.SiteStat, 0, len(stats))
+	for _, stat := range stats {
+		date := period.Bucket(stat.Day, granularity).Format(time.DateOnly)
+		if n := len(ret); n > 0 && ret[n-1].Date == date {
+			ret[n-1].NewUsers += stat.NewUsers
+			ret[n-1].NewPosts += stat.NewPosts
+			ret[n-1].TotalUsers = stat.TotalUsers
+			ret[n-1].TotalPosts = stat.TotalPosts
+			continue
+		}
+		ret = append(ret, &apiv1.SiteStat{
+			Date:       date,
+			NewUsers:   stat.NewUsers,
+			NewPosts:   stat.NewPosts,
+			TotalUsers: stat.TotalUsers,
+			TotalPosts: stat.TotalPosts,
+		})
+	}
+	return ret
+}
@@ -0,0 +1,84 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/period"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+func day(s string) time.Time {
+	t, _ := time.ParseInLocation(time.DateOnly, s, time.Local)
+	return t
+}
+
+func TestRollupSiteStats(t *testing.T) {
+	base := &model.SiteDailyStatM{TotalUsers: 10, TotalPosts: 100}
+	postStats := []*model.PostDailyStatM{
+		{Day: day("2024-03-01"), UserID: "user-a", PostCount: 2},
+		{Day: day("2024-03-01"), UserID: "user-b", PostCount: 1},
+		{Day: day("2024-03-03"), UserID: "user-a", PostCount: 4},
+	}
+	newUsers := map[string]int64{"2024-03-02": 3}
+
+	got := rollupSiteStats(day("2024-03-01"), day("2024-03-03"), base, postStats, newUsers)
+
+	assert.Len(t, got, 3)
+	want := []struct {
+		newUsers, newPosts, totalUsers, totalPosts int64
+	}{
+		{0, 3, 10, 103},
+		{3, 0, 13, 103},
+		{0, 4, 13, 107},
+	}
+	for i, w := range want {
+		assert.True(t, day("2024-03-01").AddDate(0, 0, i).Equal(got[i].Day))
+		assert.Equal(t, w.newUsers, got[i].NewUsers)
+		assert.Equal(t, w.newPosts, got[i].NewPosts)
+		assert.Equal(t, w.totalUsers, got[i].TotalUsers)
+		assert.Equal(t, w.totalPosts, got[i].TotalPosts)
+	}
+}
+
+func TestPostActivities(t *testing.T) {
+	// 2024-03-11 是周一
+	stats := []*model.PostDailyStatM{
+		{Day: day("2024-03-17"), UserID: "user-a", PostCount: 1},
+		{Day: day("2024-03-11"), UserID: "user-b", PostCount: 2},
+		{Day: day("2024-03-11"), UserID: "user-a", PostCount: 3},
+		{Day: day("2024-03-18"), UserID: "user-a", PostCount: 5},
+	}
+
+	daily := postActivities(stats, period.GranularityDay)
+	assert.Equal(t, []*apiv1.PostActivity{
+		{Date: "2024-03-11", UserID: "user-a", PostCount: 3},
+		{Date: "2024-03-11", UserID: "user-b", PostCount: 2},
+		{Date: "2024-03-17", UserID: "user-a", PostCount: 1},
+		{Date: "2024-03-18", UserID: "user-a", PostCount: 5},
+	}, daily)
+
+	weekly := postActivities(stats, period.GranularityWeek)
+	assert.Equal(t, []*apiv1.PostActivity{
+		{Date: "2024-03-11", UserID: "user-a", PostCount: 4},
+		{Date: "2024-03-11", UserID: "user-b", PostCount: 2},
+		{Date: "2024-03-18", UserID: "user-a", PostCount: 5},
+	}, weekly)
+}
+
+func TestSiteStats(t *testing.T) {
+	stats := []*model.SiteDailyStatM{
+		{Day: day("2024-03-16"), NewUsers: 1, NewPosts: 2, TotalUsers: 11, TotalPosts: 102},
+		{Day: day("2024-03-17"), NewUsers: 2, NewPosts: 3, TotalUsers: 13, TotalPosts: 105},
+		{Day: day("2024-03-18"), NewUsers: 0, NewPosts: 1, TotalUsers: 13, TotalPosts: 106},
+	}
+
+	assert.Len(t, siteStats(stats, period.GranularityDay), 3)
+	assert.Equal(t, []*apiv1.SiteStat{
+		{Date: "2024-03-11", NewUsers: 3, NewPosts: 5, TotalUsers: 13, TotalPosts: 105},
+		{Date: "2024-03-18", NewUsers: 0, NewPosts: 1, TotalUsers: 13, TotalPosts: 106},
+	}, siteStats(stats, period.GranularityWeek))
+}
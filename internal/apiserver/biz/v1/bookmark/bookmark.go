@@ -0,0 +1,201 @@
+package bookmark
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/store"
+	"github.com/ra1n6ow/miniblog/internal/pkg/contextx"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// BookmarkBiz 定义处理书签请求所需的方法.
+type BookmarkBiz interface {
+	Add(ctx context.Context, rq *apiv1.AddBookmarkRequest) (*apiv1.AddBookmarkResponse, error)
+	Remove(ctx context.Context, rq *apiv1.RemoveBookmarkRequest) (*apiv1.RemoveBookmarkResponse, error)
+	List(ctx context.Context, rq *apiv1.ListBookmarkRequest) (*apiv1.ListBookmarkResponse, error)
+
+	BookmarkExpansion
+}
+
+// BookmarkExpansion 定义额外的书签操作方法.
+type BookmarkExpansion interface {
+	Reorder(ctx context.Context, rq *apiv1.ReorderBookmarksRequest) (*apiv1.ReorderBookmarksResponse, error)
+	ListReadingList(ctx context.Context, rq *apiv1.ListReadingListRequest) (*apiv1.ListReadingListResponse, error)
+}
+
+// bookmarkBiz 是 BookmarkBiz 接口的实现.
+type bookmarkBiz struct {
+	store store.IStore
+}
+
+// 确保 bookmarkBiz 实现了 BookmarkBiz 接口.
+var _ BookmarkBiz = (*bookmarkBiz)(nil)
+
+// New 创建 bookmarkBiz 的实例.
+func New(store store.IStore) *bookmarkBiz {
+	return &bookmarkBiz{store: store}
+}
+
+// Add 实现 BookmarkBiz 接口中的 Add 方法. 书签已存在时会移动到指定阅读列表的末尾.
+func (b *bookmarkBiz) Add(ctx context.Context, rq *apiv1.AddBookmarkRequest) (*apiv1.AddBookmarkResponse, error) {
+	// 可以收藏任意用户的博客，所以这里不用 where.T()
+	if _, err := b.store.Post().Get(ctx, where.F("postID", rq.GetPostID())); err != nil {
+		return nil, err
+	}
+
+	userID := contextx.UserID(ctx)
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		bookmarkM, err := b.store.Bookmark().Get(ctx, where.T(ctx).F("postID", rq.GetPostID()))
+		if err != nil && !errors.Is(err, errno.ErrBookmarkNotFound) {
+			return err
+		}
+		if bookmarkM != nil && bookmarkM.ListName == rq.GetListName() {
+			return nil
+		}
+
+		pos, err := b.store.Bookmark().MaxPosition(ctx, userID, rq.GetListName())
+		if err != nil {
+			return err
+		}
+
+		if bookmarkM == nil {
+			return b.store.Bookmark().Create(ctx, &model.BookmarkM{
+				UserID:   userID,
+				PostID:   rq.GetPostID(),
+				ListName: rq.GetListName(),
+				Position: pos + 1,
+			})
+		}
+
+		bookmarkM.ListName = rq.GetListName()
+		bookmarkM.Position = pos + 1
+		return b.store.Bookmark().Update(ctx, bookmarkM)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.AddBookmarkResponse{}, nil
+}
+
+// Remove 实现 BookmarkBiz 接口中的 Remove 方法.
+func (b *bookmarkBiz) Remove(ctx context.Context, rq *apiv1.RemoveBookmarkRequest) (*apiv1.RemoveBookmarkResponse, error) {
+	if err := b.store.Bookmark().Delete(ctx, where.T(ctx).F("postID", rq.GetPostID())); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.RemoveBookmarkResponse{}, nil
+}
+
+// List 实现 BookmarkBiz 接口中的 List 方法. 博客已被删除的书签会被标记为 postDeleted.
+func (b *bookmarkBiz) List(ctx context.Context, rq *apiv1.ListBookmarkRequest) (*apiv1.ListBookmarkResponse, error) {
+	whr := where.T(ctx).O(int(rq.GetOffset())).L(int(rq.GetLimit()))
+	if rq.ListName != nil {
+		whr.F("listName", rq.GetListName())
+	}
+	count, bookmarkList, err := b.store.Bookmark().List(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	postIDs := make([]string, 0, len(bookmarkList))
+	for _, bookmark := range bookmarkList {
+		postIDs = append(postIDs, bookmark.PostID)
+	}
+	posts := make(map[string]*apiv1.Post, len(postIDs))
+	if len(postIDs) > 0 {
+		_, postList, err := b.store.Post().List(ctx, where.F("postID", postIDs))
+		if err != nil {
+			return nil, err
+		}
+		for _, post := range postList {
+			converted := conversion.PostModelToPostV1(post)
+			converted.Bookmarked = true
+			posts[post.PostID] = converted
+		}
+	}
+
+	bookmarks := make([]*apiv1.Bookmark, 0, len(bookmarkList))
+	for _, bookmark := range bookmarkList {
+		post, ok := posts[bookmark.PostID]
+		bookmarks = append(bookmarks, &apiv1.Bookmark{
+			PostID:      bookmark.PostID,
+			ListName:    bookmark.ListName,
+			Position:    bookmark.Position,
+			Post:        post,
+			PostDeleted: !ok,
+			CreatedAt:   timestamppb.New(bookmark.CreatedAt),
+		})
+	}
+
+	return &apiv1.ListBookmarkResponse{TotalCount: count, Bookmarks: bookmarks}, nil
+}
+
+// Reorder 实现 BookmarkBiz 接口中的 Reorder 方法.
+// 请求中列出的书签按给定顺序排在最前面，未列出的书签保持原有顺序排在后面.
+func (b *bookmarkBiz) Reorder(ctx context.Context, rq *apiv1.ReorderBookmarksRequest) (*apiv1.ReorderBookmarksResponse, error) {
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		_, bookmarkList, err := b.store.Bookmark().List(ctx, where.T(ctx).F("listName", rq.GetListName()))
+		if err != nil {
+			return err
+		}
+
+		byPostID := make(map[string]*model.BookmarkM, len(bookmarkList))
+		for _, bookmark := range bookmarkList {
+			byPostID[bookmark.PostID] = bookmark
+		}
+
+		ordered := make([]*model.BookmarkM, 0, len(bookmarkList))
+		for _, postID := range rq.GetPostIDs() {
+			bookmark, ok := byPostID[postID]
+			if !ok {
+				return errno.ErrBookmarkNotFound.WithMessage("bookmark of post %s not found in reading list %q", postID, rq.GetListName())
+			}
+			ordered = append(ordered, bookmark)
+			delete(byPostID, postID)
+		}
+		for _, bookmark := range bookmarkList {
+			if _, ok := byPostID[bookmark.PostID]; ok {
+				ordered = append(ordered, bookmark)
+			}
+		}
+
+		for i, bookmark := range ordered {
+			if bookmark.Position == int64(i) {
+				continue
+			}
+			bookmark.Position = int64(i)
+			if err := b.store.Bookmark().Update(ctx, bookmark); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.ReorderBookmarksResponse{}, nil
+}
+
+// ListReadingList 实现 BookmarkBiz 接口中的 ListReadingList 方法.
+func (b *bookmarkBiz) ListReadingList(ctx context.Context, rq *apiv1.ListReadingListRequest) (*apiv1.ListReadingListResponse, error) {
+	lists, err := b.store.Bookmark().ReadingLists(ctx, contextx.UserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	readingLists := make([]*apiv1.ReadingList, 0, len(lists))
+	for _, list := range lists {
+		readingLists = append(readingLists, &apiv1.ReadingList{Name: list.ListName, BookmarkCount: list.Count})
+	}
+
+	return &apiv1.ListReadingListResponse{ReadingLists: readingLists}, nil
+}
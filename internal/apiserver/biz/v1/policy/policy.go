@@ -0,0 +1,338 @@
+package policy
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/usercache"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/store"
+	"github.com/ra1n6ow/miniblog/internal/pkg/contextx"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/known"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+	"github.com/ra1n6ow/miniblog/pkg/auth"
+)
+
+// PolicyBiz 定义管理 casbin 授权策略和角色分配所需的方法.
+// 所有方法只允许管理员调用.
+type PolicyBiz interface {
+	ListPolicies(ctx context.Context, rq *apiv1.ListPoliciesRequest) (*apiv1.ListPoliciesResponse, error)
+	CreatePolicy(ctx context.Context, rq *apiv1.CreatePolicyRequest) (*apiv1.CreatePolicyResponse, error)
+	DeletePolicy(ctx context.Context, rq *apiv1.DeletePolicyRequest) (*apiv1.DeletePolicyResponse, error)
+	ReplacePolicies(ctx context.Context, rq *apiv1.ReplacePoliciesRequest) (*apiv1.ReplacePoliciesResponse, error)
+	ListRoleAssignments(ctx context.Context, rq *apiv1.ListRoleAssignmentsRequest) (*apiv1.ListRoleAssignmentsResponse, error)
+	AssignRole(ctx context.Context, rq *apiv1.AssignRoleRequest) (*apiv1.AssignRoleResponse, error)
+	UnassignRole(ctx context.Context, rq *apiv1.UnassignRoleRequest) (*apiv1.UnassignRoleResponse, error)
+	ListUserRoles(ctx context.Context, rq *apiv1.ListUserRolesRequest) (*apiv1.ListUserRolesResponse, error)
+	ListRoleUsers(ctx context.Context, rq *apiv1.ListRoleUsersRequest) (*apiv1.ListRoleUsersResponse, error)
+
+	PolicyExpansion
+}
+
+// PolicyExpansion 定义额外的授权策略操作方法.
+type PolicyExpansion interface{}
+
+// adminPolicy 为管理员的通配策略. 删除该策略后管理员无法再访问管理接口，因此不允许删除.
+var adminPolicy = []string{known.RoleAdmin, "*", "*", "allow"}
+
+// policyBiz 是 PolicyBiz 接口的实现.
+type policyBiz struct {
+	store store.IStore
+	authz *auth.Authz
+	// users 为认证中间件使用的用户信息缓存，修改用户的角色后需要清除.
+	users *usercache.Cache
+}
+
+// 确保 policyBiz 实现了 PolicyBiz 接口.
+var _ PolicyBiz = (*policyBiz)(nil)
+
+// New 创建 policyBiz 的实例.
+func New(store store.IStore, authz *auth.Authz, users *usercache.Cache) *policyBiz {
+	return &policyBiz{store: store, authz: authz, users: users}
+}
+
+// ListPolicies 实现 PolicyBiz 接口中的 ListPolicies 方法.
+func (b *policyBiz) ListPolicies(ctx context.Context, rq *apiv1.ListPoliciesRequest) (*apiv1.ListPoliciesResponse, error) {
+	if err := b.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	var (
+		rules [][]string
+		err   error
+	)
+	if rq.Subject != nil {
+		rules, err = b.authz.GetFilteredPolicy(0, rq.GetSubject())
+	} else {
+		rules, err = b.authz.GetPolicy()
+	}
+	if err != nil {
+		return nil, errno.ErrInternal.WithMessage("failed to list policies: %s", err.Error())
+	}
+
+	policies := make([]*apiv1.Policy, 0, len(rules))
+	for _, rule := range rules {
+		policies = append(policies, toPolicy(rule))
+	}
+	return &apiv1.ListPoliciesResponse{Policies: policies}, nil
+}
+
+// CreatePolicy 实现 PolicyBiz 接口中的 CreatePolicy 方法.
+func (b *policyBiz) CreatePolicy(ctx context.Context, rq *apiv1.CreatePolicyRequest) (*apiv1.CreatePolicyResponse, error) {
+	if err := b.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	rule := toRule(rq.GetPolicy())
+	ok, err := b.authz.AddPolicy(rule)
+	if err != nil {
+		return nil, errno.ErrInternal.WithMessage("failed to add policy: %s", err.Error())
+	}
+	if !ok {
+		return nil, errno.ErrPolicyAlreadyExist
+	}
+
+	log.W(ctx).Infow("Policy added", "policy", rule)
+	return &apiv1.CreatePolicyResponse{}, nil
+}
+
+// DeletePolicy 实现 PolicyBiz 接口中的 DeletePolicy 方法.
+// 管理员的通配策略和普通用户角色的最后一条拒绝策略不允许删除.
+func (b *policyBiz) DeletePolicy(ctx context.Context, rq *apiv1.DeletePolicyRequest) (*apiv1.DeletePolicyResponse, error) {
+	if err := b.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	rule := toRule(rq.GetPolicy())
+	if slices.Equal(rule, adminPolicy) {
+		return nil, errno.ErrPolicyProtected
+	}
+	if rule[0] == known.RoleUser {
+		rules, err := b.authz.GetFilteredPolicy(0, known.RoleUser)
+		if err != nil {
+			return nil, errno.ErrInternal.WithMessage("failed to list policies: %s", err.Error())
+		}
+		rules = slices.DeleteFunc(rules, func(r []string) bool { return slices.Equal(r, rule) })
+		if !hasUserDeny(rules) {
+			return nil, errno.ErrPolicyUnrestricted
+		}
+	}
+	ok, err := b.authz.RemovePolicy(rule)
+	if err != nil {
+		return nil, errno.ErrInternal.WithMessage("failed to remove policy: %s", err.Error())
+	}
+	if !ok {
+		return nil, errno.ErrPolicyNotFound
+	}
+
+	log.W(ctx).Infow("Policy removed", "policy", rule)
+	return &apiv1.DeletePolicyResponse{}, nil
+}
+
+// ReplacePolicies 实现 PolicyBiz 接口中的 ReplacePolicies 方法.
+// 新的策略必须包含管理员的通配策略，避免替换后管理员无法再修改策略. 新的策略还必须包含普通用户角色的拒绝策略，
+// 否则替换后普通用户可以访问所有接口.
+func (b *policyBiz) ReplacePolicies(ctx context.Context, rq *apiv1.ReplacePoliciesRequest) (*apiv1.ReplacePoliciesResponse, error) {
+	if err := b.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	rules := make([][]string, 0, len(rq.GetPolicies()))
+	keepsAdmin := false
+	for _, policy := range rq.GetPolicies() {
+		rule := toRule(policy)
+		keepsAdmin = keepsAdmin || slices.Equal(rule, adminPolicy)
+		rules = append(rules, rule)
+	}
+	if !keepsAdmin {
+		return nil, errno.ErrPolicyProtected.WithMessage("Policies must keep the %v policy.", adminPolicy)
+	}
+	if !hasUserDeny(rules) {
+		return nil, errno.ErrPolicyUnrestricted.WithMessage("Policies must keep at least one deny policy for %s.", known.RoleUser)
+	}
+
+	if err := b.authz.ReplacePolicies(rules); err != nil {
+		return nil, errno.ErrInternal.WithMessage("failed to replace policies: %s", err.Error())
+	}
+
+	log.W(ctx).Infow("Policies replaced", "count", len(rules))
+	return &apiv1.ReplacePoliciesResponse{}, nil
+}
+
+// ListRoleAssignments 实现 PolicyBiz 接口中的 ListRoleAssignments 方法.
+func (b *policyBiz) ListRoleAssignments(ctx context.Context, rq *apiv1.ListRoleAssignmentsRequest) (*apiv1.ListRoleAssignmentsResponse, error) {
+	if err := b.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	rules, err := b.authz.GetGroupingPolicy()
+	if err != nil {
+		return nil, errno.ErrInternal.WithMessage("failed to list role assignments: %s", err.Error())
+	}
+
+	assignments := make([]*apiv1.RoleAssignment, 0, len(rules))
+	for _, rule := range rules {
+		if len(rule) < 2 {
+			continue
+		}
+		assignments = append(assignments, &apiv1.RoleAssignment{UserID: rule[0], Role: rule[1]})
+	}
+	return &apiv1.ListRoleAssignmentsResponse{Assignments: assignments}, nil
+}
+
+// AssignRole 实现 PolicyBiz 接口中的 AssignRole 方法.
+// 分配角色后会更新用户的修改时间，此前签发的访问令牌中的角色不再被使用，新的角色在令牌刷新后写入令牌.
+// 授权始终以 casbin 中的角色分配为准.
+func (b *policyBiz) AssignRole(ctx context.Context, rq *apiv1.AssignRoleRequest) (*apiv1.AssignRoleResponse, error) {
+	if err := b.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	userM, err := b.store.User().Get(ctx, where.F("userID", rq.GetUserID()))
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := b.authz.AddGroupingPolicy(rq.GetUserID(), rq.GetRole())
+	if err != nil {
+		log.W(ctx).Errorw("Failed to add grouping policy for user", "user", rq.GetUserID(), "role", rq.GetRole())
+		return nil, errno.ErrAddRole.WithMessage("%s", err.Error())
+	}
+	if !ok {
+		return nil, errno.ErrRoleAlreadyAssigned
+	}
+	if err := b.touchUser(ctx, userM); err != nil {
+		return nil, err
+	}
+
+	log.W(ctx).Infow("Role assigned", "userID", rq.GetUserID(), "role", rq.GetRole())
+	return &apiv1.AssignRoleResponse{}, nil
+}
+
+// UnassignRole 实现 PolicyBiz 接口中的 UnassignRole 方法.
+// 管理员不能移除自己的管理员角色，避免误操作后没有管理员. 移除后用户既没有普通用户角色也没有管理员角色时拒绝移除，
+// 没有任何拒绝策略的用户可以访问所有接口. 和 AssignRole 一样，移除角色后此前签发的访问令牌中的角色不再被使用.
+func (b *policyBiz) UnassignRole(ctx context.Context, rq *apiv1.UnassignRoleRequest) (*apiv1.UnassignRoleResponse, error) {
+	if err := b.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if rq.GetRole() == known.RoleAdmin && rq.GetUserID() == contextx.UserID(ctx) {
+		return nil, errno.ErrPolicyProtected.WithMessage("Administrators cannot remove their own %s role.", known.RoleAdmin)
+	}
+
+	userM, err := b.store.User().Get(ctx, where.F("userID", rq.GetUserID()))
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := b.authz.GetRolesForUser(rq.GetUserID())
+	if err != nil {
+		return nil, errno.ErrInternal.WithMessage("failed to get roles: %s", err.Error())
+	}
+	if !slices.Contains(roles, rq.GetRole()) {
+		return nil, errno.ErrRoleNotAssigned
+	}
+	roles = slices.DeleteFunc(roles, func(role string) bool { return role == rq.GetRole() })
+	if !slices.Contains(roles, known.RoleUser) && !slices.Contains(roles, known.RoleAdmin) {
+		return nil, errno.ErrPolicyUnrestricted.WithMessage("Users must keep the %s or %s role.", known.RoleUser, known.RoleAdmin)
+	}
+
+	ok, err := b.authz.RemoveGroupingPolicy(rq.GetUserID(), rq.GetRole())
+	if err != nil {
+		log.W(ctx).Errorw("Failed to remove grouping policy for user", "user", rq.GetUserID(), "role", rq.GetRole())
+		return nil, errno.ErrRemoveRole.WithMessage("%s", err.Error())
+	}
+	if !ok {
+		return nil, errno.ErrRoleNotAssigned
+	}
+	if err := b.touchUser(ctx, userM); err != nil {
+		return nil, err
+	}
+
+	log.W(ctx).Infow("Role unassigned", "userID", rq.GetUserID(), "role", rq.GetRole())
+	return &apiv1.UnassignRoleResponse{}, nil
+}
+
+// ListUserRoles 实现 PolicyBiz 接口中的 ListUserRoles 方法.
+func (b *policyBiz) ListUserRoles(ctx context.Context, rq *apiv1.ListUserRolesRequest) (*apiv1.ListUserRolesResponse, error) {
+	if err := b.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := b.store.User().Get(ctx, where.F("userID", rq.GetUserID())); err != nil {
+		return nil, err
+	}
+
+	roles, err := b.authz.GetRolesForUser(rq.GetUserID())
+	if err != nil {
+		return nil, errno.ErrInternal.WithMessage("failed to get roles: %s", err.Error())
+	}
+	return &apiv1.ListUserRolesResponse{Roles: roles}, nil
+}
+
+// ListRoleUsers 实现 PolicyBiz 接口中的 ListRoleUsers 方法.
+func (b *policyBiz) ListRoleUsers(ctx context.Context, rq *apiv1.ListRoleUsersRequest) (*apiv1.ListRoleUsersResponse, error) {
+	if err := b.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	userIDs, err := b.authz.GetUsersForRole(rq.GetRole())
+	if err != nil {
+		return nil, errno.ErrInternal.WithMessage("failed to get users: %s", err.Error())
+	}
+	return &apiv1.ListRoleUsersResponse{UserIDs: userIDs}, nil
+}
+
+// requireAdmin 确认当前用户拥有管理员角色. 授权中间件已经根据策略做了校验，
+// 但策略本身可以通过这些接口修改，因此这里再次校验，避免误删策略后普通用户可以修改策略.
+func (b *policyBiz) requireAdmin(ctx context.Context) error {
+	ok, err := b.authz.HasRoleForUser(contextx.UserID(ctx), known.RoleAdmin)
+	if err != nil {
+		return errno.ErrInternal.WithMessage("failed to check role: %s", err.Error())
+	}
+	if !ok {
+		return errno.ErrPermissionDenied
+	}
+	return nil
+}
+
+// hasUserDeny 判断 rules 中是否包含普通用户角色的拒绝策略. 授权模型只包含拒绝策略，没有匹配到任何拒绝策略的请求
+// 都会被放行，因此普通用户角色必须至少保留一条拒绝策略，每个用户也必须拥有普通用户角色或者管理员角色.
+func hasUserDeny(rules [][]string) bool {
+	return slices.ContainsFunc(rules, func(rule []string) bool {
+		return len(rule) >= 4 && rule[0] == known.RoleUser && rule[3] == "deny"
+	})
+}
+
+// touchUser 更新用户的修改时间并清除用户缓存. 认证中间件只使用在用户最后修改之后签发的访问令牌中的用户名和角色，
+// 因此角色变化后，旧令牌中的角色不会再被放入请求上下文.
+func (b *policyBiz) touchUser(ctx context.Context, userM *model.UserM) error {
+	userM.UpdatedAt = time.Now()
+	if err := b.store.User().Update(ctx, userM); err != nil {
+		return err
+	}
+	b.users.Forget(userM.UserID)
+	return nil
+}
+
+// toRule 将 API 中的策略转换为 casbin 的策略.
+func toRule(policy *apiv1.Policy) []string {
+	return []string{policy.GetSubject(), policy.GetObject(), policy.GetAction(), policy.GetEffect()}
+}
+
+// toPolicy 将 casbin 的策略转换为 API 中的策略.
+func toPolicy(rule []string) *apiv1.Policy {
+	field := func(i int) string {
+		if i < len(rule) {
+			return rule[i]
+		}
+		return ""
+	}
+	return &apiv1.Policy{Subject: field(0), Object: field(1), Action: field(2), Effect: field(3)}
+}
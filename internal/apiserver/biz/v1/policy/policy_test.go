@@ -0,0 +1,205 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"github.com/ra1n6ow/gpkg/store/where"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/usercache"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/store"
+	"github.com/ra1n6ow/miniblog/internal/pkg/contextx"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/known"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+	"github.com/ra1n6ow/miniblog/pkg/auth"
+)
+
+// userDenies 为测试使用的普通用户角色的拒绝策略.
+var userDenies = [][]string{
+	{known.RoleUser, "/v1/users", "GET", "deny"},
+	{known.RoleUser, "/v1/users/*", "DELETE", "deny"},
+}
+
+// newTestBiz 创建使用内存 SQLite 数据库的 policyBiz. 用户保存在所有测试共享的数据库中，
+// 授权策略保存在每个测试独立的数据库中，测试之间修改策略互不影响.
+func newTestBiz(t *testing.T) *policyBiz {
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&model.UserM{}))
+	ds := store.NewStore(db)
+
+	authz := newTestAuthz(t, t.Name())
+	_, err = authz.AddPolicies(append([][]string{adminPolicy}, userDenies...))
+	require.NoError(t, err)
+	return New(ds, authz, usercache.New(ds))
+}
+
+// newTestAuthz 创建使用名为 name 的内存 SQLite 数据库保存策略的授权器，同名的授权器共享策略.
+func newTestAuthz(t *testing.T, name string) *auth.Authz {
+	db, err := gorm.Open(sqlite.Open(fmt.Sprintf("file:%s?mode=memory&cache=shared", name)), &gorm.Config{})
+	require.NoError(t, err)
+	authz, err := auth.NewAuthz(db)
+	require.NoError(t, err)
+	t.Cleanup(authz.StopAutoLoadPolicy)
+	return authz
+}
+
+// createUser 创建拥有指定角色的用户，返回用户 ID.
+func createUser(t *testing.T, b *policyBiz, username string, roles ...string) string {
+	userM := &model.UserM{Username: username, Password: "secret", Nickname: username, Email: username + "@example.com"}
+	require.NoError(t, b.store.User().Create(context.Background(), userM))
+	for _, role := range roles {
+		_, err := b.authz.AddGroupingPolicy(userM.UserID, role)
+		require.NoError(t, err)
+	}
+	return userM.UserID
+}
+
+func TestRequireAdmin(t *testing.T) {
+	b := newTestBiz(t)
+	adminCtx := contextx.WithUserID(context.Background(), createUser(t, b, "require-admin", known.RoleAdmin))
+	userID := createUser(t, b, "require-user", known.RoleUser)
+	userCtx := contextx.WithUserID(context.Background(), userID)
+
+	_, err := b.ListPolicies(adminCtx, &apiv1.ListPoliciesRequest{})
+	assert.NoError(t, err)
+
+	// 即使策略被误改为允许普通用户访问，普通用户也不能管理策略
+	_, err = b.authz.RemovePolicies(userDenies)
+	require.NoError(t, err)
+	_, err = b.ListPolicies(userCtx, &apiv1.ListPoliciesRequest{})
+	assert.Equal(t, errno.ErrPermissionDenied, err)
+	_, err = b.CreatePolicy(userCtx, &apiv1.CreatePolicyRequest{Policy: &apiv1.Policy{Subject: known.RoleUser, Object: "*", Action: "*", Effect: "allow"}})
+	assert.Equal(t, errno.ErrPermissionDenied, err)
+	_, err = b.ReplacePolicies(userCtx, &apiv1.ReplacePoliciesRequest{Policies: []*apiv1.Policy{toPolicy(adminPolicy)}})
+	assert.Equal(t, errno.ErrPermissionDenied, err)
+	_, err = b.AssignRole(userCtx, &apiv1.AssignRoleRequest{UserID: userID, Role: known.RoleAdmin})
+	assert.Equal(t, errno.ErrPermissionDenied, err)
+}
+
+func TestDeletePolicy(t *testing.T) {
+	b := newTestBiz(t)
+	ctx := contextx.WithUserID(context.Background(), createUser(t, b, "delete-policy-admin", known.RoleAdmin))
+
+	_, err := b.DeletePolicy(ctx, &apiv1.DeletePolicyRequest{Policy: toPolicy(adminPolicy)})
+	assert.Equal(t, errno.ErrPolicyProtected, err)
+
+	_, err = b.DeletePolicy(ctx, &apiv1.DeletePolicyRequest{Policy: toPolicy(userDenies[0])})
+	require.NoError(t, err)
+
+	// 删除普通用户角色的最后一条拒绝策略后，普通用户可以访问所有接口
+	_, err = b.DeletePolicy(ctx, &apiv1.DeletePolicyRequest{Policy: toPolicy(userDenies[1])})
+	assert.Equal(t, errno.ErrPolicyUnrestricted, err)
+
+	rules, err := b.authz.GetPolicy()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, [][]string{adminPolicy, userDenies[1]}, rules)
+}
+
+func TestReplacePolicies(t *testing.T) {
+	b := newTestBiz(t)
+	ctx := contextx.WithUserID(context.Background(), createUser(t, b, "replace-admin", known.RoleAdmin))
+	userID := createUser(t, b, "replace-user", known.RoleUser)
+
+	postDeny := []string{known.RoleUser, "/v1/posts/*", "DELETE", "deny"}
+	tests := []struct {
+		name  string
+		rules [][]string
+		want  error
+	}{
+		{"without admin policy", [][]string{postDeny}, errno.ErrPolicyProtected},
+		{"without user deny", [][]string{adminPolicy, {known.RoleUser, "/v1/posts/*", "DELETE", "allow"}}, errno.ErrPolicyUnrestricted},
+		{"valid", [][]string{adminPolicy, postDeny}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before, err := b.authz.GetPolicy()
+			require.NoError(t, err)
+
+			policies := make([]*apiv1.Policy, 0, len(tt.rules))
+			for _, rule := range tt.rules {
+				policies = append(policies, toPolicy(rule))
+			}
+			_, err = b.ReplacePolicies(ctx, &apiv1.ReplacePoliciesRequest{Policies: policies})
+			if tt.want != nil {
+				assert.ErrorIs(t, err, tt.want)
+				after, err := b.authz.GetPolicy()
+				require.NoError(t, err)
+				assert.ElementsMatch(t, before, after)
+				return
+			}
+			require.NoError(t, err)
+
+			// 旧策略全部被替换，内存中的策略立即生效，角色分配不受影响
+			rules, err := b.authz.GetPolicy()
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.rules, rules)
+			allowed, err := b.authz.Authorize(userID, "/v1/users", "GET")
+			require.NoError(t, err)
+			assert.True(t, allowed)
+			allowed, err = b.authz.Authorize(userID, "/v1/posts/post-1", "DELETE")
+			require.NoError(t, err)
+			assert.False(t, allowed)
+
+			// 数据库中的策略和内存中一致
+			reloaded, err := newTestAuthz(t, "TestReplacePolicies").GetPolicy()
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.rules, reloaded)
+		})
+	}
+}
+
+func TestUnassignRole(t *testing.T) {
+	b := newTestBiz(t)
+	adminID := createUser(t, b, "unassign-admin", known.RoleAdmin)
+	ctx := contextx.WithUserID(context.Background(), adminID)
+
+	tests := []struct {
+		name  string
+		roles []string
+		self  bool
+		role  string
+		want  error
+	}{
+		{"own admin role", nil, true, known.RoleAdmin, errno.ErrPolicyProtected},
+		{"only user role", []string{known.RoleUser}, false, known.RoleUser, errno.ErrPolicyUnrestricted},
+		{"user role with custom role", []string{known.RoleUser, "role::editor"}, false, known.RoleUser, errno.ErrPolicyUnrestricted},
+		{"only admin role", []string{known.RoleAdmin}, false, known.RoleAdmin, errno.ErrPolicyUnrestricted},
+		{"not assigned", []string{known.RoleUser}, false, "role::editor", errno.ErrRoleNotAssigned},
+		{"custom role", []string{known.RoleUser, "role::editor"}, false, "role::editor", nil},
+		{"admin role of another admin", []string{known.RoleUser, known.RoleAdmin}, false, known.RoleAdmin, nil},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userID := adminID
+			if !tt.self {
+				userID = createUser(t, b, fmt.Sprintf("unassign-%d", i), tt.roles...)
+			}
+			before, err := b.store.User().Get(ctx, where.F("userID", userID))
+			require.NoError(t, err)
+
+			_, err = b.UnassignRole(ctx, &apiv1.UnassignRoleRequest{UserID: userID, Role: tt.role})
+			assert.ErrorIs(t, err, tt.want)
+			if tt.want != nil {
+				return
+			}
+
+			roles, err := b.authz.GetRolesForUser(userID)
+			require.NoError(t, err)
+			assert.NotContains(t, roles, tt.role)
+			assert.Contains(t, roles, known.RoleUser)
+
+			// 移除角色后更新用户的修改时间，此前签发的访问令牌中的角色不再被使用
+			after, err := b.store.User().Get(ctx, where.F("userID", userID))
+			require.NoError(t, err)
+			assert.True(t, after.UpdatedAt.After(before.UpdatedAt))
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package post
+
+import (
+	"context"
+	"time"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/fingerprint"
+)
+
+// DuplicatePolicy 定义创建博客时检测到重复博客的处理策略.
+type DuplicatePolicy string
+
+const (
+	// DuplicatePolicyOff 表示不检测重复博客.
+	DuplicatePolicyOff DuplicatePolicy = "off"
+	// DuplicatePolicyWarn 表示允许创建重复博客，并在响应中返回重复的博客 ID.
+	DuplicatePolicyWarn DuplicatePolicy = "warn"
+	// DuplicatePolicyReject 表示拒绝创建重复博客.
+	DuplicatePolicyReject DuplicatePolicy = "reject"
+)
+
+// maxDuplicateCandidates 为检测重复博客时最多比较的已有博客数量.
+const maxDuplicateCandidates = 500
+
+// findDuplicates 返回用户在检测时间窗口内创建的、和 fp 重复的博客 ID，最近创建的博客排在前面.
+func (b *postBiz) findDuplicates(ctx context.Context, userID string, fp fingerprint.Fingerprint) ([]string, error) {
+	if b.opts.DuplicatePolicy == "" || b.opts.DuplicatePolicy == DuplicatePolicyOff {
+		return nil, nil
+	}
+
+	whr := where.F("userID", userID).Q("createdAt >= ?", time.Now().Add(-b.opts.DuplicateWindow)).L(maxDuplicateCandidates)
+	_, candidates, err := b.store.Fingerprint().List(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	return duplicatesOf(fp, candidates, b.opts.DuplicateMaxDistance), nil
+}
+
+// duplicatesOf 返回 candidates 中和 fp 重复的博客 ID.
+func duplicatesOf(fp fingerprint.Fingerprint, candidates []*model.PostFingerprintM, maxDistance int) []string {
+	var postIDs []string
+	for _, candidate := range candidates {
+		if fp.Matches(fingerprint.Fingerprint{Hash: candidate.ContentHash, SimHash: candidate.SimHash}, maxDistance) {
+			postIDs = append(postIDs, candidate.PostID)
+		}
+	}
+	return postIDs
+}
+
+// fingerprintModel 将博客的指纹转换为数据库模型.
+func fingerprintModel(postM *model.PostM, fp fingerprint.Fingerprint) *model.PostFingerprintM {
+	return &model.PostFingerprintM{
+		PostID:      postM.PostID,
+		UserID:      postM.UserID,
+		ContentHash: fp.Hash,
+		SimHash:     fp.SimHash,
+		CreatedAt:   postM.CreatedAt,
+	}
+}
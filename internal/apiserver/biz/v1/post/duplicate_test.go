@@ -0,0 +1,42 @@
+package post
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/fingerprint"
+)
+
+func TestDuplicatesOf(t *testing.T) {
+	body := strings.Repeat("Retries should never create the same post twice, so the server checks fingerprints. ", 8)
+	fp := fingerprint.Compute("Retries", body)
+
+	newFingerprint := func(postID string, title string, content string) *model.PostFingerprintM {
+		return fingerprintModel(&model.PostM{PostID: postID, Title: title, Content: content}, fingerprint.Compute(title, content))
+	}
+	candidates := []*model.PostFingerprintM{
+		newFingerprint("post-exact", "retries", "  "+strings.ToUpper(body)),
+		newFingerprint("post-near", "Retries", body+"Typo fixed."),
+		newFingerprint("post-other", "Caching", strings.Repeat("A cache trades memory for latency and must be invalidated carefully. ", 8)),
+	}
+
+	tests := []struct {
+		name        string
+		maxDistance int
+		want        []string
+	}{
+		{"exact only", -1, []string{"post-exact"}},
+		{"exact and near", 3, []string{"post-exact", "post-near"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, duplicatesOf(fp, candidates, tt.maxDistance))
+		})
+	}
+
+	assert.Empty(t, duplicatesOf(fp, nil, 3))
+}
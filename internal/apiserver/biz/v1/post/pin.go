@@ -0,0 +1,184 @@
+package post
+
+import (
+	"context"
+	"slices"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/pkg/contextx"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// Pin 实现 PostBiz 接口中的 Pin 方法. 博客已置顶时会移动到指定位置.
+func (b *postBiz) Pin(ctx context.Context, rq *apiv1.PinPostRequest) (*apiv1.PinPostResponse, error) {
+	// 只能置顶自己的博客
+	if _, err := b.store.Post().Get(ctx, where.T(ctx).F("postID", rq.GetPostID())); err != nil {
+		return nil, err
+	}
+
+	userID := contextx.UserID(ctx)
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		_, pinList, err := b.store.Pin().List(ctx, where.F("userID", userID))
+		if err != nil {
+			return err
+		}
+
+		postIDs := make([]string, 0, len(pinList)+1)
+		for _, pin := range pinList {
+			if pin.PostID != rq.GetPostID() {
+				postIDs = append(postIDs, pin.PostID)
+			}
+		}
+
+		// 新置顶的博客需要检查数量上限，调整已置顶博客的位置则不需要
+		if len(postIDs) == len(pinList) {
+			if len(pinList) >= b.opts.MaxPinned {
+				return errno.ErrTooManyPinnedPosts.WithMessage("at most %d posts can be pinned", b.opts.MaxPinned)
+			}
+			if err := b.store.Pin().Create(ctx, &model.PostPinM{UserID: userID, PostID: rq.GetPostID()}); err != nil {
+				return err
+			}
+		}
+
+		return b.store.Pin().SetPositions(ctx, userID, insertAt(postIDs, rq.GetPostID(), rq.Position))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.PinPostResponse{}, nil
+}
+
+// Unpin 实现 PostBiz 接口中的 Unpin 方法.
+func (b *postBiz) Unpin(ctx context.Context, rq *apiv1.UnpinPostRequest) (*apiv1.UnpinPostResponse, error) {
+	if err := b.store.Pin().Delete(ctx, where.T(ctx).F("postID", rq.GetPostID())); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.UnpinPostResponse{}, nil
+}
+
+// Feature 实现 PostBiz 接口中的 Feature 方法. 博客已是精选时会移动到指定位置.
+// 只有管理员可以调用该方法，由 casbin 策略保证.
+func (b *postBiz) Feature(ctx context.Context, rq *apiv1.FeaturePostRequest) (*apiv1.FeaturePostResponse, error) {
+	// 管理员可以精选任意用户的博客，所以这里不用 where.T()
+	if _, err := b.store.Post().Get(ctx, where.F("postID", rq.GetPostID())); err != nil {
+		return nil, err
+	}
+
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		_, featureList, err := b.store.Feature().List(ctx, where.NewWhere())
+		if err != nil {
+			return err
+		}
+
+		postIDs := make([]string, 0, len(featureList)+1)
+		for _, feature := range featureList {
+			if feature.PostID != rq.GetPostID() {
+				postIDs = append(postIDs, feature.PostID)
+			}
+		}
+		if len(postIDs) == len(featureList) {
+			if err := b.store.Feature().Create(ctx, &model.PostFeatureM{PostID: rq.GetPostID()}); err != nil {
+				return err
+			}
+		}
+
+		return b.store.Feature().SetPositions(ctx, insertAt(postIDs, rq.GetPostID(), rq.Position))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.FeaturePostResponse{}, nil
+}
+
+// Unfeature 实现 PostBiz 接口中的 Unfeature 方法.
+func (b *postBiz) Unfeature(ctx context.Context, rq *apiv1.UnfeaturePostRequest) (*apiv1.UnfeaturePostResponse, error) {
+	if err := b.store.Feature().Delete(ctx, where.F("postID", rq.GetPostID())); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.UnfeaturePostResponse{}, nil
+}
+
+// pinnedPostIDs 返回用户置顶的博客 ID，按置顶位置排列.
+func (b *postBiz) pinnedPostIDs(ctx context.Context, userID string) ([]string, error) {
+	_, pinList, err := b.store.Pin().List(ctx, where.F("userID", userID))
+	if err != nil {
+		return nil, err
+	}
+
+	postIDs := make([]string, 0, len(pinList))
+	for _, pin := range pinList {
+		postIDs = append(postIDs, pin.PostID)
+	}
+	return postIDs, nil
+}
+
+// featuredPostIDs 返回精选的博客 ID，按精选位置排列.
+func (b *postBiz) featuredPostIDs(ctx context.Context) ([]string, error) {
+	_, featureList, err := b.store.Feature().List(ctx, where.NewWhere())
+	if err != nil {
+		return nil, err
+	}
+
+	postIDs := make([]string, 0, len(featureList))
+	for _, feature := range featureList {
+		postIDs = append(postIDs, feature.PostID)
+	}
+	return postIDs, nil
+}
+
+// listPrioritized 返回满足 base 条件的博客列表，priority 中的博客按顺序排在最前面，其余博客按默认顺序排列.
+// base 每次调用都需要返回新的查询条件.
+func (b *postBiz) listPrioritized(ctx context.Context, base func() *where.Options, priority []string, offset int, limit int) (int64, []*model.PostM, error) {
+	if len(priority) == 0 {
+		return b.store.Post().List(ctx, base().O(offset).L(limit))
+	}
+
+	var head []*model.PostM
+	if offset < len(priority) {
+		postIDs := priority[offset:min(offset+limit, len(priority))]
+		_, postList, err := b.store.Post().List(ctx, base().F("postID", postIDs))
+		if err != nil {
+			return 0, nil, err
+		}
+		head = sortByPostIDs(postList, postIDs)
+	}
+
+	// 其余博客需要排除优先展示的博客，偏移量也需要减去优先展示的博客数量
+	count, tail, err := b.store.Post().List(ctx, base().Q("postID NOT IN ?", priority).O(max(offset-len(priority), 0)).L(limit-len(head)))
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return count + int64(len(priority)), append(head, tail...), nil
+}
+
+// insertAt 将 postID 插入到 postIDs 的 position 位置，position 为空或超出范围时追加到末尾.
+func insertAt(postIDs []string, postID string, position *int64) []string {
+	if position == nil || *position >= int64(len(postIDs)) {
+		return append(postIDs, postID)
+	}
+	return slices.Insert(postIDs, int(max(*position, 0)), postID)
+}
+
+// sortByPostIDs 按 postIDs 的顺序排列博客，不存在的博客会被忽略.
+func sortByPostIDs(postList []*model.PostM, postIDs []string) []*model.PostM {
+	postMap := make(map[string]*model.PostM, len(postList))
+	for _, post := range postList {
+		postMap[post.PostID] = post
+	}
+
+	ret := make([]*model.PostM, 0, len(postList))
+	for _, postID := range postIDs {
+		if post, ok := postMap[postID]; ok {
+			ret = append(ret, post)
+		}
+	}
+	return ret
+}
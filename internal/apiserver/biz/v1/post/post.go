@@ -2,13 +2,20 @@ package post
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/jinzhu/copier"
 	"github.com/ra1n6ow/gpkg/store/where"
 	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
 	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/fingerprint"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/mention"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/related"
 	"github.com/ra1n6ow/miniblog/internal/apiserver/store"
 	"github.com/ra1n6ow/miniblog/internal/pkg/contextx"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
 	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
 )
 
@@ -24,36 +31,124 @@ type PostBiz interface {
 }
 
 // PostExpansion 定义额外的帖子操作方法.
-type PostExpansion interface{}
+type PostExpansion interface {
+	// GetPublic 获取任意用户的博客详情，不做租户过滤，供公开页面使用.
+	GetPublic(ctx context.Context, postID string) (*apiv1.Post, error)
+	// ListPublic 列出所有用户的博客，userID 不为空时只列出该用户的博客，供公开页面使用.
+	ListPublic(ctx context.Context, userID string, offset int64, limit int64) (int64, []*apiv1.Post, error)
+	// ListMentions 列出内容中提及了当前用户的博客.
+	ListMentions(ctx context.Context, rq *apiv1.ListMentionsRequest) (*apiv1.ListMentionsResponse, error)
+	// ListRelatedPosts 列出和指定博客相关的博客.
+	ListRelatedPosts(ctx context.Context, rq *apiv1.ListRelatedPostsRequest) (*apiv1.ListRelatedPostsResponse, error)
+	// Pin 将当前用户的博客置顶.
+	Pin(ctx context.Context, rq *apiv1.PinPostRequest) (*apiv1.PinPostResponse, error)
+	// Unpin 取消置顶当前用户的博客.
+	Unpin(ctx context.Context, rq *apiv1.UnpinPostRequest) (*apiv1.UnpinPostResponse, error)
+	// Feature 将博客设为全站精选.
+	Feature(ctx context.Context, rq *apiv1.FeaturePostRequest) (*apiv1.FeaturePostResponse, error)
+	// Unfeature 取消博客的全站精选.
+	Unfeature(ctx context.Context, rq *apiv1.UnfeaturePostRequest) (*apiv1.UnfeaturePostResponse, error)
+	// CreateTranslation 为当前用户的博客添加其他语言的译文.
+	CreateTranslation(ctx context.Context, rq *apiv1.CreatePostTranslationRequest) (*apiv1.CreatePostTranslationResponse, error)
+	// UpdateTranslation 更新当前用户博客的译文.
+	UpdateTranslation(ctx context.Context, rq *apiv1.UpdatePostTranslationRequest) (*apiv1.UpdatePostTranslationResponse, error)
+	// DeleteTranslation 删除当前用户博客的译文.
+	DeleteTranslation(ctx context.Context, rq *apiv1.DeletePostTranslationRequest) (*apiv1.DeletePostTranslationResponse, error)
+}
+
+// defaultRelatedLimit 为未指定数量时返回的相关博客数量.
+const defaultRelatedLimit = 5
+
+// Options 定义博客业务的可配置项.
+type Options struct {
+	// MaxPinned 为每个用户最多可以置顶的博客数量.
+	MaxPinned int
+	// DuplicatePolicy 为创建博客时检测到重复博客的处理策略.
+	DuplicatePolicy DuplicatePolicy
+	// DuplicateWindow 为检测重复博客的时间窗口，只和该时间窗口内创建的博客比较.
+	DuplicateWindow time.Duration
+	// DuplicateMaxDistance 为判定近似重复时 SimHash 签名允许的最大汉明距离，小于 0 时只检测完全相同的博客.
+	DuplicateMaxDistance int
+	// DefaultLocale 为创建博客时未指定语言时使用的默认语言.
+	DefaultLocale string
+	// RequireVerifiedEmail 为 true 时，邮箱未验证的用户不能创建和修改博客.
+	RequireVerifiedEmail bool
+}
 
 // postBiz 是 PostBiz 接口的实现.
 type postBiz struct {
 	store store.IStore
+	index *related.Index
+	opts  *Options
 }
 
 // 确保 postBiz 实现了 PostBiz 接口.
 var _ PostBiz = (*postBiz)(nil)
 
 // New 创建 postBiz 的实例.
-func New(store store.IStore) *postBiz {
-	return &postBiz{store: store}
+func New(store store.IStore, index *related.Index, opts *Options) *postBiz {
+	return &postBiz{store: store, index: index, opts: opts}
 }
 
 // Create 实现 PostBiz 接口中的 Create 方法.
 func (b *postBiz) Create(ctx context.Context, rq *apiv1.CreatePostRequest) (*apiv1.CreatePostResponse, error) {
+	if err := b.checkEmailVerified(ctx); err != nil {
+		return nil, err
+	}
+
 	var postM model.PostM
 	_ = copier.Copy(&postM, rq)
 	postM.UserID = contextx.UserID(ctx)
+	postM.Locale = b.opts.DefaultLocale
+	if rq.Locale != nil {
+		loc, err := canonicalLocale(rq.GetLocale())
+		if err != nil {
+			return nil, err
+		}
+		postM.Locale = loc
+	}
+
+	// 客户端重试或重复提交时，同一用户会在短时间内创建内容相同的博客
+	fp := fingerprint.Compute(postM.Title, postM.Content)
+	duplicates, err := b.findDuplicates(ctx, postM.UserID, fp)
+	if err != nil {
+		return nil, err
+	}
+	if len(duplicates) > 0 {
+		if b.opts.DuplicatePolicy == DuplicatePolicyReject {
+			return nil, errno.ErrDuplicatePost.WithMessage("The post duplicates post %s created recently.", duplicates[0])
+		}
+		log.W(ctx).Infow("Creating a post that duplicates recent posts", "duplicateOf", duplicates)
+	}
+
+	mentions, err := b.resolveMentions(ctx, postM.Content)
+	if err != nil {
+		return nil, err
+	}
 
-	if err := b.store.Post().Create(ctx, &postM); err != nil {
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.Post().Create(ctx, &postM); err != nil {
+			return err
+		}
+		if err := b.store.Mention().Replace(ctx, postM.PostID, mentions); err != nil {
+			return err
+		}
+		return b.store.Fingerprint().Save(ctx, fingerprintModel(&postM, fp))
+	})
+	if err != nil {
 		return nil, err
 	}
+	b.index.Put(conversion.PostModelToDocument(&postM))
 
-	return &apiv1.CreatePostResponse{PostID: postM.PostID}, nil
+	return &apiv1.CreatePostResponse{PostID: postM.PostID, DuplicateOf: duplicates}, nil
 }
 
 // Update 实现 PostBiz 接口中的 Update 方法.
 func (b *postBiz) Update(ctx context.Context, rq *apiv1.UpdatePostRequest) (*apiv1.UpdatePostResponse, error) {
+	if err := b.checkEmailVerified(ctx); err != nil {
+		return nil, err
+	}
+
 	whr := where.T(ctx).F("postID", rq.GetPostID())
 	postM, err := b.store.Post().Get(ctx, whr)
 	if err != nil {
@@ -68,19 +163,98 @@ func (b *postBiz) Update(ctx context.Context, rq *apiv1.UpdatePostRequest) (*api
 		postM.Content = rq.GetContent()
 	}
 
-	if err := b.store.Post().Update(ctx, postM); err != nil {
+	// 默认语言不能和已有译文的语言相同
+	if rq.Locale != nil {
+		loc, err := canonicalLocale(rq.GetLocale())
+		if err != nil {
+			return nil, err
+		}
+		_, err = b.store.Translation().Get(ctx, where.F("postID", postM.PostID).F("locale", loc))
+		if err == nil {
+			return nil, errno.ErrTranslationAlreadyExists.WithMessage("The post already has a %s translation", loc)
+		}
+		if !errors.Is(err, errno.ErrTranslationNotFound) {
+			return nil, err
+		}
+		postM.Locale = loc
+	}
+
+	// 内容未修改时不需要重新解析提及
+	var mentions []*model.PostMentionM
+	if rq.Content != nil {
+		mentions, err = b.resolveMentions(ctx, postM.Content)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.Post().Update(ctx, postM); err != nil {
+			return err
+		}
+		if rq.Content != nil {
+			if err := b.store.Mention().Replace(ctx, postM.PostID, mentions); err != nil {
+				return err
+			}
+		}
+		return b.store.Fingerprint().Save(ctx, fingerprintModel(postM, fingerprint.Compute(postM.Title, postM.Content)))
+	})
+	if err != nil {
 		return nil, err
 	}
+	b.index.Put(conversion.PostModelToDocument(postM))
 
 	return &apiv1.UpdatePostResponse{}, nil
 }
 
 // Delete 实现 PostBiz 接口中的 Delete 方法.
 func (b *postBiz) Delete(ctx context.Context, rq *apiv1.DeletePostRequest) (*apiv1.DeletePostResponse, error) {
-	whr := where.T(ctx).F("postID", rq.GetPostIDs())
-	if err := b.store.Post().Delete(ctx, whr); err != nil {
+	// 先查询出当前用户拥有的博客，避免清理其他用户博客的关联数据
+	_, postList, err := b.store.Post().List(ctx, where.T(ctx).F("postID", rq.GetPostIDs()))
+	if err != nil {
+		return nil, err
+	}
+	if len(postList) == 0 {
+		return &apiv1.DeletePostResponse{}, nil
+	}
+
+	postIDs := make([]string, 0, len(postList))
+	for _, post := range postList {
+		postIDs = append(postIDs, post.PostID)
+	}
+
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.Post().Delete(ctx, where.T(ctx).F("postID", postIDs)); err != nil {
+			return err
+		}
+		if err := b.store.Reaction().DeleteByPost(ctx, postIDs...); err != nil {
+			return err
+		}
+		if err := b.store.Mention().DeleteByPost(ctx, postIDs...); err != nil {
+			return err
+		}
+		if err := b.store.Pin().DeleteByPost(ctx, postIDs...); err != nil {
+			return err
+		}
+		if err := b.store.Feature().DeleteByPost(ctx, postIDs...); err != nil {
+			return err
+		}
+		if err := b.store.Fingerprint().DeleteByPost(ctx, postIDs...); err != nil {
+			return err
+		}
+		if err := b.store.Translation().DeleteByPost(ctx, postIDs...); err != nil {
+			return err
+		}
+		if err := b.store.Preview().DeleteByPost(ctx, postIDs...); err != nil {
+			return err
+		}
+		// 博客删除后，其他用户对该博客的书签也一并清理
+		return b.store.Bookmark().DeleteByPost(ctx, postIDs...)
+	})
+	if err != nil {
 		return nil, err
 	}
+	b.index.Remove(postIDs...)
 
 	return &apiv1.DeletePostResponse{}, nil
 }
@@ -93,22 +267,323 @@ func (b *postBiz) Get(ctx context.Context, rq *apiv1.GetPostRequest) (*apiv1.Get
 		return nil, err
 	}
 
-	return &apiv1.GetPostResponse{Post: conversion.PostModelToPostV1(postM)}, nil
+	post := conversion.PostModelToPostV1(postM)
+	if err := b.fillPosts(ctx, rq.GetLocale(), post); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.GetPostResponse{Post: post}, nil
 }
 
 // List 实现 PostBiz 接口中的 List 方法.
 func (b *postBiz) List(ctx context.Context, rq *apiv1.ListPostRequest) (*apiv1.ListPostResponse, error) {
 	whr := where.T(ctx).P(int(rq.GetOffset()), int(rq.GetLimit()))
-	count, postList, err := b.store.Post().List(ctx, whr)
+
+	base := func() *where.Options { return where.T(ctx) }
+	var loc string
+	if rq.Locale != nil {
+		var err error
+		if loc, err = canonicalLocale(rq.GetLocale()); err != nil {
+			return nil, err
+		}
+		base = func() *where.Options { return b.localeFilter(where.T(ctx), loc) }
+	}
+
+	var priority []string
+	if rq.GetPinnedFirst() {
+		pinned, err := b.pinnedPostIDs(ctx, contextx.UserID(ctx))
+		if err != nil {
+			return nil, err
+		}
+		priority = pinned
+		// 按语言过滤时，没有该语言版本的置顶博客不应该出现在列表中
+		if rq.Locale != nil && len(pinned) > 0 {
+			_, pinnedList, err := b.store.Post().List(ctx, base().F("postID", pinned))
+			if err != nil {
+				return nil, err
+			}
+			priority = make([]string, 0, len(pinnedList))
+			for _, post := range sortByPostIDs(pinnedList, pinned) {
+				priority = append(priority, post.PostID)
+			}
+		}
+	}
+
+	count, postList, err := b.listPrioritized(ctx, base, priority, max(whr.Offset, 0), whr.Limit)
 	if err != nil {
 		return nil, err
 	}
 
 	posts := make([]*apiv1.Post, 0, len(postList))
 	for _, post := range postList {
-		converted := conversion.PostModelToPostV1(post)
-		posts = append(posts, converted)
+		posts = append(posts, conversion.PostModelToPostV1(post))
+	}
+	if err := b.fillPosts(ctx, loc, posts...); err != nil {
+		return nil, err
+	}
+	// 列表页只需要展示摘要，省略内容可以明显减小响应的大小
+	if rq.GetOmitContent() {
+		for _, post := range posts {
+			post.Content = ""
+		}
 	}
 
 	return &apiv1.ListPostResponse{TotalCount: count, Posts: posts}, nil
 }
+
+// GetPublic 实现 PostBiz 接口中的 GetPublic 方法.
+func (b *postBiz) GetPublic(ctx context.Context, postID string) (*apiv1.Post, error) {
+	postM, err := b.store.Post().Get(ctx, where.F("postID", postID))
+	if err != nil {
+		return nil, err
+	}
+
+	post := conversion.PostModelToPostV1(postM)
+	if err := b.fillPosts(ctx, "", post); err != nil {
+		return nil, err
+	}
+
+	return post, nil
+}
+
+// ListPublic 实现 PostBiz 接口中的 ListPublic 方法.
+// 列出所有用户的博客时精选博客排在最前面，列出指定用户的博客时该用户置顶的博客排在最前面.
+func (b *postBiz) ListPublic(ctx context.Context, userID string, offset int64, limit int64) (int64, []*apiv1.Post, error) {
+	base := func() *where.Options {
+		if userID != "" {
+			return where.F("userID", userID)
+		}
+		return where.NewWhere()
+	}
+
+	var (
+		priority []string
+		err      error
+	)
+	if userID != "" {
+		priority, err = b.pinnedPostIDs(ctx, userID)
+	} else {
+		priority, err = b.featuredPostIDs(ctx)
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+
+	count, postList, err := b.listPrioritized(ctx, base, priority, int(offset), int(limit))
+	if err != nil {
+		return 0, nil, err
+	}
+
+	posts := make([]*apiv1.Post, 0, len(postList))
+	for _, post := range postList {
+		posts = append(posts, conversion.PostModelToPostV1(post))
+	}
+	if err := b.fillPosts(ctx, "", posts...); err != nil {
+		return 0, nil, err
+	}
+
+	return count, posts, nil
+}
+
+// ListMentions 实现 PostBiz 接口中的 ListMentions 方法.
+func (b *postBiz) ListMentions(ctx context.Context, rq *apiv1.ListMentionsRequest) (*apiv1.ListMentionsResponse, error) {
+	count, postIDs, err := b.store.Mention().PostIDs(ctx, contextx.UserID(ctx), int(rq.GetOffset()), int(rq.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+	if len(postIDs) == 0 {
+		return &apiv1.ListMentionsResponse{TotalCount: count, Posts: []*apiv1.Post{}}, nil
+	}
+
+	// 提及了当前用户的博客可能属于任意用户，所以这里不用 where.T()
+	_, postList, err := b.store.Post().List(ctx, where.F("postID", postIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	// 按最近提及时间的顺序返回博客
+	posts := make([]*apiv1.Post, 0, len(postList))
+	for _, post := range sortByPostIDs(postList, postIDs) {
+		posts = append(posts, conversion.PostModelToPostV1(post))
+	}
+	if err := b.fillPosts(ctx, "", posts...); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.ListMentionsResponse{TotalCount: count, Posts: posts}, nil
+}
+
+// ListRelatedPosts 实现 PostBiz 接口中的 ListRelatedPosts 方法.
+func (b *postBiz) ListRelatedPosts(ctx context.Context, rq *apiv1.ListRelatedPostsRequest) (*apiv1.ListRelatedPostsResponse, error) {
+	// 可以查看任意用户博客的相关博客，所以这里不用 where.T()
+	if _, err := b.store.Post().Get(ctx, where.F("postID", rq.GetPostID())); err != nil {
+		return nil, err
+	}
+
+	limit := int(rq.GetLimit())
+	if limit <= 0 {
+		limit = defaultRelatedLimit
+	}
+	results := b.index.Related(rq.GetPostID(), limit)
+	if len(results) == 0 {
+		return &apiv1.ListRelatedPostsResponse{Posts: []*apiv1.Post{}}, nil
+	}
+
+	postIDs := make([]string, 0, len(results))
+	for _, result := range results {
+		postIDs = append(postIDs, result.PostID)
+	}
+	_, postList, err := b.store.Post().List(ctx, where.F("postID", postIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	// 按相关度的顺序返回博客
+	posts := make([]*apiv1.Post, 0, len(postList))
+	for _, post := range sortByPostIDs(postList, postIDs) {
+		posts = append(posts, conversion.PostModelToPostV1(post))
+	}
+	if err := b.fillPosts(ctx, "", posts...); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.ListRelatedPostsResponse{Posts: posts}, nil
+}
+
+// checkEmailVerified 在配置了 RequireVerifiedEmail 时，检查当前用户的邮箱是否已经验证.
+func (b *postBiz) checkEmailVerified(ctx context.Context) error {
+	if !b.opts.RequireVerifiedEmail {
+		return nil
+	}
+
+	userM, err := b.store.User().Get(ctx, where.T(ctx))
+	if err != nil {
+		return err
+	}
+	if userM.EmailVerifiedAt == nil {
+		return errno.ErrEmailNotVerified
+	}
+	return nil
+}
+
+// resolveMentions 解析内容中的 @username 提及，并通过 UserStore 解析为用户.
+// 不存在的用户会被忽略，不会导致博客创建或更新失败.
+func (b *postBiz) resolveMentions(ctx context.Context, content string) ([]*model.PostMentionM, error) {
+	parsed := mention.Parse(content)
+	if len(parsed) == 0 {
+		return nil, nil
+	}
+
+	_, userList, err := b.store.User().List(ctx, where.F("username", mention.Usernames(parsed)))
+	if err != nil {
+		return nil, err
+	}
+	userIDs := make(map[string]string, len(userList))
+	for _, user := range userList {
+		userIDs[user.Username] = user.UserID
+	}
+
+	mentions := make([]*model.PostMentionM, 0, len(parsed))
+	for _, m := range parsed {
+		userID, ok := userIDs[m.Username]
+		if !ok {
+			continue
+		}
+		mentions = append(mentions, &model.PostMentionM{UserID: userID, StartOffset: m.Start, EndOffset: m.End})
+	}
+
+	return mentions, nil
+}
+
+// fillPosts 批量查询并填充博客的附加信息，包括反应数量、当前用户是否收藏了该博客、提及的用户、置顶和精选状态以及语言信息.
+// 博客的标题和内容会替换为最符合 preference 的语言版本.
+func (b *postBiz) fillPosts(ctx context.Context, preference string, posts ...*apiv1.Post) error {
+	if len(posts) == 0 {
+		return nil
+	}
+
+	postIDs := make([]string, 0, len(posts))
+	for _, post := range posts {
+		postIDs = append(postIDs, post.PostID)
+	}
+
+	counts, err := b.store.Reaction().Counts(ctx, postIDs...)
+	if err != nil {
+		return err
+	}
+	bookmarked, err := b.store.Bookmark().Bookmarked(ctx, contextx.UserID(ctx), postIDs...)
+	if err != nil {
+		return err
+	}
+	mentions, err := b.mentions(ctx, postIDs...)
+	if err != nil {
+		return err
+	}
+	_, pinList, err := b.store.Pin().List(ctx, where.F("postID", postIDs))
+	if err != nil {
+		return err
+	}
+	pinned := make(map[string]bool, len(pinList))
+	for _, pin := range pinList {
+		pinned[pin.PostID] = true
+	}
+	_, featureList, err := b.store.Feature().List(ctx, where.F("postID", postIDs))
+	if err != nil {
+		return err
+	}
+	featured := make(map[string]bool, len(featureList))
+	for _, feature := range featureList {
+		featured[feature.PostID] = true
+	}
+
+	for _, post := range posts {
+		post.ReactionCounts = counts[post.PostID]
+		post.Bookmarked = bookmarked[post.PostID]
+		post.Mentions = mentions[post.PostID]
+		post.Pinned = pinned[post.PostID]
+		post.Featured = featured[post.PostID]
+	}
+
+	return b.localize(ctx, preference, posts...)
+}
+
+// mentions 批量查询博客中提及的用户，结果以 postID 为键. 被提及的用户已不存在时会被忽略.
+func (b *postBiz) mentions(ctx context.Context, postIDs ...string) (map[string][]*apiv1.Mention, error) {
+	ret := make(map[string][]*apiv1.Mention, len(postIDs))
+	if len(postIDs) == 0 {
+		return ret, nil
+	}
+
+	_, mentionList, err := b.store.Mention().List(ctx, where.F("postID", postIDs))
+	if err != nil || len(mentionList) == 0 {
+		return ret, err
+	}
+
+	userIDs := make([]string, 0, len(mentionList))
+	for _, m := range mentionList {
+		userIDs = append(userIDs, m.UserID)
+	}
+	_, userList, err := b.store.User().List(ctx, where.F("userID", userIDs))
+	if err != nil {
+		return nil, err
+	}
+	usernames := make(map[string]string, len(userList))
+	for _, user := range userList {
+		usernames[user.UserID] = user.Username
+	}
+
+	for _, m := range mentionList {
+		username, ok := usernames[m.UserID]
+		if !ok {
+			continue
+		}
+		ret[m.PostID] = append(ret[m.PostID], &apiv1.Mention{
+			UserID:   m.UserID,
+			Username: username,
+			Start:    m.StartOffset,
+			End:      m.EndOffset,
+		})
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,170 @@
+package post
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/locale"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// CreateTranslation 实现 PostBiz 接口中的 CreateTranslation 方法.
+func (b *postBiz) CreateTranslation(ctx context.Context, rq *apiv1.CreatePostTranslationRequest) (*apiv1.CreatePostTranslationResponse, error) {
+	loc, err := canonicalLocale(rq.GetLocale())
+	if err != nil {
+		return nil, err
+	}
+
+	// 只能为自己的博客添加译文
+	postM, err := b.store.Post().Get(ctx, where.T(ctx).F("postID", rq.GetPostID()))
+	if err != nil {
+		return nil, err
+	}
+	if loc == b.defaultLocale(postM) {
+		return nil, errno.ErrTranslationAlreadyExists.WithMessage("%s is the default locale of the post", loc)
+	}
+
+	_, err = b.store.Translation().Get(ctx, where.F("postID", postM.PostID).F("locale", loc))
+	if err == nil {
+		return nil, errno.ErrTranslationAlreadyExists.WithMessage("The post already has a %s translation", loc)
+	}
+	if !errors.Is(err, errno.ErrTranslationNotFound) {
+		return nil, err
+	}
+
+	translationM := &model.PostTranslationM{
+		PostID:  postM.PostID,
+		Locale:  loc,
+		Title:   rq.GetTitle(),
+		Content: rq.GetContent(),
+	}
+	if err := b.store.Translation().Create(ctx, translationM); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.CreatePostTranslationResponse{}, nil
+}
+
+// UpdateTranslation 实现 PostBiz 接口中的 UpdateTranslation 方法.
+func (b *postBiz) UpdateTranslation(ctx context.Context, rq *apiv1.UpdatePostTranslationRequest) (*apiv1.UpdatePostTranslationResponse, error) {
+	translationM, err := b.getTranslation(ctx, rq.GetPostID(), rq.GetLocale())
+	if err != nil {
+		return nil, err
+	}
+
+	if rq.Title != nil {
+		translationM.Title = rq.GetTitle()
+	}
+	if rq.Content != nil {
+		translationM.Content = rq.GetContent()
+	}
+	if err := b.store.Translation().Update(ctx, translationM); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.UpdatePostTranslationResponse{}, nil
+}
+
+// DeleteTranslation 实现 PostBiz 接口中的 DeleteTranslation 方法.
+func (b *postBiz) DeleteTranslation(ctx context.Context, rq *apiv1.DeletePostTranslationRequest) (*apiv1.DeletePostTranslationResponse, error) {
+	translationM, err := b.getTranslation(ctx, rq.GetPostID(), rq.GetLocale())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := b.store.Translation().Delete(ctx, where.F("id", translationM.ID)); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.DeletePostTranslationResponse{}, nil
+}
+
+// getTranslation 查询当前用户博客指定语言的译文.
+func (b *postBiz) getTranslation(ctx context.Context, postID string, tag string) (*model.PostTranslationM, error) {
+	loc, err := canonicalLocale(tag)
+	if err != nil {
+		return nil, err
+	}
+
+	// 只能修改自己博客的译文
+	if _, err := b.store.Post().Get(ctx, where.T(ctx).F("postID", postID)); err != nil {
+		return nil, err
+	}
+
+	return b.store.Translation().Get(ctx, where.F("postID", postID).F("locale", loc))
+}
+
+// defaultLocale 返回博客的默认语言. 支持多语言之前创建的博客没有记录语言，使用服务端配置的默认语言.
+func (b *postBiz) defaultLocale(postM *model.PostM) string {
+	if postM.Locale == "" {
+		return b.opts.DefaultLocale
+	}
+	return postM.Locale
+}
+
+// localeFilter 返回只保留有 loc 语言版本的博客的查询条件，loc 可以是默认语言，也可以是译文的语言.
+func (b *postBiz) localeFilter(whr *where.Options, loc string) *where.Options {
+	subQuery := "postID IN (SELECT postID FROM post_translation WHERE locale = ?)"
+	if loc == b.opts.DefaultLocale {
+		return whr.Q("(locale IN ? OR "+subQuery+")", []string{loc, ""}, loc)
+	}
+	return whr.Q("(locale = ? OR "+subQuery+")", loc, loc)
+}
+
+// localize 查询博客的译文，填充博客的语言信息，并将标题、内容和统计信息替换为最符合 preference 的语言版本.
+// preference 为空时返回默认语言的版本.
+func (b *postBiz) localize(ctx context.Context, preference string, posts ...*apiv1.Post) error {
+	postIDs := make([]string, 0, len(posts))
+	for _, post := range posts {
+		postIDs = append(postIDs, post.PostID)
+	}
+	_, translationList, err := b.store.Translation().List(ctx, where.F("postID", postIDs))
+	if err != nil {
+		return err
+	}
+	translations := make(map[string][]*model.PostTranslationM, len(posts))
+	for _, translation := range translationList {
+		translations[translation.PostID] = append(translations[translation.PostID], translation)
+	}
+
+	for _, post := range posts {
+		// 转换后的 Locale 为博客的默认语言
+		post.DefaultLocale = post.Locale
+		if post.DefaultLocale == "" {
+			post.DefaultLocale = b.opts.DefaultLocale
+		}
+
+		available := make([]string, 0, len(translations[post.PostID]))
+		for _, translation := range translations[post.PostID] {
+			available = append(available, translation.Locale)
+		}
+		post.AvailableLocales = append([]string{post.DefaultLocale}, available...)
+
+		post.Locale = locale.Match(preference, post.DefaultLocale, available)
+		for _, translation := range translations[post.PostID] {
+			if translation.Locale != post.Locale {
+				continue
+			}
+			post.Title = translation.Title
+			post.Content = translation.Content
+			post.WordCount = translation.WordCount
+			post.ReadingTime = translation.ReadingTime
+			post.Excerpt = translation.Excerpt
+		}
+	}
+
+	return nil
+}
+
+// canonicalLocale 规范化请求中的语言标签.
+func canonicalLocale(tag string) (string, error) {
+	loc, err := locale.Canonicalize(tag)
+	if err != nil {
+		return "", errno.ErrInvalidArgument.WithMessage("invalid locale %q", tag)
+	}
+	return loc, nil
+}
@@ -0,0 +1,158 @@
+package preview
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+
+	postv1 "github.com/ra1n6ow/miniblog/internal/apiserver/biz/v1/post"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/signature"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/store"
+	"github.com/ra1n6ow/miniblog/internal/pkg/contextx"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// signaturePurpose 为派生预览链接签名密钥时使用的用途，保证预览链接的签名不能用于其他场景.
+const signaturePurpose = "miniblog/preview-link"
+
+// PreviewBiz 定义处理博客预览链接请求所需的方法.
+type PreviewBiz interface {
+	Create(ctx context.Context, rq *apiv1.CreatePreviewLinkRequest) (*apiv1.CreatePreviewLinkResponse, error)
+	List(ctx context.Context, rq *apiv1.ListPreviewLinksRequest) (*apiv1.ListPreviewLinksResponse, error)
+	Revoke(ctx context.Context, rq *apiv1.RevokePreviewLinkRequest) (*apiv1.RevokePreviewLinkResponse, error)
+
+	PreviewExpansion
+}
+
+// PreviewExpansion 定义额外的预览链接操作方法.
+type PreviewExpansion interface {
+	// Get 校验预览链接的签名、有效期和撤销状态，返回只读的博客内容. 调用方不需要登录.
+	Get(ctx context.Context, rq *apiv1.GetPreviewRequest) (*apiv1.GetPreviewResponse, error)
+}
+
+// Options 定义预览链接业务的可配置项.
+type Options struct {
+	// Key 为派生预览链接签名密钥使用的服务端密钥.
+	Key string
+	// DefaultTTL 为未指定有效期时预览链接的有效期.
+	DefaultTTL time.Duration
+	// MaxTTL 为预览链接的最长有效期.
+	MaxTTL time.Duration
+}
+
+// previewBiz 是 PreviewBiz 接口的实现.
+type previewBiz struct {
+	store  store.IStore
+	post   postv1.PostBiz
+	opts   *Options
+	signer *signature.Signer
+}
+
+// 确保 previewBiz 实现了 PreviewBiz 接口.
+var _ PreviewBiz = (*previewBiz)(nil)
+
+// New 创建 previewBiz 的实例.
+func New(store store.IStore, post postv1.PostBiz, opts *Options) *previewBiz {
+	return &previewBiz{store: store, post: post, opts: opts, signer: signature.NewSigner(opts.Key, signaturePurpose)}
+}
+
+// Create 实现 PreviewBiz 接口中的 Create 方法.
+func (b *previewBiz) Create(ctx context.Context, rq *apiv1.CreatePreviewLinkRequest) (*apiv1.CreatePreviewLinkResponse, error) {
+	ttl := b.opts.DefaultTTL
+	if rq.Ttl != nil {
+		ttl = time.Duration(rq.GetTtl()) * time.Second
+	}
+	if ttl > b.opts.MaxTTL {
+		return nil, errno.ErrInvalidArgument.WithMessage("ttl cannot exceed %d seconds", int64(b.opts.MaxTTL.Seconds()))
+	}
+
+	// 只能为自己的博客创建预览链接
+	postM, err := b.store.Post().Get(ctx, where.T(ctx).F("postID", rq.GetPostID()))
+	if err != nil {
+		return nil, err
+	}
+
+	linkM := &model.PreviewLinkM{
+		UserID: contextx.UserID(ctx),
+		PostID: postM.PostID,
+		// 过期时间保存在 datetime 字段中，只保留到秒，和签名中的时间戳保持一致
+		ExpiresAt: time.Now().Add(ttl).Truncate(time.Second),
+	}
+	if err := b.store.Preview().Create(ctx, linkM); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.CreatePreviewLinkResponse{Link: b.toV1(linkM)}, nil
+}
+
+// List 实现 PreviewBiz 接口中的 List 方法.
+func (b *previewBiz) List(ctx context.Context, rq *apiv1.ListPreviewLinksRequest) (*apiv1.ListPreviewLinksResponse, error) {
+	whr := where.T(ctx).F("postID", rq.GetPostID()).Q("expiresAt > ?", time.Now())
+	_, linkList, err := b.store.Preview().List(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	links := make([]*apiv1.PreviewLink, 0, len(linkList))
+	for _, link := range linkList {
+		links = append(links, b.toV1(link))
+	}
+
+	return &apiv1.ListPreviewLinksResponse{Links: links}, nil
+}
+
+// Revoke 实现 PreviewBiz 接口中的 Revoke 方法.
+func (b *previewBiz) Revoke(ctx context.Context, rq *apiv1.RevokePreviewLinkRequest) (*apiv1.RevokePreviewLinkResponse, error) {
+	if err := b.store.Preview().Delete(ctx, where.T(ctx).F("postID", rq.GetPostID()).F("linkID", rq.GetLinkID())); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.RevokePreviewLinkResponse{}, nil
+}
+
+// Get 实现 PreviewBiz 接口中的 Get 方法.
+// 先校验签名再查询数据库，签名错误的请求不会产生数据库查询.
+func (b *previewBiz) Get(ctx context.Context, rq *apiv1.GetPreviewRequest) (*apiv1.GetPreviewResponse, error) {
+	if !b.signer.Verify(rq.GetSignature(), rq.GetLinkID(), strconv.FormatInt(rq.GetExpires(), 10)) {
+		return nil, errno.ErrPreviewLinkInvalid
+	}
+	if time.Now().Unix() >= rq.GetExpires() {
+		return nil, errno.ErrPreviewLinkExpired
+	}
+
+	// 撤销的预览链接记录已被删除
+	linkM, err := b.store.Preview().Get(ctx, where.F("linkID", rq.GetLinkID()))
+	if err != nil {
+		return nil, err
+	}
+
+	post, err := b.post.GetPublic(ctx, linkM.PostID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.GetPreviewResponse{Post: post}, nil
+}
+
+// toV1 将预览链接转换为 v1 对象，并填充带签名的预览地址.
+func (b *previewBiz) toV1(linkM *model.PreviewLinkM) *apiv1.PreviewLink {
+	link := conversion.PreviewLinkModelToPreviewLinkV1(linkM)
+	link.Url = b.url(linkM.LinkID, linkM.ExpiresAt.Unix())
+	return link
+}
+
+// url 返回带签名的预览地址.
+func (b *previewBiz) url(linkID string, expires int64) string {
+	exp := strconv.FormatInt(expires, 10)
+	query := url.Values{
+		"expires":   []string{exp},
+		"signature": []string{b.signer.Sign(linkID, exp)},
+	}
+	return "/v1/previews/" + url.PathEscape(linkID) + "?" + query.Encode()
+}
@@ -0,0 +1,55 @@
+package preview
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// parseURL 从预览地址中解析出 GetPreviewRequest.
+func parseURL(t *testing.T, raw string) *apiv1.GetPreviewRequest {
+	u, err := url.Parse(raw)
+	assert.NoError(t, err)
+	expires, err := strconv.ParseInt(u.Query().Get("expires"), 10, 64)
+	assert.NoError(t, err)
+	return &apiv1.GetPreviewRequest{
+		LinkID:    strings.TrimPrefix(u.Path, "/v1/previews/"),
+		Expires:   expires,
+		Signature: u.Query().Get("signature"),
+	}
+}
+
+func TestGetRejectsBeforeStoreLookup(t *testing.T) {
+	// store 为 nil，签名或有效期校验失败时不能查询数据库
+	b := New(nil, nil, &Options{Key: "secret", DefaultTTL: time.Hour, MaxTTL: time.Hour})
+	ctx := context.Background()
+
+	raw := b.url("preview-abc123", time.Now().Add(time.Hour).Unix())
+	valid := parseURL(t, raw)
+
+	tampered := parseURL(t, raw)
+	tampered.Expires += 3600
+	_, err := b.Get(ctx, tampered)
+	assert.ErrorIs(t, err, errno.ErrPreviewLinkInvalid)
+
+	otherLink := parseURL(t, raw)
+	otherLink.LinkID = "preview-abc124"
+	_, err = b.Get(ctx, otherLink)
+	assert.ErrorIs(t, err, errno.ErrPreviewLinkInvalid)
+
+	otherKey := New(nil, nil, &Options{Key: "other"})
+	_, err = otherKey.Get(ctx, valid)
+	assert.ErrorIs(t, err, errno.ErrPreviewLinkInvalid)
+
+	expired := parseURL(t, b.url("preview-abc123", time.Now().Add(-time.Second).Unix()))
+	_, err = b.Get(ctx, expired)
+	assert.ErrorIs(t, err, errno.ErrPreviewLinkExpired)
+}
@@ -0,0 +1,124 @@
+package reaction
+
+import (
+	"context"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/store"
+	"github.com/ra1n6ow/miniblog/internal/pkg/contextx"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// ReactionBiz 定义处理博客反应请求所需的方法.
+type ReactionBiz interface {
+	Add(ctx context.Context, rq *apiv1.AddReactionRequest) (*apiv1.AddReactionResponse, error)
+	Remove(ctx context.Context, rq *apiv1.RemoveReactionRequest) (*apiv1.RemoveReactionResponse, error)
+	ListReactors(ctx context.Context, rq *apiv1.ListReactorsRequest) (*apiv1.ListReactorsResponse, error)
+
+	ReactionExpansion
+}
+
+// ReactionExpansion 定义额外的反应操作方法.
+type ReactionExpansion interface{}
+
+// reactionBiz 是 ReactionBiz 接口的实现.
+type reactionBiz struct {
+	store store.IStore
+}
+
+// 确保 reactionBiz 实现了 ReactionBiz 接口.
+var _ ReactionBiz = (*reactionBiz)(nil)
+
+// New 创建 reactionBiz 的实例.
+func New(store store.IStore) *reactionBiz {
+	return &reactionBiz{store: store}
+}
+
+// Add 实现 ReactionBiz 接口中的 Add 方法. 同一用户重复添加同一反应时不会重复计数.
+func (b *reactionBiz) Add(ctx context.Context, rq *apiv1.AddReactionRequest) (*apiv1.AddReactionResponse, error) {
+	// 任何登录用户都可以对任意博客做出反应，所以这里不用 where.T()
+	if _, err := b.store.Post().Get(ctx, where.F("postID", rq.GetPostID())); err != nil {
+		return nil, err
+	}
+
+	reactionM := &model.PostReactionM{PostID: rq.GetPostID(), UserID: contextx.UserID(ctx), Reaction: rq.GetReaction()}
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		created, err := b.store.Reaction().Create(ctx, reactionM)
+		if err != nil || !created {
+			return err
+		}
+
+		// 反应记录和计数在同一个事务中修改，保证并发时计数一致
+		return b.store.Reaction().IncrCount(ctx, rq.GetPostID(), rq.GetReaction(), 1)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.AddReactionResponse{}, nil
+}
+
+// Remove 实现 ReactionBiz 接口中的 Remove 方法. 移除不存在的反应时直接返回成功.
+func (b *reactionBiz) Remove(ctx context.Context, rq *apiv1.RemoveReactionRequest) (*apiv1.RemoveReactionResponse, error) {
+	whr := where.T(ctx).F("postID", rq.GetPostID(), "reaction", rq.GetReaction())
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		deleted, err := b.store.Reaction().Delete(ctx, whr)
+		if err != nil || !deleted {
+			return err
+		}
+
+		return b.store.Reaction().IncrCount(ctx, rq.GetPostID(), rq.GetReaction(), -1)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.RemoveReactionResponse{}, nil
+}
+
+// ListReactors 实现 ReactionBiz 接口中的 ListReactors 方法.
+func (b *reactionBiz) ListReactors(ctx context.Context, rq *apiv1.ListReactorsRequest) (*apiv1.ListReactorsResponse, error) {
+	if _, err := b.store.Post().Get(ctx, where.F("postID", rq.GetPostID())); err != nil {
+		return nil, err
+	}
+
+	whr := where.O(int(rq.GetOffset())).L(int(rq.GetLimit())).F("postID", rq.GetPostID())
+	if rq.Reaction != nil {
+		whr.F("reaction", rq.GetReaction())
+	}
+	count, reactionList, err := b.store.Reaction().List(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	// 批量查询用户名，避免逐条查询
+	userIDs := make([]string, 0, len(reactionList))
+	for _, reaction := range reactionList {
+		userIDs = append(userIDs, reaction.UserID)
+	}
+	usernames := make(map[string]string, len(userIDs))
+	if len(userIDs) > 0 {
+		_, userList, err := b.store.User().List(ctx, where.F("userID", userIDs))
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range userList {
+			usernames[user.UserID] = user.Username
+		}
+	}
+
+	reactors := make([]*apiv1.Reactor, 0, len(reactionList))
+	for _, reaction := range reactionList {
+		reactors = append(reactors, &apiv1.Reactor{
+			UserID:    reaction.UserID,
+			Username:  usernames[reaction.UserID],
+			Reaction:  reaction.Reaction,
+			CreatedAt: timestamppb.New(reaction.CreatedAt),
+		})
+	}
+
+	return &apiv1.ListReactorsResponse{TotalCount: count, Reactors: reactors}, nil
+}
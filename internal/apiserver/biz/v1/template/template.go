@@ -0,0 +1,200 @@
+package template
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+
+	postv1 "github.com/ra1n6ow/miniblog/internal/apiserver/biz/v1/post"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/placeholder"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/store"
+	"github.com/ra1n6ow/miniblog/internal/pkg/contextx"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// TemplateBiz 定义处理博客模板请求所需的方法.
+type TemplateBiz interface {
+	Create(ctx context.Context, rq *apiv1.CreatePostTemplateRequest) (*apiv1.CreatePostTemplateResponse, error)
+	Update(ctx context.Context, rq *apiv1.UpdatePostTemplateRequest) (*apiv1.UpdatePostTemplateResponse, error)
+	Delete(ctx context.Context, rq *apiv1.DeletePostTemplateRequest) (*apiv1.DeletePostTemplateResponse, error)
+	Get(ctx context.Context, rq *apiv1.GetPostTemplateRequest) (*apiv1.GetPostTemplateResponse, error)
+	List(ctx context.Context, rq *apiv1.ListPostTemplateRequest) (*apiv1.ListPostTemplateResponse, error)
+
+	TemplateExpansion
+}
+
+// TemplateExpansion 定义额外的博客模板操作方法.
+type TemplateExpansion interface {
+	// CreatePost 渲染模板中的占位符，并通过 PostBiz 使用渲染结果创建博客.
+	CreatePost(ctx context.Context, rq *apiv1.CreatePostFromTemplateRequest) (*apiv1.CreatePostFromTemplateResponse, error)
+}
+
+// templateBiz 是 TemplateBiz 接口的实现.
+type templateBiz struct {
+	store store.IStore
+	post  postv1.PostBiz
+}
+
+// 确保 templateBiz 实现了 TemplateBiz 接口.
+var _ TemplateBiz = (*templateBiz)(nil)
+
+// New 创建 templateBiz 的实例.
+func New(store store.IStore, post postv1.PostBiz) *templateBiz {
+	return &templateBiz{store: store, post: post}
+}
+
+// Create 实现 TemplateBiz 接口中的 Create 方法.
+func (b *templateBiz) Create(ctx context.Context, rq *apiv1.CreatePostTemplateRequest) (*apiv1.CreatePostTemplateResponse, error) {
+	if err := b.checkNameAvailable(ctx, rq.GetName()); err != nil {
+		return nil, err
+	}
+
+	templateM := &model.PostTemplateM{
+		UserID:  contextx.UserID(ctx),
+		Name:    rq.GetName(),
+		Title:   rq.GetTitle(),
+		Content: rq.GetContent(),
+	}
+	if err := b.store.Template().Create(ctx, templateM); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.CreatePostTemplateResponse{TemplateID: templateM.TemplateID}, nil
+}
+
+// Update 实现 TemplateBiz 接口中的 Update 方法.
+func (b *templateBiz) Update(ctx context.Context, rq *apiv1.UpdatePostTemplateRequest) (*apiv1.UpdatePostTemplateResponse, error) {
+	templateM, err := b.store.Template().Get(ctx, where.T(ctx).F("templateID", rq.GetTemplateID()))
+	if err != nil {
+		return nil, err
+	}
+
+	if rq.Name != nil && rq.GetName() != templateM.Name {
+		if err := b.checkNameAvailable(ctx, rq.GetName()); err != nil {
+			return nil, err
+		}
+		templateM.Name = rq.GetName()
+	}
+	if rq.Title != nil {
+		templateM.Title = rq.GetTitle()
+	}
+	if rq.Content != nil {
+		templateM.Content = rq.GetContent()
+	}
+
+	if err := b.store.Template().Update(ctx, templateM); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.UpdatePostTemplateResponse{}, nil
+}
+
+// Delete 实现 TemplateBiz 接口中的 Delete 方法.
+func (b *templateBiz) Delete(ctx context.Context, rq *apiv1.DeletePostTemplateRequest) (*apiv1.DeletePostTemplateResponse, error) {
+	if err := b.store.Template().Delete(ctx, where.T(ctx).F("templateID", rq.GetTemplateID())); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.DeletePostTemplateResponse{}, nil
+}
+
+// Get 实现 TemplateBiz 接口中的 Get 方法.
+func (b *templateBiz) Get(ctx context.Context, rq *apiv1.GetPostTemplateRequest) (*apiv1.GetPostTemplateResponse, error) {
+	templateM, err := b.store.Template().Get(ctx, where.T(ctx).F("templateID", rq.GetTemplateID()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.GetPostTemplateResponse{Template: conversion.TemplateModelToTemplateV1(templateM)}, nil
+}
+
+// List 实现 TemplateBiz 接口中的 List 方法.
+func (b *templateBiz) List(ctx context.Context, rq *apiv1.ListPostTemplateRequest) (*apiv1.ListPostTemplateResponse, error) {
+	count, templateList, err := b.store.Template().List(ctx, where.T(ctx).P(int(rq.GetOffset()), int(rq.GetLimit())))
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]*apiv1.PostTemplate, 0, len(templateList))
+	for _, template := range templateList {
+		templates = append(templates, conversion.TemplateModelToTemplateV1(template))
+	}
+
+	return &apiv1.ListPostTemplateResponse{TotalCount: count, Templates: templates}, nil
+}
+
+// CreatePost 实现 TemplateBiz 接口中的 CreatePost 方法.
+// 渲染后的博客和直接创建的博客走同一条路径，同样会进行重复检测、解析提及等处理.
+func (b *templateBiz) CreatePost(ctx context.Context, rq *apiv1.CreatePostFromTemplateRequest) (*apiv1.CreatePostFromTemplateResponse, error) {
+	templateM, err := b.store.Template().Get(ctx, where.T(ctx).F("templateID", rq.GetTemplateID()))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if rq.Date != nil {
+		// 指定日期时使用当天的当前时刻，{{time}} 仍然是创建博客的时间
+		day, err := time.ParseInLocation(time.DateOnly, rq.GetDate(), time.Local)
+		if err != nil {
+			return nil, errno.ErrInvalidArgument.WithMessage("date must be in YYYY-MM-DD format")
+		}
+		now = time.Date(day.Year(), day.Month(), day.Day(), now.Hour(), now.Minute(), now.Second(), 0, time.Local)
+	}
+
+	vars := placeholder.Builtins(now, contextx.Username(ctx))
+	for name, value := range rq.GetVariables() {
+		vars[name] = value
+	}
+
+	createRq, err := render(templateM, vars)
+	if err != nil {
+		return nil, err
+	}
+	createRq.Locale = rq.Locale
+
+	resp, err := b.post.Create(ctx, createRq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.CreatePostFromTemplateResponse{PostID: resp.GetPostID(), DuplicateOf: resp.GetDuplicateOf()}, nil
+}
+
+// checkNameAvailable 检查当前用户是否已经有同名的模板.
+func (b *templateBiz) checkNameAvailable(ctx context.Context, name string) error {
+	_, err := b.store.Template().Get(ctx, where.T(ctx).F("name", name))
+	if err == nil {
+		return errno.ErrTemplateAlreadyExists.WithMessage("A template named %q already exists", name)
+	}
+	if !errors.Is(err, errno.ErrTemplateNotFound) {
+		return err
+	}
+	return nil
+}
+
+// render 渲染模板的标题和内容，返回创建博客的请求. 模板中有未定义的占位符，或者渲染后标题或内容为空时返回错误.
+func render(templateM *model.PostTemplateM, vars map[string]string) (*apiv1.CreatePostRequest, error) {
+	title, missingInTitle := placeholder.Render(templateM.Title, vars)
+	content, missingInContent := placeholder.Render(templateM.Content, vars)
+	if missing := append(missingInTitle, missingInContent...); len(missing) > 0 {
+		slices.Sort(missing)
+		return nil, errno.ErrInvalidArgument.WithMessage("undefined placeholders in template: %s", strings.Join(slices.Compact(missing), ", "))
+	}
+
+	// 和直接创建博客时的校验规则保持一致
+	if title == "" {
+		return nil, errno.ErrInvalidArgument.WithMessage("title cannot be empty")
+	}
+	if content == "" {
+		return nil, errno.ErrInvalidArgument.WithMessage("content cannot be empty")
+	}
+
+	return &apiv1.CreatePostRequest{Title: title, Content: content}, nil
+}
@@ -0,0 +1,24 @@
+package template
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+)
+
+func TestRender(t *testing.T) {
+	vars := map[string]string{"week": "2024-W11", "date": "2024-03-14"}
+
+	rq, err := render(&model.PostTemplateM{Title: "周报 {{week}}", Content: "## {{date}}\n\n- "}, vars)
+	assert.NoError(t, err)
+	assert.Equal(t, "周报 2024-W11", rq.Title)
+	assert.Equal(t, "## 2024-03-14\n\n- ", rq.Content)
+
+	_, err = render(&model.PostTemplateM{Title: "{{project}} {{week}}", Content: "{{owner}} {{project}}"}, vars)
+	assert.ErrorContains(t, err, "undefined placeholders in template: owner, project")
+
+	_, err = render(&model.PostTemplateM{Title: "{{empty}}", Content: "content"}, map[string]string{"empty": ""})
+	assert.ErrorContains(t, err, "title cannot be empty")
+}
@@ -0,0 +1,102 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ra1n6ow/gpkg/store/where"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/revocation"
+	"github.com/ra1n6ow/miniblog/internal/pkg/contextx"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/known"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+	"github.com/ra1n6ow/miniblog/internal/pkg/scope"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// CreateAccessToken 实现 UserBiz 接口中的 CreateAccessToken 方法.
+// 令牌明文只在创建时返回一次，数据库中只保存令牌的摘要.
+func (b *userBiz) CreateAccessToken(ctx context.Context, rq *apiv1.CreateAccessTokenRequest) (*apiv1.CreateAccessTokenResponse, error) {
+	buf := make([]byte, tokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, errno.ErrInternal.WithMessage("failed to generate access token: %s", err.Error())
+	}
+	accessToken := known.AccessTokenPrefix + base64.RawURLEncoding.EncodeToString(buf)
+
+	tokenM := &model.AccessTokenM{
+		TokenID:   uuid.New().String(),
+		UserID:    contextx.UserID(ctx),
+		Name:      rq.GetName(),
+		Scopes:    scope.Join(rq.GetScopes()),
+		TokenHash: revocation.HashAccessToken(accessToken),
+		ExpiresAt: time.Now().AddDate(0, 0, int(rq.GetExpiresInDays())),
+	}
+	if err := b.store.AccessToken().Create(ctx, tokenM); err != nil {
+		return nil, err
+	}
+
+	log.W(ctx).Infow("Access token created", "tokenID", tokenM.TokenID, "scopes", tokenM.Scopes)
+	return &apiv1.CreateAccessTokenResponse{
+		AccessToken: conversion.AccessTokenModelToAccessTokenV1(tokenM),
+		Token:       accessToken,
+	}, nil
+}
+
+// ListAccessTokens 实现 UserBiz 接口中的 ListAccessTokens 方法.
+func (b *userBiz) ListAccessTokens(ctx context.Context, rq *apiv1.ListAccessTokensRequest) (*apiv1.ListAccessTokensResponse, error) {
+	count, tokenList, err := b.store.AccessToken().List(ctx, where.T(ctx).P(int(rq.GetOffset()), int(rq.GetLimit())))
+	if err != nil {
+		return nil, err
+	}
+
+	accessTokens := make([]*apiv1.AccessToken, 0, len(tokenList))
+	for _, tokenM := range tokenList {
+		accessTokens = append(accessTokens, conversion.AccessTokenModelToAccessTokenV1(tokenM))
+	}
+
+	return &apiv1.ListAccessTokensResponse{TotalCount: count, AccessTokens: accessTokens}, nil
+}
+
+// RevokeAccessToken 实现 UserBiz 接口中的 RevokeAccessToken 方法.
+func (b *userBiz) RevokeAccessToken(ctx context.Context, rq *apiv1.RevokeAccessTokenRequest) (*apiv1.RevokeAccessTokenResponse, error) {
+	// 只能撤销自己的令牌
+	tokenM, err := b.store.AccessToken().Get(ctx, where.T(ctx).F("tokenID", rq.GetTokenID()))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := b.deleteAccessTokens(ctx, where.F("tokenID", tokenM.TokenID)); err != nil {
+		return nil, err
+	}
+
+	log.W(ctx).Infow("Access token revoked", "tokenID", tokenM.TokenID)
+	return &apiv1.RevokeAccessTokenResponse{}, nil
+}
+
+// deleteAccessTokens 删除满足条件的个人访问令牌，并清除令牌的缓存使其立即失效.
+func (b *userBiz) deleteAccessTokens(ctx context.Context, whr *where.Options) error {
+	_, tokenList, err := b.store.AccessToken().List(ctx, whr)
+	if err != nil {
+		return err
+	}
+	if len(tokenList) == 0 {
+		return nil
+	}
+
+	if err := b.store.AccessToken().Delete(ctx, whr); err != nil {
+		return err
+	}
+
+	tokenHashes := make([]string, 0, len(tokenList))
+	for _, tokenM := range tokenList {
+		tokenHashes = append(tokenHashes, tokenM.TokenHash)
+	}
+	b.revocations.ForgetAccessTokens(tokenHashes...)
+	return nil
+}
@@ -0,0 +1,107 @@
+package user
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+
+	"github.com/ra1n6ow/miniblog/internal/pkg/contextx"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/lockout"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+	"github.com/ra1n6ow/miniblog/internal/pkg/ratelimit"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// Guards 保存需要在多个 userBiz 实例之间共享的限流器和登录失败记录.
+// 这些状态只保存在当前进程的内存中，服务重启后清空.
+type Guards struct {
+	// PasswordReset 按邮箱限制申请重置密码的频率.
+	PasswordReset *ratelimit.Limiter
+	// LoginByUsername 按用户名记录连续登录失败的次数.
+	LoginByUsername *lockout.Tracker
+	// LoginByIP 按客户端 IP 记录连续登录失败的次数.
+	LoginByIP *lockout.Tracker
+}
+
+// NewGuards 根据用户业务的可配置项创建 Guards.
+func NewGuards(opts *Options) *Guards {
+	return &Guards{
+		PasswordReset: ratelimit.New(opts.PasswordResetsPerEmail, time.Hour),
+		LoginByUsername: lockout.New(lockout.Policy{
+			Threshold: opts.LoginMaxFailures,
+			Duration:  opts.LoginLockoutDuration,
+			BaseDelay: opts.LoginDelay,
+		}),
+		// 同一个 IP 后面可能有很多用户，因此不对 IP 设置递增等待时间，只在失败次数过多时锁定
+		LoginByIP: lockout.New(lockout.Policy{
+			Threshold: opts.LoginMaxFailuresPerIP,
+			Duration:  opts.LoginLockoutDuration,
+		}),
+	}
+}
+
+// UnlockUser 实现 UserBiz 接口中的 UnlockUser 方法.
+// 只有管理员可以调用该方法，由 casbin 策略保证.
+func (b *userBiz) UnlockUser(ctx context.Context, rq *apiv1.UnlockUserRequest) (*apiv1.UnlockUserResponse, error) {
+	userM, err := b.store.User().Get(ctx, where.F("userID", rq.GetUserID()))
+	if err != nil {
+		return nil, err
+	}
+
+	b.guards.LoginByUsername.Reset(usernameKey(userM.Username))
+	log.W(ctx).Infow("User login lockout cleared", "userID", userM.UserID, "username", userM.Username)
+
+	return &apiv1.UnlockUserResponse{}, nil
+}
+
+// checkLogin 检查用户名和客户端 IP 当前是否允许尝试登录.
+func (b *userBiz) checkLogin(ctx context.Context, username string) error {
+	if ip := contextx.ClientIP(ctx); ip != "" {
+		if wait, locked := b.guards.LoginByIP.Check(ip); locked {
+			return errno.ErrClientLocked.WithMessage("Too many failed login attempts from this client, retry after %s", retryAfter(wait))
+		}
+	}
+
+	wait, locked := b.guards.LoginByUsername.Check(usernameKey(username))
+	if locked {
+		return errno.ErrAccountLocked.WithMessage("Account is temporarily locked due to too many failed login attempts, retry after %s", retryAfter(wait))
+	}
+	if wait > 0 {
+		return errno.ErrLoginThrottled.WithMessage("Too many failed login attempts, retry after %s", retryAfter(wait))
+	}
+	return nil
+}
+
+// loginFailed 记录一次登录失败. 本次失败导致账号被锁定时返回 errno.ErrAccountLocked，否则返回 err.
+// 不存在的用户名同样会被记录，避免通过是否锁定判断用户名是否存在.
+func (b *userBiz) loginFailed(ctx context.Context, username string, err error) error {
+	if ip := contextx.ClientIP(ctx); ip != "" {
+		if b.guards.LoginByIP.Fail(ip) {
+			log.W(ctx).Warnw("Client locked out after too many failed logins", "clientIP", ip)
+		}
+	}
+
+	if b.guards.LoginByUsername.Fail(usernameKey(username)) {
+		log.W(ctx).Warnw("Account locked out after too many failed logins", "username", username)
+		return errno.ErrAccountLocked.WithMessage("Account is temporarily locked due to too many failed login attempts, retry after %s", retryAfter(b.opts.LoginLockoutDuration))
+	}
+	return err
+}
+
+// loginSucceeded 清除用户名的登录失败记录. 客户端 IP 的失败记录不清除，避免攻击者用自己的账号重置计数.
+func (b *userBiz) loginSucceeded(username string) {
+	b.guards.LoginByUsername.Reset(usernameKey(username))
+}
+
+// usernameKey 返回用户名在失败记录中的 key. 数据库中的用户名比较不区分大小写，这里也不区分.
+func usernameKey(username string) string {
+	return strings.ToLower(username)
+}
+
+// retryAfter 将等待时间向上取整到秒.
+func retryAfter(wait time.Duration) time.Duration {
+	return (wait + time.Second - 1).Truncate(time.Second)
+}
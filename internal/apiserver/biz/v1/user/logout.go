@@ -0,0 +1,55 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/pkg/contextx"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// Logout 实现 UserBiz 接口中的 Logout 方法.
+// 撤销当前请求所属的会话和使用的访问令牌. 请求中带有刷新令牌时，一并撤销该刷新令牌所在的令牌族.
+func (b *userBiz) Logout(ctx context.Context, rq *apiv1.LogoutRequest) (*apiv1.LogoutResponse, error) {
+	if sessionID := contextx.SessionID(ctx); sessionID != "" {
+		if _, err := b.revokeSessions(ctx, where.T(ctx).F("sessionID", sessionID)); err != nil {
+			return nil, err
+		}
+	}
+
+	if rq.GetRefreshToken() != "" {
+		// 只能撤销自己的刷新令牌
+		refreshM, err := b.store.RefreshToken().Get(ctx, where.T(ctx).F("tokenHash", hashToken(rq.GetRefreshToken())))
+		if err != nil && !errors.Is(err, errno.ErrRefreshTokenInvalid) {
+			return nil, err
+		}
+		if refreshM != nil {
+			if err := b.store.RefreshToken().Delete(ctx, where.F("familyID", refreshM.FamilyID)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// 旧版本签发的访问令牌没有唯一标识，只能等待过期
+	if tokenID := contextx.TokenID(ctx); tokenID != "" {
+		if err := b.revocations.Revoke(ctx, contextx.UserID(ctx), tokenID, contextx.TokenExpiresAt(ctx)); err != nil {
+			return nil, err
+		}
+	}
+
+	log.W(ctx).Infow("User logged out")
+	return &apiv1.LogoutResponse{}, nil
+}
+
+// revokeAllTokens 使用户在此之前签发的所有访问令牌失效，调用方需要保存 userM.
+// 访问令牌的签发时间精确到毫秒，撤销时间同样截断到毫秒，撤销之后立即签发的令牌不受影响.
+func revokeAllTokens(userM *model.UserM) {
+	now := time.Now().Truncate(time.Millisecond)
+	userM.TokensRevokedAt = &now
+}
@@ -0,0 +1,300 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+	"github.com/ra1n6ow/miniblog/internal/pkg/oidc"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+	"github.com/ra1n6ow/miniblog/pkg/auth"
+)
+
+const (
+	// tokenPurposeOIDCState 为单点登录 state 的用途. state 不属于任何用户，userID 为空.
+	tokenPurposeOIDCState = "oidc-state"
+	// oidcStateTTL 为单点登录 state 的有效期，用户需要在该时间内完成身份提供方的认证.
+	oidcStateTTL = 10 * time.Minute
+	// maxUsernameLength 和 minUsernameLength 为用户名的长度限制，和用户名校验规则一致.
+	maxUsernameLength = 20
+	minUsernameLength = 3
+	// maxNicknameLength 为用户昵称的最大长度.
+	maxNicknameLength = 30
+	// usernameAttempts 为自动生成用户名时最多尝试的次数.
+	usernameAttempts = 5
+)
+
+// oidcState 为发起单点登录时保存的状态，完成单点登录时使用.
+type oidcState struct {
+	Provider string `json:"provider"`
+	Nonce    string `json:"nonce"`
+	Verifier string `json:"verifier"`
+}
+
+// NewOIDCProviders 根据用户业务的可配置项创建单点登录身份提供方的客户端，key 为身份提供方名称.
+func NewOIDCProviders(opts *Options) map[string]*oidc.Provider {
+	providers := make(map[string]*oidc.Provider, len(opts.OIDCProviders))
+	for _, o := range opts.OIDCProviders {
+		providers[o.Name] = oidc.NewProvider(o, nil)
+	}
+	return providers
+}
+
+// StartOIDCLogin 实现 UserBiz 接口中的 StartOIDCLogin 方法.
+// state 和 PKCE 的 code verifier 保存在服务端，客户端只需要跳转到返回的授权地址.
+func (b *userBiz) StartOIDCLogin(ctx context.Context, rq *apiv1.StartOIDCLoginRequest) (*apiv1.StartOIDCLoginResponse, error) {
+	provider, ok := b.oidcProviders[rq.GetProvider()]
+	if !ok {
+		return nil, errno.ErrOIDCProviderNotFound
+	}
+
+	state, err := oidc.RandomString(tokenBytes)
+	if err != nil {
+		return nil, errno.ErrInternal.WithMessage("failed to generate state: %s", err.Error())
+	}
+	nonce, err := oidc.RandomString(tokenBytes)
+	if err != nil {
+		return nil, errno.ErrInternal.WithMessage("failed to generate nonce: %s", err.Error())
+	}
+	verifier, err := oidc.NewVerifier()
+	if err != nil {
+		return nil, errno.ErrInternal.WithMessage("failed to generate code verifier: %s", err.Error())
+	}
+
+	authURL, err := provider.AuthCodeURL(ctx, state, nonce, verifier)
+	if err != nil {
+		log.W(ctx).Errorw("Failed to build OIDC authorization URL", "err", err, "provider", provider.Name())
+		return nil, errno.ErrOIDCProviderUnavailable
+	}
+
+	payload, _ := json.Marshal(&oidcState{Provider: provider.Name(), Nonce: nonce, Verifier: verifier})
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		// 顺便清理用户没有完成的单点登录留下的 state
+		if err := b.store.UserToken().Delete(ctx, where.F("purpose", tokenPurposeOIDCState).Q("expiresAt < ?", time.Now())); err != nil {
+			return err
+		}
+		return b.store.UserToken().Create(ctx, &model.UserTokenM{
+			Purpose:   tokenPurposeOIDCState,
+			TokenHash: hashToken(state),
+			Payload:   string(payload),
+			ExpiresAt: time.Now().Add(oidcStateTTL),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.StartOIDCLoginResponse{AuthorizationURL: authURL}, nil
+}
+
+// CompleteOIDCLogin 实现 UserBiz 接口中的 CompleteOIDCLogin 方法.
+// 已关联的身份直接登录；未关联的身份按已验证的电子邮箱地址关联到已有用户，找不到用户时创建新用户.
+func (b *userBiz) CompleteOIDCLogin(ctx context.Context, rq *apiv1.CompleteOIDCLoginRequest) (*apiv1.CompleteOIDCLoginResponse, error) {
+	provider, ok := b.oidcProviders[rq.GetProvider()]
+	if !ok {
+		return nil, errno.ErrOIDCProviderNotFound
+	}
+
+	// state 只能使用一次，防止授权码被重放
+	stateM, err := b.consumeToken(ctx, tokenPurposeOIDCState, rq.GetState())
+	if err != nil {
+		if errors.Is(err, errno.ErrUserTokenInvalid) {
+			return nil, errno.ErrOIDCStateInvalid
+		}
+		return nil, err
+	}
+	var state oidcState
+	if err := json.Unmarshal([]byte(stateM.Payload), &state); err != nil || state.Provider != provider.Name() {
+		return nil, errno.ErrOIDCStateInvalid
+	}
+
+	claims, err := provider.Exchange(ctx, rq.GetCode(), state.Verifier, state.Nonce)
+	if err != nil {
+		log.W(ctx).Errorw("Failed to complete OIDC login", "err", err, "provider", provider.Name())
+		return nil, errno.ErrOIDCLoginFailed
+	}
+
+	userM, err := b.oidcUser(ctx, provider.Name(), claims)
+	if err != nil {
+		return nil, err
+	}
+
+	// 启用了两步验证的用户通过单点登录时同样需要提交验证码
+	if userM.TotpEnabledAt != nil {
+		challenge, err := b.loginChallenge(ctx, userM)
+		if err != nil {
+			return nil, err
+		}
+		return &apiv1.CompleteOIDCLoginResponse{TwoFactorRequired: true, ChallengeToken: challenge.GetChallengeToken()}, nil
+	}
+
+	pair, err := b.issueTokenPair(ctx, userM, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.CompleteOIDCLoginResponse{
+		Token:           pair.token,
+		ExpireAt:        timestamppb.New(pair.expireAt),
+		RefreshToken:    pair.refreshToken,
+		RefreshExpireAt: timestamppb.New(pair.refreshExpireAt),
+	}, nil
+}
+
+// oidcUser 返回外部身份对应的用户，必要时关联已有用户或创建新用户.
+func (b *userBiz) oidcUser(ctx context.Context, provider string, claims *oidc.Claims) (*model.UserM, error) {
+	identityM, err := b.store.Identity().Get(ctx, where.F("provider", provider, "subject", claims.Subject))
+	if err == nil {
+		if err := b.store.Identity().Touch(ctx, identityM.ID, claims.Email, time.Now()); err != nil {
+			return nil, err
+		}
+		userM, err := b.store.User().Get(ctx, where.F("userID", identityM.UserID))
+		if errors.Is(err, errno.ErrUserNotFound) {
+			return nil, errno.ErrOIDCLoginFailed
+		}
+		return userM, err
+	}
+	if !errors.Is(err, errno.ErrIdentityNotFound) {
+		return nil, err
+	}
+
+	// 第一次登录时需要通过电子邮箱地址确定用户，身份提供方没有验证过的地址不可信
+	if claims.Email == "" || !claims.EmailVerified {
+		return nil, errno.ErrOIDCEmailNotVerified
+	}
+
+	identityM = &model.UserIdentityM{
+		Provider:    provider,
+		Subject:     claims.Subject,
+		Email:       claims.Email,
+		LastLoginAt: time.Now(),
+	}
+
+	count, userList, err := b.store.User().List(ctx, where.F("email", claims.Email).L(2))
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case count > 1:
+		log.W(ctx).Warnw("Multiple users share the OIDC email, refusing to link", "provider", provider, "email", claims.Email)
+		return nil, errno.ErrOIDCAccountConflict
+	case count == 1:
+		// 只关联邮箱已验证的用户，否则攻击者可以预先使用他人的邮箱注册，等待对方通过单点登录登录到攻击者的账号
+		userM := userList[0]
+		if userM.EmailVerifiedAt == nil {
+			log.W(ctx).Warnw("User with the OIDC email has not verified it, refusing to link", "provider", provider, "userID", userM.UserID)
+			return nil, errno.ErrOIDCAccountConflict
+		}
+		identityM.UserID = userM.UserID
+		if err := b.store.Identity().Create(ctx, identityM); err != nil {
+			return nil, err
+		}
+		log.W(ctx).Infow("OIDC identity linked to existing user", "provider", provider, "userID", userM.UserID)
+		return userM, nil
+	}
+
+	return b.provisionOIDCUser(ctx, identityM, claims)
+}
+
+// provisionOIDCUser 为第一次通过单点登录登录的外部身份创建用户，并授予默认角色.
+func (b *userBiz) provisionOIDCUser(ctx context.Context, identityM *model.UserIdentityM, claims *oidc.Claims) (*model.UserM, error) {
+	username, err := b.availableUsername(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	// 用户只能通过单点登录或者重置密码登录，因此使用随机密码
+	password, err := oidc.RandomString(tokenBytes)
+	if err != nil {
+		return nil, errno.ErrInternal.WithMessage("failed to generate password: %s", err.Error())
+	}
+	now := time.Now()
+	userM := &model.UserM{
+		Username:        username,
+		Nickname:        truncateRunes(claims.Name, maxNicknameLength),
+		Email:           claims.Email,
+		EmailVerifiedAt: &now,
+	}
+	if userM.Nickname == "" {
+		userM.Nickname = username
+	}
+	userM.Password, _ = auth.Encrypt(password)
+
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.User().Create(ctx, userM); err != nil {
+			return err
+		}
+		identityM.UserID = userM.UserID
+		return b.store.Identity().Create(ctx, identityM)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := b.authz.AddGroupingPolicy(userM.UserID, b.opts.OIDCDefaultRole); err != nil {
+		log.W(ctx).Errorw("Failed to add grouping policy for user", "user", userM.UserID, "role", b.opts.OIDCDefaultRole)
+		return nil, errno.ErrAddRole.WithMessage("%s", err.Error())
+	}
+
+	log.W(ctx).Infow("User provisioned by OIDC login", "provider", identityM.Provider, "userID", userM.UserID, "username", username)
+	return userM, nil
+}
+
+// availableUsername 根据 ID Token 中的用户名或电子邮箱地址生成一个未被使用的用户名.
+func (b *userBiz) availableUsername(ctx context.Context, claims *oidc.Claims) (string, error) {
+	base := sanitizeUsername(claims.PreferredUsername)
+	if base == "" {
+		base = sanitizeUsername(strings.SplitN(claims.Email, "@", 2)[0])
+	}
+	if len(base) < minUsernameLength {
+		base = "user" + base
+	}
+
+	candidate := truncateRunes(base, maxUsernameLength)
+	for range usernameAttempts {
+		if _, err := b.store.User().Get(ctx, where.F("username", candidate)); err != nil {
+			if errors.Is(err, errno.ErrUserNotFound) {
+				return candidate, nil
+			}
+			return "", err
+		}
+		// 用户名已被使用时追加随机后缀
+		suffix := fmt.Sprintf("_%04d", rand.IntN(10000))
+		candidate = truncateRunes(base, maxUsernameLength-len(suffix)) + suffix
+	}
+
+	return "", errno.ErrOIDCAccountConflict.WithMessage("unable to generate an available username")
+}
+
+// sanitizeUsername 只保留用户名中允许使用的字母、数字和下划线.
+func sanitizeUsername(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_':
+			sb.WriteRune(r)
+		case r == '.' || r == '-':
+			sb.WriteRune('_')
+		}
+	}
+	return sb.String()
+}
+
+// truncateRunes 将字符串截断为最多 n 个字符.
+func truncateRunes(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
@@ -0,0 +1,117 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+	"github.com/ra1n6ow/miniblog/internal/pkg/mailer"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+	"github.com/ra1n6ow/miniblog/pkg/auth"
+)
+
+// tokenPurposePasswordReset 为重置密码令牌的用途.
+const tokenPurposePasswordReset = "password-reset"
+
+// RequestPasswordReset 实现 UserBiz 接口中的 RequestPasswordReset 方法.
+// 为避免泄露账号是否存在，邮箱没有对应的用户、请求被限流时都返回成功，令牌的签发和邮件的发送也在后台进行.
+func (b *userBiz) RequestPasswordReset(ctx context.Context, rq *apiv1.RequestPasswordResetRequest) (*apiv1.RequestPasswordResetResponse, error) {
+	email := strings.ToLower(rq.GetEmail())
+	if !b.guards.PasswordReset.Allow(email) {
+		log.W(ctx).Warnw("Password reset requests for email exceeded the limit", "email", email)
+		return &apiv1.RequestPasswordResetResponse{}, nil
+	}
+
+	// 多个用户可以使用同一个邮箱，每个用户都会收到各自的重置密码邮件
+	_, userList, err := b.store.User().List(ctx, where.F("email", rq.GetEmail()))
+	if err != nil {
+		return nil, err
+	}
+	for _, userM := range userList {
+		go b.sendPasswordResetEmail(context.WithoutCancel(ctx), userM)
+	}
+
+	return &apiv1.RequestPasswordResetResponse{}, nil
+}
+
+// ResetPassword 实现 UserBiz 接口中的 ResetPassword 方法.
+func (b *userBiz) ResetPassword(ctx context.Context, rq *apiv1.ResetPasswordRequest) (*apiv1.ResetPasswordResponse, error) {
+	tokenM, err := b.consumeToken(ctx, tokenPurposePasswordReset, rq.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	userM, err := b.store.User().Get(ctx, where.F("userID", tokenM.UserID))
+	if err != nil {
+		if errors.Is(err, errno.ErrUserNotFound) {
+			return nil, errno.ErrUserTokenInvalid
+		}
+		return nil, err
+	}
+	// 令牌签发后用户修改了邮箱时，发往旧邮箱的令牌不能再用于重置密码
+	if userM.Email != tokenM.Payload {
+		return nil, errno.ErrUserTokenInvalid
+	}
+
+	userM.Password, _ = auth.Encrypt(rq.GetNewPassword())
+	// 能够收到重置密码邮件说明用户拥有该邮箱
+	if userM.EmailVerifiedAt == nil {
+		now := time.Now()
+		userM.EmailVerifiedAt = &now
+	}
+
+	// 重置密码后，用户之前签发的所有访问令牌、刷新令牌和其他一次性令牌全部失效.
+	// 两步验证的恢复码不是由密码派生的，需要保留，否则重置密码会让用户丢失第二因素的备用凭证
+	revokeAllTokens(userM)
+	var sessionIDs []string
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.User().Update(ctx, userM); err != nil {
+			return err
+		}
+		whr := where.F("userID", userM.UserID).F("purpose", []string{tokenPurposePasswordReset, tokenPurposeEmailVerification, tokenPurposeTwoFactorChallenge})
+		if err := b.store.UserToken().Delete(ctx, whr); err != nil {
+			return err
+		}
+		if sessionIDs, err = b.deleteSessions(ctx, where.F("userID", userM.UserID)); err != nil {
+			return err
+		}
+		return b.store.RefreshToken().Delete(ctx, where.F("userID", userM.UserID))
+	})
+	if err != nil {
+		return nil, err
+	}
+	b.revocations.ForgetSessions(sessionIDs...)
+	b.users.Forget(userM.UserID)
+
+	return &apiv1.ResetPasswordResponse{}, nil
+}
+
+// sendPasswordResetEmail 为用户签发新的重置密码令牌，并将重置密码令牌发送到用户的邮箱.
+// 该方法在后台执行，错误只记录日志.
+func (b *userBiz) sendPasswordResetEmail(ctx context.Context, userM *model.UserM) {
+	token, err := b.issueToken(ctx, userM.UserID, tokenPurposePasswordReset, userM.Email, b.opts.PasswordResetTTL)
+	if err != nil {
+		log.W(ctx).Errorw("Failed to issue password reset token", "err", err, "userID", userM.UserID)
+		return
+	}
+
+	endpoint := strings.TrimSuffix(b.opts.ExternalURL, "/") + "/v1/password-reset/confirm"
+	msg := &mailer.Message{
+		To:      userM.Email,
+		Subject: "重置你的 miniblog 密码",
+		Body: fmt.Sprintf("%s，你好：\n\n我们收到了重置你的 miniblog 账号密码的申请。\n\n"+
+			"请在 %s 内将下面的令牌和新密码一起提交到 POST %s 完成重置，令牌只能使用一次：\n\n%s\n\n"+
+			"如果这不是你的操作，请忽略这封邮件，你的密码不会被修改。\n",
+			userM.Username, b.opts.PasswordResetTTL, endpoint, token),
+	}
+	if err := b.mailer.Send(ctx, msg); err != nil {
+		log.W(ctx).Errorw("Failed to send password reset email", "err", err, "userID", userM.UserID)
+	}
+}
@@ -0,0 +1,70 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/ra1n6ow/gpkg/store/where"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/revocation"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/usercache"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/store"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// newTestBiz 创建使用内存 SQLite 数据库的 userBiz.
+func newTestBiz(t *testing.T) *userBiz {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&model.UserM{}, &model.UserTokenM{}, &model.UserSessionM{}, &model.RefreshTokenM{}))
+
+	ds := store.NewStore(db)
+	return New(ds, nil, nil, nil, revocation.New(ds), usercache.New(ds), nil, &Options{PasswordResetTTL: time.Hour})
+}
+
+func TestResetPasswordKeepsRecoveryCodes(t *testing.T) {
+	b := newTestBiz(t)
+	ctx := context.Background()
+
+	secret, enabledAt := "JBSWY3DPEHPK3PXP", time.Now()
+	userM := &model.UserM{Username: "alice", Password: "secret", Nickname: "alice", Email: "alice@example.com", TotpSecret: &secret, TotpEnabledAt: &enabledAt}
+	require.NoError(t, b.store.User().Create(ctx, userM))
+
+	const code = "abcde-fghij"
+	require.NoError(t, b.store.UserToken().Create(ctx, &model.UserTokenM{
+		UserID:    userM.UserID,
+		Purpose:   tokenPurposeRecoveryCode,
+		TokenHash: hashToken(normalizeRecoveryCode(code)),
+		ExpiresAt: time.Now().Add(24 * time.Hour),
+	}))
+	_, err := b.issueToken(ctx, userM.UserID, tokenPurposeEmailVerification, userM.Email, time.Hour)
+	require.NoError(t, err)
+	challenge, err := b.issueToken(ctx, userM.UserID, tokenPurposeTwoFactorChallenge, "", time.Hour)
+	require.NoError(t, err)
+	resetToken, err := b.issueToken(ctx, userM.UserID, tokenPurposePasswordReset, userM.Email, time.Hour)
+	require.NoError(t, err)
+
+	_, err = b.ResetPassword(ctx, &apiv1.ResetPasswordRequest{Token: resetToken, NewPassword: "new-password"})
+	require.NoError(t, err)
+
+	// 重置密码前签发的其他一次性令牌全部失效
+	for _, purpose := range []string{tokenPurposeEmailVerification, tokenPurposeTwoFactorChallenge, tokenPurposePasswordReset} {
+		_, err := b.store.UserToken().Get(ctx, where.F("userID", userM.UserID).F("purpose", purpose))
+		assert.ErrorIs(t, err, errno.ErrUserTokenInvalid, purpose)
+	}
+	_, err = b.store.UserToken().Get(ctx, where.F("tokenHash", hashToken(challenge)))
+	assert.ErrorIs(t, err, errno.ErrUserTokenInvalid)
+
+	// 恢复码仍然可以使用，并且只能使用一次
+	userM, err = b.store.User().Get(ctx, where.F("userID", userM.UserID))
+	require.NoError(t, err)
+	assert.NoError(t, b.verifySecondFactor(ctx, userM, code))
+	assert.ErrorIs(t, b.verifySecondFactor(ctx, userM, code), errno.ErrTwoFactorCodeInvalid)
+}
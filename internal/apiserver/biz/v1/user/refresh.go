@@ -0,0 +1,150 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ra1n6ow/gpkg/store/where"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+	"github.com/ra1n6ow/miniblog/pkg/token"
+)
+
+// tokenPair 为登录或刷新成功后签发的访问令牌和刷新令牌.
+type tokenPair struct {
+	token           string
+	expireAt        time.Time
+	refreshToken    string
+	refreshExpireAt time.Time
+}
+
+// RefreshToken 实现 UserBiz 接口中的 RefreshToken 方法.
+// 每个刷新令牌只能使用一次，使用后轮换为新的刷新令牌. 已经使用过的刷新令牌再次出现，
+// 说明令牌可能已经泄露，此时撤销整个令牌族，用户需要重新登录.
+func (b *userBiz) RefreshToken(ctx context.Context, rq *apiv1.RefreshTokenRequest) (*apiv1.RefreshTokenResponse, error) {
+	refreshM, err := b.store.RefreshToken().Get(ctx, where.F("tokenHash", hashToken(rq.GetRefreshToken())))
+	if err != nil {
+		return nil, err
+	}
+	if refreshM.UsedAt != nil {
+		return nil, b.refreshTokenReused(ctx, refreshM)
+	}
+	if time.Now().After(refreshM.ExpiresAt) {
+		return nil, errno.ErrRefreshTokenInvalid
+	}
+
+	// 并发使用同一个刷新令牌时，只有一个请求可以完成轮换，其余请求同样视为重复使用
+	ok, err := b.store.RefreshToken().MarkUsed(ctx, refreshM.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, b.refreshTokenReused(ctx, refreshM)
+	}
+
+	userM, err := b.store.User().Get(ctx, where.F("userID", refreshM.UserID))
+	if err != nil {
+		if errors.Is(err, errno.ErrUserNotFound) {
+			return nil, errno.ErrRefreshTokenInvalid
+		}
+		return nil, err
+	}
+	// 刷新令牌在用户撤销所有令牌之前签发，例如修改密码之前. 刷新令牌的创建时间只精确到秒，撤销时同一秒内创建的刷新令牌
+	// 已经在同一个事务中删除，这里按秒比较，避免撤销之后立即登录签发的刷新令牌被拒绝
+	if userM.TokensRevokedAt != nil && refreshM.CreatedAt.Before(userM.TokensRevokedAt.Truncate(time.Second)) {
+		return nil, errno.ErrRefreshTokenInvalid
+	}
+
+	// 令牌族 ID 即会话 ID，会话被撤销后刷新令牌同样失效. 旧版本签发的刷新令牌没有对应的会话，继续允许刷新
+	if _, err := b.store.Session().Get(ctx, where.F("sessionID", refreshM.FamilyID)); err != nil {
+		if !errors.Is(err, errno.ErrSessionNotFound) {
+			return nil, err
+		}
+	} else if err := b.store.Session().Touch(ctx, refreshM.FamilyID, time.Now()); err != nil {
+		return nil, err
+	}
+
+	pair, err := b.issueTokenPair(ctx, userM, refreshM.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.RefreshTokenResponse{
+		Token:           pair.token,
+		ExpireAt:        timestamppb.New(pair.expireAt),
+		RefreshToken:    pair.refreshToken,
+		RefreshExpireAt: timestamppb.New(pair.refreshExpireAt),
+	}, nil
+}
+
+// issueTokenPair 为用户签发访问令牌和刷新令牌. familyID 为空时表示一次新的登录，创建新的令牌族和会话，
+// 令牌族 ID 同时也是会话 ID. 访问令牌中会存放用户名和角色，认证时无需再查询.
+func (b *userBiz) issueTokenPair(ctx context.Context, userM *model.UserM, familyID string) (*tokenPair, error) {
+	userID := userM.UserID
+	newSession := familyID == ""
+	if newSession {
+		familyID = uuid.New().String()
+	}
+
+	roles, err := b.authz.GetRolesForUser(userID)
+	if err != nil {
+		return nil, errno.ErrInternal.WithMessage("failed to get roles: %s", err.Error())
+	}
+
+	tokenStr, expireAt, err := token.Sign(userID, token.WithSessionID(familyID), token.WithUsername(userM.Username), token.WithRoles(roles))
+	if err != nil {
+		log.W(ctx).Errorw("Failed to sign token", "err", err)
+		return nil, errno.ErrSignToken
+	}
+
+	buf := make([]byte, tokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, errno.ErrInternal.WithMessage("failed to generate refresh token: %s", err.Error())
+	}
+	refreshToken := base64.RawURLEncoding.EncodeToString(buf)
+	refreshExpireAt := time.Now().Add(b.opts.RefreshTokenTTL)
+
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		// 顺便清理用户已经过期的刷新令牌
+		if err := b.store.RefreshToken().Delete(ctx, where.F("userID", userID).Q("expiresAt < ?", time.Now())); err != nil {
+			return err
+		}
+		if newSession {
+			if err := b.createSession(ctx, userID, familyID); err != nil {
+				return err
+			}
+		}
+		return b.store.RefreshToken().Create(ctx, &model.RefreshTokenM{
+			UserID:    userID,
+			FamilyID:  familyID,
+			TokenHash: hashToken(refreshToken),
+			ExpiresAt: refreshExpireAt,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &tokenPair{token: tokenStr, expireAt: expireAt, refreshToken: refreshToken, refreshExpireAt: refreshExpireAt}, nil
+}
+
+// refreshTokenReused 撤销刷新令牌所在令牌族的所有刷新令牌以及对应的会话，并返回 errno.ErrRefreshTokenReused.
+func (b *userBiz) refreshTokenReused(ctx context.Context, refreshM *model.RefreshTokenM) error {
+	log.W(ctx).Warnw("Refresh token reuse detected, revoking token family", "userID", refreshM.UserID, "familyID", refreshM.FamilyID)
+	if _, err := b.revokeSessions(ctx, where.F("sessionID", refreshM.FamilyID)); err != nil {
+		return err
+	}
+	// 旧版本签发的刷新令牌没有对应的会话
+	if err := b.store.RefreshToken().Delete(ctx, where.F("familyID", refreshM.FamilyID)); err != nil {
+		return err
+	}
+	return errno.ErrRefreshTokenReused
+}
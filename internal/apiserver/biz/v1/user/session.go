@@ -0,0 +1,127 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/ra1n6ow/miniblog/internal/pkg/contextx"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// maxUserAgentLength 为会话中保存的 User-Agent 的最大长度，和数据库字段长度一致.
+const maxUserAgentLength = 512
+
+// ListSessions 实现 UserBiz 接口中的 ListSessions 方法.
+func (b *userBiz) ListSessions(ctx context.Context, rq *apiv1.ListSessionsRequest) (*apiv1.ListSessionsResponse, error) {
+	count, sessionList, err := b.store.Session().List(ctx, where.T(ctx).P(int(rq.GetOffset()), int(rq.GetLimit())))
+	if err != nil {
+		return nil, err
+	}
+
+	current := contextx.SessionID(ctx)
+	sessions := make([]*apiv1.Session, 0, len(sessionList))
+	for _, sessionM := range sessionList {
+		session := conversion.SessionModelToSessionV1(sessionM)
+		session.Current = sessionM.SessionID == current
+		sessions = append(sessions, session)
+	}
+
+	return &apiv1.ListSessionsResponse{TotalCount: count, Sessions: sessions}, nil
+}
+
+// RevokeSession 实现 UserBiz 接口中的 RevokeSession 方法.
+// 可以撤销当前会话，效果和退出登录相同.
+func (b *userBiz) RevokeSession(ctx context.Context, rq *apiv1.RevokeSessionRequest) (*apiv1.RevokeSessionResponse, error) {
+	// 只能撤销自己的会话
+	sessionM, err := b.store.Session().Get(ctx, where.T(ctx).F("sessionID", rq.GetSessionID()))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := b.revokeSessions(ctx, where.F("sessionID", sessionM.SessionID)); err != nil {
+		return nil, err
+	}
+
+	log.W(ctx).Infow("Session revoked", "sessionID", sessionM.SessionID)
+	return &apiv1.RevokeSessionResponse{}, nil
+}
+
+// RevokeAllOtherSessions 实现 UserBiz 接口中的 RevokeAllOtherSessions 方法.
+// 当前请求不属于任何会话时（例如旧版本签发的令牌），撤销用户的所有会话.
+func (b *userBiz) RevokeAllOtherSessions(ctx context.Context, rq *apiv1.RevokeAllOtherSessionsRequest) (*apiv1.RevokeAllOtherSessionsResponse, error) {
+	whr := where.T(ctx)
+	if current := contextx.SessionID(ctx); current != "" {
+		whr = whr.Q("sessionID <> ?", current)
+	}
+
+	count, err := b.revokeSessions(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	log.W(ctx).Infow("Other sessions revoked", "count", count)
+	return &apiv1.RevokeAllOtherSessionsResponse{RevokedCount: int64(count)}, nil
+}
+
+// createSession 为一次登录创建会话，记录客户端 IP 和 User-Agent.
+func (b *userBiz) createSession(ctx context.Context, userID string, sessionID string) error {
+	userAgent := contextx.UserAgent(ctx)
+	if len(userAgent) > maxUserAgentLength {
+		userAgent = userAgent[:maxUserAgentLength]
+	}
+
+	return b.store.Session().Create(ctx, &model.UserSessionM{
+		SessionID:  sessionID,
+		UserID:     userID,
+		ClientIP:   contextx.ClientIP(ctx),
+		UserAgent:  userAgent,
+		LastSeenAt: time.Now(),
+	})
+}
+
+// revokeSessions 在事务中删除满足条件的会话，会话签发的访问令牌立即失效. 返回删除的会话数量.
+func (b *userBiz) revokeSessions(ctx context.Context, whr *where.Options) (int, error) {
+	var sessionIDs []string
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		var err error
+		sessionIDs, err = b.deleteSessions(ctx, whr)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	b.revocations.ForgetSessions(sessionIDs...)
+	return len(sessionIDs), nil
+}
+
+// deleteSessions 删除满足条件的会话以及会话的刷新令牌，返回被删除的会话 ID.
+// 不开启事务，调用方需要在事务中调用，并在事务提交后调用 ForgetSessions 清除缓存.
+func (b *userBiz) deleteSessions(ctx context.Context, whr *where.Options) ([]string, error) {
+	_, sessionList, err := b.store.Session().List(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+	if len(sessionList) == 0 {
+		return nil, nil
+	}
+
+	sessionIDs := make([]string, 0, len(sessionList))
+	for _, sessionM := range sessionList {
+		sessionIDs = append(sessionIDs, sessionM.SessionID)
+	}
+
+	if err := b.store.Session().Delete(ctx, where.F("sessionID", sessionIDs)); err != nil {
+		return nil, err
+	}
+	// 会话 ID 同时也是刷新令牌的令牌族 ID
+	if err := b.store.RefreshToken().Delete(ctx, where.F("familyID", sessionIDs)); err != nil {
+		return nil, err
+	}
+	return sessionIDs, nil
+}
@@ -0,0 +1,64 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+)
+
+// tokenBytes 为一次性令牌的随机字节数.
+const tokenBytes = 32
+
+// issueToken 为用户签发指定用途的一次性令牌，返回令牌明文. 数据库中只保存令牌的摘要，
+// 用户之前签发的同一用途的令牌全部失效.
+func (b *userBiz) issueToken(ctx context.Context, userID string, purpose string, payload string, ttl time.Duration) (string, error) {
+	buf := make([]byte, tokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", errno.ErrInternal.WithMessage("failed to generate token: %s", err.Error())
+	}
+	token := base64.RawURLEncoding.EncodeToString(buf)
+
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.UserToken().Delete(ctx, where.F("userID", userID).F("purpose", purpose)); err != nil {
+			return err
+		}
+		return b.store.UserToken().Create(ctx, &model.UserTokenM{
+			UserID:    userID,
+			Purpose:   purpose,
+			TokenHash: hashToken(token),
+			Payload:   payload,
+			ExpiresAt: time.Now().Add(ttl),
+		})
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// consumeToken 使用一次性令牌，令牌无论是否过期都会被删除. 令牌无效或已过期时返回 errno.ErrUserTokenInvalid.
+func (b *userBiz) consumeToken(ctx context.Context, purpose string, token string) (*model.UserTokenM, error) {
+	tokenM, err := b.store.UserToken().Consume(ctx, purpose, hashToken(token))
+	if err != nil {
+		return nil, err
+	}
+	if time.Now().After(tokenM.ExpiresAt) {
+		return nil, errno.ErrUserTokenInvalid
+	}
+	return tokenM, nil
+}
+
+// hashToken 返回令牌的 SHA-256 摘要. 令牌是高熵的随机数，不需要加盐或使用慢哈希.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,266 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+	"github.com/ra1n6ow/miniblog/internal/pkg/totp"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+const (
+	// tokenPurposeTwoFactorChallenge 为两步验证挑战令牌的用途.
+	tokenPurposeTwoFactorChallenge = "two-factor-challenge"
+	// tokenPurposeRecoveryCode 为两步验证恢复码的用途.
+	tokenPurposeRecoveryCode = "two-factor-recovery"
+	// recoveryCodeCount 为启用两步验证时生成的恢复码数量.
+	recoveryCodeCount = 10
+	// totpSkew 为校验验证码时允许的时钟误差，单位为时间步.
+	totpSkew = 1
+)
+
+// recoveryCodeExpiresAt 为恢复码的过期时间. 恢复码在使用或重新生成之前一直有效.
+var recoveryCodeExpiresAt = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+
+// recoveryCodeEncoding 为恢复码使用的编码，只包含小写字母和数字，方便用户抄写.
+var recoveryCodeEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// EnrollTwoFactor 实现 UserBiz 接口中的 EnrollTwoFactor 方法.
+// 重复调用会生成新的密钥，之前未确认的密钥失效.
+func (b *userBiz) EnrollTwoFactor(ctx context.Context, rq *apiv1.EnrollTwoFactorRequest) (*apiv1.EnrollTwoFactorResponse, error) {
+	userM, err := b.store.User().Get(ctx, where.T(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if userM.TotpEnabledAt != nil {
+		return nil, errno.ErrTwoFactorAlreadyEnabled
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, errno.ErrInternal.WithMessage("failed to generate secret: %s", err.Error())
+	}
+	userM.TotpSecret = &secret
+	if err := b.store.User().Update(ctx, userM); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.EnrollTwoFactorResponse{
+		Secret:          secret,
+		ProvisioningURI: totp.URI(b.opts.TwoFactorIssuer, userM.Username, secret),
+	}, nil
+}
+
+// ConfirmTwoFactor 实现 UserBiz 接口中的 ConfirmTwoFactor 方法.
+func (b *userBiz) ConfirmTwoFactor(ctx context.Context, rq *apiv1.ConfirmTwoFactorRequest) (*apiv1.ConfirmTwoFactorResponse, error) {
+	userM, err := b.store.User().Get(ctx, where.T(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if userM.TotpEnabledAt != nil {
+		return nil, errno.ErrTwoFactorAlreadyEnabled
+	}
+	if userM.TotpSecret == nil {
+		return nil, errno.ErrTwoFactorNotEnrolled
+	}
+
+	step, ok := totp.Validate(*userM.TotpSecret, rq.GetCode(), time.Now(), totpSkew)
+	if !ok {
+		return nil, errno.ErrTwoFactorCodeInvalid
+	}
+
+	codes := make([]string, 0, recoveryCodeCount)
+	for range recoveryCodeCount {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			return nil, errno.ErrInternal.WithMessage("failed to generate recovery code: %s", err.Error())
+		}
+		codes = append(codes, code)
+	}
+
+	now := time.Now()
+	userM.TotpEnabledAt = &now
+	userM.TotpLastStep = step
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.User().Update(ctx, userM); err != nil {
+			return err
+		}
+		if err := b.store.UserToken().Delete(ctx, where.F("userID", userM.UserID).F("purpose", tokenPurposeRecoveryCode)); err != nil {
+			return err
+		}
+		for _, code := range codes {
+			err := b.store.UserToken().Create(ctx, &model.UserTokenM{
+				UserID:    userM.UserID,
+				Purpose:   tokenPurposeRecoveryCode,
+				TokenHash: hashToken(normalizeRecoveryCode(code)),
+				ExpiresAt: recoveryCodeExpiresAt,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	b.users.Forget(userM.UserID)
+
+	return &apiv1.ConfirmTwoFactorResponse{RecoveryCodes: codes}, nil
+}
+
+// VerifyTwoFactor 实现 UserBiz 接口中的 VerifyTwoFactor 方法.
+// 验证码错误时挑战令牌仍然有效，错误次数计入用户名的登录失败记录.
+func (b *userBiz) VerifyTwoFactor(ctx context.Context, rq *apiv1.VerifyTwoFactorRequest) (*apiv1.VerifyTwoFactorResponse, error) {
+	challengeHash := hashToken(rq.GetChallengeToken())
+	challengeM, err := b.store.UserToken().Get(ctx, where.F("purpose", tokenPurposeTwoFactorChallenge).F("tokenHash", challengeHash))
+	if err != nil {
+		return nil, err
+	}
+	if time.Now().After(challengeM.ExpiresAt) {
+		return nil, errno.ErrUserTokenInvalid
+	}
+
+	userM, err := b.store.User().Get(ctx, where.F("userID", challengeM.UserID))
+	if err != nil {
+		if errors.Is(err, errno.ErrUserNotFound) {
+			return nil, errno.ErrUserTokenInvalid
+		}
+		return nil, err
+	}
+	// 签发挑战令牌后管理员重置了两步验证，需要重新登录
+	if userM.TotpEnabledAt == nil || userM.TotpSecret == nil {
+		return nil, errno.ErrUserTokenInvalid
+	}
+
+	if err := b.checkLogin(ctx, userM.Username); err != nil {
+		return nil, err
+	}
+	if err := b.verifySecondFactor(ctx, userM, rq.GetCode()); err != nil {
+		return nil, b.loginFailed(ctx, userM.Username, err)
+	}
+
+	// 验证通过后才使用挑战令牌，并发请求中只有一个可以完成登录
+	if _, err := b.store.UserToken().Consume(ctx, tokenPurposeTwoFactorChallenge, challengeHash); err != nil {
+		return nil, err
+	}
+	b.loginSucceeded(userM.Username)
+
+	pair, err := b.issueTokenPair(ctx, userM, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.VerifyTwoFactorResponse{
+		Token:           pair.token,
+		ExpireAt:        timestamppb.New(pair.expireAt),
+		RefreshToken:    pair.refreshToken,
+		RefreshExpireAt: timestamppb.New(pair.refreshExpireAt),
+	}, nil
+}
+
+// ResetTwoFactor 实现 UserBiz 接口中的 ResetTwoFactor 方法.
+// 只有管理员可以调用该方法，由 casbin 策略保证.
+func (b *userBiz) ResetTwoFactor(ctx context.Context, rq *apiv1.ResetTwoFactorRequest) (*apiv1.ResetTwoFactorResponse, error) {
+	userM, err := b.store.User().Get(ctx, where.F("userID", rq.GetUserID()))
+	if err != nil {
+		return nil, err
+	}
+
+	userM.TotpSecret = nil
+	userM.TotpEnabledAt = nil
+	userM.TotpLastStep = 0
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.User().Update(ctx, userM); err != nil {
+			return err
+		}
+		whr := where.F("userID", userM.UserID).F("purpose", []string{tokenPurposeRecoveryCode, tokenPurposeTwoFactorChallenge})
+		return b.store.UserToken().Delete(ctx, whr)
+	})
+	if err != nil {
+		return nil, err
+	}
+	b.users.Forget(userM.UserID)
+
+	log.W(ctx).Infow("User two-factor authentication reset", "userID", userM.UserID)
+	return &apiv1.ResetTwoFactorResponse{}, nil
+}
+
+// loginChallenge 为启用了两步验证的用户签发挑战令牌.
+func (b *userBiz) loginChallenge(ctx context.Context, userM *model.UserM) (*apiv1.LoginResponse, error) {
+	challenge, err := b.issueToken(ctx, userM.UserID, tokenPurposeTwoFactorChallenge, "", b.opts.TwoFactorChallengeTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.LoginResponse{TwoFactorRequired: true, ChallengeToken: challenge}, nil
+}
+
+// verifySecondFactor 校验验证器应用生成的验证码或者一次性恢复码.
+func (b *userBiz) verifySecondFactor(ctx context.Context, userM *model.UserM, code string) error {
+	if isTOTPCode(code) {
+		step, ok := totp.Validate(*userM.TotpSecret, code, time.Now(), totpSkew)
+		// 同一个验证码只能使用一次
+		if !ok || step <= userM.TotpLastStep {
+			return errno.ErrTwoFactorCodeInvalid
+		}
+		userM.TotpLastStep = step
+		return b.store.User().Update(ctx, userM)
+	}
+
+	codeHash := hashToken(normalizeRecoveryCode(code))
+	if _, err := b.store.UserToken().Get(ctx, where.F("userID", userM.UserID).F("purpose", tokenPurposeRecoveryCode).F("tokenHash", codeHash)); err != nil {
+		if errors.Is(err, errno.ErrUserTokenInvalid) {
+			return errno.ErrTwoFactorCodeInvalid
+		}
+		return err
+	}
+	if _, err := b.store.UserToken().Consume(ctx, tokenPurposeRecoveryCode, codeHash); err != nil {
+		if errors.Is(err, errno.ErrUserTokenInvalid) {
+			return errno.ErrTwoFactorCodeInvalid
+		}
+		return err
+	}
+
+	log.W(ctx).Infow("User logged in with a recovery code", "userID", userM.UserID)
+	return nil
+}
+
+// generateRecoveryCode 生成一个 xxxxx-xxxxx 格式的恢复码.
+func generateRecoveryCode() (string, error) {
+	buf := make([]byte, 7)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	code := recoveryCodeEncoding.EncodeToString(buf)
+	return code[:5] + "-" + code[5:10], nil
+}
+
+// normalizeRecoveryCode 去掉恢复码中的分隔符和空白，并转换为小写.
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
+
+// isTOTPCode 判断 code 是否为验证器应用生成的数字验证码.
+func isTOTPCode(code string) bool {
+	if len(code) != totp.Digits {
+		return false
+	}
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,27 @@
+package user
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecoveryCode(t *testing.T) {
+	code, err := generateRecoveryCode()
+	require.NoError(t, err)
+	assert.Regexp(t, regexp.MustCompile(`^[a-z2-7]{5}-[a-z2-7]{5}$`), code)
+	assert.False(t, isTOTPCode(code))
+
+	// 用户输入时大小写、分隔符和空白不影响结果
+	assert.Equal(t, normalizeRecoveryCode(code), normalizeRecoveryCode(" "+code[:5]+" "+code[6:]+" "))
+	assert.Equal(t, "abcdefghij", normalizeRecoveryCode("ABCDE-FGHIJ"))
+}
+
+func TestIsTOTPCode(t *testing.T) {
+	assert.True(t, isTOTPCode("012345"))
+	assert.False(t, isTOTPCode("01234"))
+	assert.False(t, isTOTPCode("01234a"))
+	assert.False(t, isTOTPCode("0123456"))
+}
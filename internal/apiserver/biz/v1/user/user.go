@@ -3,6 +3,7 @@ package user
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/jinzhu/copier"
 	"github.com/ra1n6ow/gpkg/store/where"
@@ -11,14 +12,17 @@ import (
 
 	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
 	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/revocation"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/usercache"
 	"github.com/ra1n6ow/miniblog/internal/apiserver/store"
 	"github.com/ra1n6ow/miniblog/internal/pkg/contextx"
 	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
 	"github.com/ra1n6ow/miniblog/internal/pkg/known"
 	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+	"github.com/ra1n6ow/miniblog/internal/pkg/mailer"
+	"github.com/ra1n6ow/miniblog/internal/pkg/oidc"
 	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
 	"github.com/ra1n6ow/miniblog/pkg/auth"
-	"github.com/ra1n6ow/miniblog/pkg/token"
 )
 
 // UserBiz 定义处理用户请求所需的方法.
@@ -37,57 +41,163 @@ type UserExpansion interface {
 	Login(ctx context.Context, rq *apiv1.LoginRequest) (*apiv1.LoginResponse, error)
 	RefreshToken(ctx context.Context, rq *apiv1.RefreshTokenRequest) (*apiv1.RefreshTokenResponse, error)
 	ChangePassword(ctx context.Context, rq *apiv1.ChangePasswordRequest) (*apiv1.ChangePasswordResponse, error)
+	// Logout 撤销当前请求所属的会话、使用的访问令牌和请求中的刷新令牌.
+	Logout(ctx context.Context, rq *apiv1.LogoutRequest) (*apiv1.LogoutResponse, error)
+	// ListSessions 列出当前用户的登录会话.
+	ListSessions(ctx context.Context, rq *apiv1.ListSessionsRequest) (*apiv1.ListSessionsResponse, error)
+	// RevokeSession 撤销当前用户的一个登录会话，该会话签发的令牌立即失效.
+	RevokeSession(ctx context.Context, rq *apiv1.RevokeSessionRequest) (*apiv1.RevokeSessionResponse, error)
+	// RevokeAllOtherSessions 撤销当前用户除当前会话之外的所有登录会话.
+	RevokeAllOtherSessions(ctx context.Context, rq *apiv1.RevokeAllOtherSessionsRequest) (*apiv1.RevokeAllOtherSessionsResponse, error)
+	// CreateAccessToken 为当前用户创建个人访问令牌.
+	CreateAccessToken(ctx context.Context, rq *apiv1.CreateAccessTokenRequest) (*apiv1.CreateAccessTokenResponse, error)
+	// ListAccessTokens 列出当前用户的个人访问令牌.
+	ListAccessTokens(ctx context.Context, rq *apiv1.ListAccessTokensRequest) (*apiv1.ListAccessTokensResponse, error)
+	// RevokeAccessToken 撤销当前用户的个人访问令牌，令牌立即失效.
+	RevokeAccessToken(ctx context.Context, rq *apiv1.RevokeAccessTokenRequest) (*apiv1.RevokeAccessTokenResponse, error)
 	ListWithBadPerformance(ctx context.Context, rq *apiv1.ListUserRequest) (*apiv1.ListUserResponse, error)
+	// SendVerificationEmail 为当前用户签发新的邮箱验证令牌，并重新发送验证邮件.
+	SendVerificationEmail(ctx context.Context, rq *apiv1.SendVerificationEmailRequest) (*apiv1.SendVerificationEmailResponse, error)
+	// VerifyEmail 使用验证邮件中的一次性令牌验证用户的电子邮箱，调用方不需要登录.
+	VerifyEmail(ctx context.Context, rq *apiv1.VerifyEmailRequest) (*apiv1.VerifyEmailResponse, error)
+	// RequestPasswordReset 向邮箱对应的用户发送重置密码邮件，调用方不需要登录.
+	RequestPasswordReset(ctx context.Context, rq *apiv1.RequestPasswordResetRequest) (*apiv1.RequestPasswordResetResponse, error)
+	// ResetPassword 使用重置密码邮件中的一次性令牌设置新密码，调用方不需要登录.
+	ResetPassword(ctx context.Context, rq *apiv1.ResetPasswordRequest) (*apiv1.ResetPasswordResponse, error)
+	// UnlockUser 清除用户的登录失败记录，解除登录锁定.
+	UnlockUser(ctx context.Context, rq *apiv1.UnlockUserRequest) (*apiv1.UnlockUserResponse, error)
+	// EnrollTwoFactor 为当前用户生成新的 TOTP 密钥.
+	EnrollTwoFactor(ctx context.Context, rq *apiv1.EnrollTwoFactorRequest) (*apiv1.EnrollTwoFactorResponse, error)
+	// ConfirmTwoFactor 校验验证码后启用两步验证，并生成一次性恢复码.
+	ConfirmTwoFactor(ctx context.Context, rq *apiv1.ConfirmTwoFactorRequest) (*apiv1.ConfirmTwoFactorResponse, error)
+	// VerifyTwoFactor 使用 Login 返回的挑战令牌和验证码完成登录，调用方不需要登录.
+	VerifyTwoFactor(ctx context.Context, rq *apiv1.VerifyTwoFactorRequest) (*apiv1.VerifyTwoFactorResponse, error)
+	// ResetTwoFactor 关闭用户的两步验证.
+	ResetTwoFactor(ctx context.Context, rq *apiv1.ResetTwoFactorRequest) (*apiv1.ResetTwoFactorResponse, error)
+	// StartOIDCLogin 生成身份提供方的授权地址，发起单点登录，调用方不需要登录.
+	StartOIDCLogin(ctx context.Context, rq *apiv1.StartOIDCLoginRequest) (*apiv1.StartOIDCLoginResponse, error)
+	// CompleteOIDCLogin 使用身份提供方返回的授权码完成单点登录，调用方不需要登录.
+	CompleteOIDCLogin(ctx context.Context, rq *apiv1.CompleteOIDCLoginRequest) (*apiv1.CompleteOIDCLoginResponse, error)
+}
+
+// Options 定义用户业务的可配置项.
+type Options struct {
+	// ExternalURL 为用户访问服务的地址，用于生成邮件中的链接.
+	ExternalURL string
+	// VerificationTTL 为邮箱验证链接的有效期.
+	VerificationTTL time.Duration
+	// RequireVerifiedEmailToLogin 为 true 时，邮箱未验证的用户不能登录.
+	RequireVerifiedEmailToLogin bool
+	// PasswordResetTTL 为重置密码令牌的有效期.
+	PasswordResetTTL time.Duration
+	// PasswordResetsPerEmail 为每个邮箱每小时最多可以申请重置密码的次数.
+	PasswordResetsPerEmail int
+	// LoginMaxFailures 为同一用户名连续登录失败多少次后锁定账号.
+	LoginMaxFailures int
+	// LoginMaxFailuresPerIP 为同一客户端 IP 连续登录失败多少次后禁止该 IP 登录.
+	LoginMaxFailuresPerIP int
+	// LoginLockoutDuration 为登录锁定的时长.
+	LoginLockoutDuration time.Duration
+	// LoginDelay 为第一次登录失败后需要等待的时间，之后每次失败翻倍.
+	LoginDelay time.Duration
+	// TwoFactorIssuer 为验证器应用中显示的服务名称.
+	TwoFactorIssuer string
+	// TwoFactorChallengeTTL 为两步验证挑战令牌的有效期.
+	TwoFactorChallengeTTL time.Duration
+	// RefreshTokenTTL 为刷新令牌的有效期.
+	RefreshTokenTTL time.Duration
+	// OIDCProviders 为单点登录的身份提供方.
+	OIDCProviders []*oidc.Options
+	// OIDCDefaultRole 为通过单点登录自动创建的用户授予的角色.
+	OIDCDefaultRole string
 }
 
 // userBiz 是 UserBiz 接口的实现.
 type userBiz struct {
-	store store.IStore
-	authz *auth.Authz
+	store  store.IStore
+	authz  *auth.Authz
+	mailer mailer.Mailer
+	guards *Guards
+	opts   *Options
+	// revocations 为访问令牌的撤销列表，和认证中间件共享.
+	revocations *revocation.List
+	// users 为用户信息缓存，和认证中间件共享. 修改或者删除用户后需要清除缓存.
+	users *usercache.Cache
+	// oidcProviders 为单点登录的身份提供方，key 为身份提供方名称.
+	oidcProviders map[string]*oidc.Provider
 }
 
 // 确保 userBiz 实现了 UserBiz 接口.
 var _ UserBiz = (*userBiz)(nil)
 
-func New(store store.IStore, authz *auth.Authz) *userBiz {
-	return &userBiz{store: store, authz: authz}
+func New(
+	store store.IStore,
+	authz *auth.Authz,
+	mailer mailer.Mailer,
+	guards *Guards,
+	revocations *revocation.List,
+	users *usercache.Cache,
+	oidcProviders map[string]*oidc.Provider,
+	opts *Options,
+) *userBiz {
+	return &userBiz{
+		store:         store,
+		authz:         authz,
+		mailer:        mailer,
+		guards:        guards,
+		revocations:   revocations,
+		users:         users,
+		oidcProviders: oidcProviders,
+		opts:          opts,
+	}
 }
 
 // Login 实现 UserBiz 接口中的 Login 方法.
 func (b *userBiz) Login(ctx context.Context, rq *apiv1.LoginRequest) (*apiv1.LoginResponse, error) {
+	// 连续登录失败的用户名和客户端 IP 需要等待一段时间或者被锁定
+	if err := b.checkLogin(ctx, rq.GetUsername()); err != nil {
+		return nil, err
+	}
+
 	// 获取登录用户的所有信息
 	whr := where.F("username", rq.GetUsername())
 	userM, err := b.store.User().Get(ctx, whr)
 	if err != nil {
-		return nil, errno.ErrUserNotFound
+		return nil, b.loginFailed(ctx, rq.GetUsername(), errno.ErrUserNotFound)
 	}
 
 	// 对比传入的明文密码和数据库中已加密过的密码是否匹配
 	if err := auth.Compare(userM.Password, rq.GetPassword()); err != nil {
 		log.W(ctx).Errorw("Failed to compare password", "err", err)
-		return nil, errno.ErrPasswordInvalid
+		return nil, b.loginFailed(ctx, rq.GetUsername(), errno.ErrPasswordInvalid)
 	}
 
-	// 如果匹配成功，说明登录成功，签发 token 并返回
-	tokenStr, expireAt, err := token.Sign(userM.UserID)
-	if err != nil {
-		log.W(ctx).Errorw("Failed to sign token", "err", err)
-		return nil, errno.ErrSignToken
+	// 邮箱未验证的用户无法登录，也就无法自行重新发送验证邮件，因此拒绝登录时重新发送一次
+	if b.opts.RequireVerifiedEmailToLogin && userM.EmailVerifiedAt == nil {
+		if err := b.sendVerificationEmail(ctx, userM); err != nil {
+			log.W(ctx).Errorw("Failed to resend verification email on login", "err", err, "userID", userM.UserID)
+		}
+		return nil, errno.ErrEmailNotVerified
 	}
 
-	return &apiv1.LoginResponse{Token: tokenStr, ExpireAt: timestamppb.New(expireAt)}, nil
-}
+	// 启用了两步验证的用户还需要调用 VerifyTwoFactor 提交验证码，完成之前不清除登录失败记录
+	if userM.TotpEnabledAt != nil {
+		return b.loginChallenge(ctx, userM)
+	}
+	b.loginSucceeded(rq.GetUsername())
 
-// RefreshToken 用于刷新用户的身份验证令牌.
-// 当用户的令牌即将过期时，可以调用此方法生成一个新的令牌.
-func (b *userBiz) RefreshToken(ctx context.Context, rq *apiv1.RefreshTokenRequest) (*apiv1.RefreshTokenResponse, error) {
-	tokenStr, expireAt, err := token.Sign(contextx.UserID(ctx))
+	// 如果匹配成功，说明登录成功，签发 token 和刷新令牌并返回
+	pair, err := b.issueTokenPair(ctx, userM, "")
 	if err != nil {
-		log.W(ctx).Errorw("Failed to sign refresh token", "err", err)
-		return nil, errno.ErrSignToken
+		return nil, err
 	}
 
-	return &apiv1.RefreshTokenResponse{Token: tokenStr, ExpireAt: timestamppb.New(expireAt)}, nil
+	return &apiv1.LoginResponse{
+		Token:           pair.token,
+		ExpireAt:        timestamppb.New(pair.expireAt),
+		RefreshToken:    pair.refreshToken,
+		RefreshExpireAt: timestamppb.New(pair.refreshExpireAt),
+	}, nil
 }
 
 // ChangePassword 实现 UserBiz 接口中的 ChangePassword 方法.
@@ -103,9 +213,23 @@ func (b *userBiz) ChangePassword(ctx context.Context, rq *apiv1.ChangePasswordRe
 	}
 
 	userM.Password, _ = auth.Encrypt(rq.GetNewPassword())
-	if err := b.store.User().Update(ctx, userM); err != nil {
+	// 修改密码后，之前签发的访问令牌和刷新令牌全部失效，需要重新登录
+	revokeAllTokens(userM)
+	var sessionIDs []string
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.User().Update(ctx, userM); err != nil {
+			return err
+		}
+		if sessionIDs, err = b.deleteSessions(ctx, where.F("userID", userM.UserID)); err != nil {
+			return err
+		}
+		return b.store.RefreshToken().Delete(ctx, where.F("userID", userM.UserID))
+	})
+	if err != nil {
 		return nil, err
 	}
+	b.revocations.ForgetSessions(sessionIDs...)
+	b.users.Forget(userM.UserID)
 
 	return &apiv1.ChangePasswordResponse{}, nil
 }
@@ -125,6 +249,11 @@ func (b *userBiz) Create(ctx context.Context, rq *apiv1.CreateUserRequest) (*api
 		return nil, errno.ErrAddRole.WithMessage("%s", err.Error())
 	}
 
+	// 验证邮件发送失败不影响注册，用户可以稍后重新发送
+	if err := b.sendVerificationEmail(ctx, &userM); err != nil {
+		log.W(ctx).Errorw("Failed to send verification email on signup", "err", err, "userID", userM.UserID)
+	}
+
 	return &apiv1.CreateUserResponse{UserID: userM.UserID}, nil
 }
 
@@ -138,19 +267,29 @@ func (b *userBiz) Update(ctx context.Context, rq *apiv1.UpdateUserRequest) (*api
 	if rq.Username != nil {
 		userM.Username = rq.GetUsername()
 	}
-	if rq.Email != nil {
+	// 修改邮箱后需要重新验证
+	emailChanged := rq.Email != nil && rq.GetEmail() != userM.Email
+	if emailChanged {
 		userM.Email = rq.GetEmail()
+		userM.EmailVerifiedAt = nil
 	}
 	if rq.Nickname != nil {
 		userM.Nickname = rq.GetNickname()
 	}
 	if rq.Phone != nil {
-		userM.Phone = rq.GetPhone()
+		userM.Phone = rq.Phone
 	}
 
 	if err := b.store.User().Update(ctx, userM); err != nil {
 		return nil, err
 	}
+	b.users.Forget(userM.UserID)
+
+	if emailChanged {
+		if err := b.sendVerificationEmail(ctx, userM); err != nil {
+			log.W(ctx).Errorw("Failed to send verification email after email change", "err", err, "userID", userM.UserID)
+		}
+	}
 
 	return &apiv1.UpdateUserResponse{}, nil
 }
@@ -162,9 +301,46 @@ func (b *userBiz) Delete(ctx context.Context, rq *apiv1.DeleteUserRequest) (*api
 	if err := b.store.User().Delete(ctx, where.F("userID", rq.GetUserID())); err != nil {
 		return nil, err
 	}
+	b.users.Forget(rq.GetUserID())
+
+	// 被删除的用户不再出现在博客的提及中
+	if err := b.store.Mention().Delete(ctx, where.F("userID", rq.GetUserID())); err != nil {
+		return nil, err
+	}
+
+	// 博客模板只对所属用户可见，用户删除后一并清理
+	if err := b.store.Template().Delete(ctx, where.F("userID", rq.GetUserID())); err != nil {
+		return nil, err
+	}
+
+	if err := b.store.UserToken().Delete(ctx, where.F("userID", rq.GetUserID())); err != nil {
+		return nil, err
+	}
+
+	if err := b.store.RefreshToken().Delete(ctx, where.F("userID", rq.GetUserID())); err != nil {
+		return nil, err
+	}
+
+	if _, err := b.revokeSessions(ctx, where.F("userID", rq.GetUserID())); err != nil {
+		return nil, err
+	}
+
+	if err := b.deleteAccessTokens(ctx, where.F("userID", rq.GetUserID())); err != nil {
+		return nil, err
+	}
+
+	if err := b.store.Identity().Delete(ctx, where.F("userID", rq.GetUserID())); err != nil {
+		return nil, err
+	}
+
+	// 用户删除后无法再撤销自己创建的预览链接，因此一并删除
+	if err := b.store.Preview().Delete(ctx, where.F("userID", rq.GetUserID())); err != nil {
+		return nil, err
+	}
 
-	if _, err := b.authz.RemoveGroupingPolicy(rq.GetUserID(), known.RoleUser); err != nil {
-		log.W(ctx).Errorw("Failed to remove grouping policy for user", "user", rq.GetUserID(), "role", known.RoleUser)
+	// 通过单点登录创建的用户可能被授予了其他角色，因此删除用户的所有角色
+	if _, err := b.authz.RemoveFilteredGroupingPolicy(0, rq.GetUserID()); err != nil {
+		log.W(ctx).Errorw("Failed to remove grouping policies for user", "user", rq.GetUserID())
 		return nil, errno.ErrRemoveRole.WithMessage("%s", err.Error())
 	}
 
@@ -0,0 +1,91 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+	"github.com/ra1n6ow/miniblog/internal/pkg/mailer"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// tokenPurposeEmailVerification 为邮箱验证令牌的用途.
+const tokenPurposeEmailVerification = "email-verification"
+
+// SendVerificationEmail 实现 UserBiz 接口中的 SendVerificationEmail 方法.
+func (b *userBiz) SendVerificationEmail(ctx context.Context, rq *apiv1.SendVerificationEmailRequest) (*apiv1.SendVerificationEmailResponse, error) {
+	userM, err := b.store.User().Get(ctx, where.T(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if userM.EmailVerifiedAt != nil {
+		return nil, errno.ErrEmailAlreadyVerified
+	}
+
+	if err := b.sendVerificationEmail(ctx, userM); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.SendVerificationEmailResponse{}, nil
+}
+
+// VerifyEmail 实现 UserBiz 接口中的 VerifyEmail 方法.
+func (b *userBiz) VerifyEmail(ctx context.Context, rq *apiv1.VerifyEmailRequest) (*apiv1.VerifyEmailResponse, error) {
+	tokenM, err := b.consumeToken(ctx, tokenPurposeEmailVerification, rq.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	userM, err := b.store.User().Get(ctx, where.F("userID", tokenM.UserID))
+	if err != nil {
+		if errors.Is(err, errno.ErrUserNotFound) {
+			return nil, errno.ErrUserTokenInvalid
+		}
+		return nil, err
+	}
+	// 令牌签发后用户修改了邮箱时，令牌不能用于验证新的邮箱
+	if userM.Email != tokenM.Payload {
+		return nil, errno.ErrUserTokenInvalid
+	}
+
+	if userM.EmailVerifiedAt == nil {
+		now := time.Now()
+		userM.EmailVerifiedAt = &now
+		if err := b.store.User().Update(ctx, userM); err != nil {
+			return nil, err
+		}
+		b.users.Forget(userM.UserID)
+	}
+
+	return &apiv1.VerifyEmailResponse{UserID: userM.UserID, Email: userM.Email}, nil
+}
+
+// sendVerificationEmail 为用户签发新的邮箱验证令牌，并将验证链接发送到用户的邮箱.
+func (b *userBiz) sendVerificationEmail(ctx context.Context, userM *model.UserM) error {
+	token, err := b.issueToken(ctx, userM.UserID, tokenPurposeEmailVerification, userM.Email, b.opts.VerificationTTL)
+	if err != nil {
+		return err
+	}
+
+	link := strings.TrimSuffix(b.opts.ExternalURL, "/") + "/v1/verify-email?" + url.Values{"token": []string{token}}.Encode()
+	msg := &mailer.Message{
+		To:      userM.Email,
+		Subject: "请验证你的 miniblog 邮箱",
+		Body: fmt.Sprintf("%s，你好：\n\n请在 %s 内打开下面的链接完成邮箱验证：\n\n%s\n\n如果这不是你的操作，请忽略这封邮件。\n",
+			userM.Username, b.opts.VerificationTTL, link),
+	}
+	if err := b.mailer.Send(ctx, msg); err != nil {
+		log.W(ctx).Errorw("Failed to send verification email", "err", err, "userID", userM.UserID)
+		return errno.ErrSendMail
+	}
+
+	return nil
+}
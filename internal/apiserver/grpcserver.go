@@ -7,6 +7,8 @@ package apiserver
 
 import (
 	"context"
+	"net/http"
+	"time"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/selector"
@@ -16,8 +18,10 @@ import (
 
 	handler "github.com/ra1n6ow/miniblog/internal/apiserver/handler/grpc"
 	mw "github.com/ra1n6ow/miniblog/internal/pkg/middleware/grpc"
+	"github.com/ra1n6ow/miniblog/internal/pkg/ratelimit"
 	"github.com/ra1n6ow/miniblog/internal/pkg/server"
 	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+	"github.com/ra1n6ow/miniblog/pkg/token"
 )
 
 // grpcServer 定义一个 gRPC 服务器.
@@ -38,16 +42,31 @@ var _ server.Server = (*grpcServer)(nil)
 //  2. 处理默认值或回退逻辑
 //  3. 表达灵活选项
 func (c *ServerConfig) NewGRPCServerOr() (server.Server, error) {
+	// 只有 gRPC-Gateway 运行在同一个进程中时才信任本机地址. 纯 gRPC 模式下没有本机代理，
+	// 信任本机地址会让本机客户端伪造 x-forwarded-for 绕过按客户端 IP 的限流
+	clientIP := c.cfg.ClientIP
+	if c.cfg.ServerMode == GRPCGatewayServerMode {
+		clientIP = clientIP.WithLoopback()
+	}
+
 	// 配置 gRPC 服务器选项，包括拦截器链
 	serverOptions := []grpc.ServerOption{
 		// 注意拦截器顺序！
 		grpc.ChainUnaryInterceptor(
 			// 请求 ID 拦截器
 			mw.RequestIDInterceptor(),
+			// 客户端 IP 拦截器，gRPC-Gateway 通过本机地址转发请求，并将 HTTP 客户端的地址追加到 x-forwarded-for
+			mw.ClientIPInterceptor(clientIP),
+			// 预览链接限流拦截器，只对不需要认证的预览接口生效
+			selector.UnaryServerInterceptor(mw.RateLimitInterceptor(ratelimit.New(c.cfg.PreviewRateLimit, time.Minute)), NewPreviewMatcher()),
+			// 重置密码限流拦截器，只对不需要认证的重置密码接口生效
+			selector.UnaryServerInterceptor(mw.RateLimitInterceptor(ratelimit.New(c.cfg.PasswordResetRateLimit, time.Minute)), NewPasswordResetMatcher()),
 			// 认证拦截器
-			selector.UnaryServerInterceptor(mw.AuthnInterceptor(c.retriever), NewAuthnWhiteListMatcher()),
+			selector.UnaryServerInterceptor(mw.AuthnInterceptor(c.retriever, c.checker), NewAuthnWhiteListMatcher()),
 			// 授权拦截器
 			selector.UnaryServerInterceptor(mw.AuthzInterceptor(c.authz), NewAuthzWhiteListMatcher()),
+			// 个人访问令牌权限范围拦截器，在 casbin 授权之后生效
+			selector.UnaryServerInterceptor(mw.ScopeInterceptor(grpcScopes), NewAuthzWhiteListMatcher()),
 			// 请求默认值设置拦截器
 			mw.DefaulterInterceptor(),
 			// 数据校验拦截器
@@ -80,6 +99,13 @@ func (c *ServerConfig) NewGRPCServerOr() (server.Server, error) {
 	// 先启动 gRPC 服务器，因为 HTTP 服务器依赖 gRPC 服务器.
 	go grpcsrv.RunOrDie()
 
+	// 签名公钥不是 gRPC 接口，在转发给 gRPC-Gateway 之前处理.
+	// 启用内置 HTML 前端时，由 HTML 前端优先处理页面请求，其余请求转发给 gRPC-Gateway
+	wrappers := []func(http.Handler) http.Handler{wrapJWKS}
+	if c.web != nil {
+		wrappers = append(wrappers, c.web.Wrap)
+	}
+
 	httpsrv, err := server.NewGRPCGatewayServer(
 		c.cfg.HTTPOptions,
 		c.cfg.GRPCOptions,
@@ -87,6 +113,7 @@ func (c *ServerConfig) NewGRPCServerOr() (server.Server, error) {
 		func(mux *runtime.ServeMux, conn *grpc.ClientConn) error {
 			return apiv1.RegisterMiniBlogHandler(context.Background(), mux, conn)
 		},
+		wrappers...,
 	)
 	if err != nil {
 		return nil, err
@@ -111,12 +138,28 @@ func (s *grpcServer) GracefulStop(ctx context.Context) {
 	s.stop(ctx)
 }
 
+// wrapJWKS 在 gRPC-Gateway 之前处理签名公钥的请求.
+func wrapJWKS(next http.Handler) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("GET "+jwksPath, token.JWKSHandler())
+	mux.Handle("/", next)
+	return mux
+}
+
 // NewAuthnWhiteListMatcher 创建认证白名单匹配器.
 func NewAuthnWhiteListMatcher() selector.Matcher {
 	whitelist := map[string]struct{}{
-		apiv1.MiniBlog_Healthz_FullMethodName:    {},
-		apiv1.MiniBlog_CreateUser_FullMethodName: {},
-		apiv1.MiniBlog_Login_FullMethodName:      {},
+		apiv1.MiniBlog_Healthz_FullMethodName:              {},
+		apiv1.MiniBlog_CreateUser_FullMethodName:           {},
+		apiv1.MiniBlog_Login_FullMethodName:                {},
+		apiv1.MiniBlog_VerifyTwoFactor_FullMethodName:      {},
+		apiv1.MiniBlog_StartOIDCLogin_FullMethodName:       {},
+		apiv1.MiniBlog_CompleteOIDCLogin_FullMethodName:    {},
+		apiv1.MiniBlog_RefreshToken_FullMethodName:         {},
+		apiv1.MiniBlog_GetPreview_FullMethodName:           {},
+		apiv1.MiniBlog_VerifyEmail_FullMethodName:          {},
+		apiv1.MiniBlog_RequestPasswordReset_FullMethodName: {},
+		apiv1.MiniBlog_ResetPassword_FullMethodName:        {},
 	}
 	return selector.MatchFunc(func(ctx context.Context, call interceptors.CallMeta) bool {
 		_, ok := whitelist[call.FullMethod()]
@@ -127,12 +170,35 @@ func NewAuthnWhiteListMatcher() selector.Matcher {
 // NewAuthzWhiteListMatcher 创建授权白名单匹配器.
 func NewAuthzWhiteListMatcher() selector.Matcher {
 	whitelist := map[string]struct{}{
-		apiv1.MiniBlog_Healthz_FullMethodName:    {},
-		apiv1.MiniBlog_CreateUser_FullMethodName: {},
-		apiv1.MiniBlog_Login_FullMethodName:      {},
+		apiv1.MiniBlog_Healthz_FullMethodName:              {},
+		apiv1.MiniBlog_CreateUser_FullMethodName:           {},
+		apiv1.MiniBlog_Login_FullMethodName:                {},
+		apiv1.MiniBlog_VerifyTwoFactor_FullMethodName:      {},
+		apiv1.MiniBlog_StartOIDCLogin_FullMethodName:       {},
+		apiv1.MiniBlog_CompleteOIDCLogin_FullMethodName:    {},
+		apiv1.MiniBlog_RefreshToken_FullMethodName:         {},
+		apiv1.MiniBlog_GetPreview_FullMethodName:           {},
+		apiv1.MiniBlog_VerifyEmail_FullMethodName:          {},
+		apiv1.MiniBlog_RequestPasswordReset_FullMethodName: {},
+		apiv1.MiniBlog_ResetPassword_FullMethodName:        {},
 	}
 	return selector.MatchFunc(func(ctx context.Context, call interceptors.CallMeta) bool {
 		_, ok := whitelist[call.FullMethod()]
 		return !ok
 	})
 }
+
+// NewPreviewMatcher 创建只匹配预览链接查看接口的匹配器.
+func NewPreviewMatcher() selector.Matcher {
+	return selector.MatchFunc(func(ctx context.Context, call interceptors.CallMeta) bool {
+		return call.FullMethod() == apiv1.MiniBlog_GetPreview_FullMethodName
+	})
+}
+
+// NewPasswordResetMatcher 创建只匹配重置密码相关接口的匹配器.
+func NewPasswordResetMatcher() selector.Matcher {
+	return selector.MatchFunc(func(ctx context.Context, call interceptors.CallMeta) bool {
+		return call.FullMethod() == apiv1.MiniBlog_RequestPasswordReset_FullMethodName ||
+			call.FullMethod() == apiv1.MiniBlog_ResetPassword_FullMethodName
+	})
+}
@@ -0,0 +1,22 @@
+package grpc
+
+import (
+	"context"
+
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// CreateAccessToken 为当前用户创建个人访问令牌.
+func (h *Handler) CreateAccessToken(ctx context.Context, rq *apiv1.CreateAccessTokenRequest) (*apiv1.CreateAccessTokenResponse, error) {
+	return h.biz.UserV1().CreateAccessToken(ctx, rq)
+}
+
+// ListAccessTokens 列出当前用户的个人访问令牌.
+func (h *Handler) ListAccessTokens(ctx context.Context, rq *apiv1.ListAccessTokensRequest) (*apiv1.ListAccessTokensResponse, error) {
+	return h.biz.UserV1().ListAccessTokens(ctx, rq)
+}
+
+// RevokeAccessToken 撤销当前用户的个人访问令牌.
+func (h *Handler) RevokeAccessToken(ctx context.Context, rq *apiv1.RevokeAccessTokenRequest) (*apiv1.RevokeAccessTokenResponse, error) {
+	return h.biz.UserV1().RevokeAccessToken(ctx, rq)
+}
@@ -0,0 +1,27 @@
+package grpc
+
+import (
+	"context"
+
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// ListPostActivity 按天或按周列出用户创建博客的数量.
+func (h *Handler) ListPostActivity(ctx context.Context, rq *apiv1.ListPostActivityRequest) (*apiv1.ListPostActivityResponse, error) {
+	return h.biz.AnalyticsV1().ListPostActivity(ctx, rq)
+}
+
+// ListTopAuthors 列出创建博客最多的用户.
+func (h *Handler) ListTopAuthors(ctx context.Context, rq *apiv1.ListTopAuthorsRequest) (*apiv1.ListTopAuthorsResponse, error) {
+	return h.biz.AnalyticsV1().ListTopAuthors(ctx, rq)
+}
+
+// ListSiteStats 按天或按周列出全站用户和博客数量.
+func (h *Handler) ListSiteStats(ctx context.Context, rq *apiv1.ListSiteStatsRequest) (*apiv1.ListSiteStatsResponse, error) {
+	return h.biz.AnalyticsV1().ListSiteStats(ctx, rq)
+}
+
+// GetMyPostStats 获取当前用户的博客统计.
+func (h *Handler) GetMyPostStats(ctx context.Context, rq *apiv1.GetMyPostStatsRequest) (*apiv1.GetMyPostStatsResponse, error) {
+	return h.biz.AnalyticsV1().GetMyPostStats(ctx, rq)
+}
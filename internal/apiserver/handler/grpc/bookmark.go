@@ -0,0 +1,32 @@
+package grpc
+
+import (
+	"context"
+
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// AddBookmark 收藏博客.
+func (h *Handler) AddBookmark(ctx context.Context, rq *apiv1.AddBookmarkRequest) (*apiv1.AddBookmarkResponse, error) {
+	return h.biz.BookmarkV1().Add(ctx, rq)
+}
+
+// RemoveBookmark 取消收藏博客.
+func (h *Handler) RemoveBookmark(ctx context.Context, rq *apiv1.RemoveBookmarkRequest) (*apiv1.RemoveBookmarkResponse, error) {
+	return h.biz.BookmarkV1().Remove(ctx, rq)
+}
+
+// ListBookmark 列出当前用户的书签.
+func (h *Handler) ListBookmark(ctx context.Context, rq *apiv1.ListBookmarkRequest) (*apiv1.ListBookmarkResponse, error) {
+	return h.biz.BookmarkV1().List(ctx, rq)
+}
+
+// ReorderBookmarks 调整阅读列表中书签的顺序.
+func (h *Handler) ReorderBookmarks(ctx context.Context, rq *apiv1.ReorderBookmarksRequest) (*apiv1.ReorderBookmarksResponse, error) {
+	return h.biz.BookmarkV1().Reorder(ctx, rq)
+}
+
+// ListReadingList 列出当前用户的阅读列表.
+func (h *Handler) ListReadingList(ctx context.Context, rq *apiv1.ListReadingListRequest) (*apiv1.ListReadingListResponse, error) {
+	return h.biz.BookmarkV1().ListReadingList(ctx, rq)
+}
@@ -0,0 +1,17 @@
+package grpc
+
+import (
+	"context"
+
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// StartOIDCLogin 发起单点登录.
+func (h *Handler) StartOIDCLogin(ctx context.Context, rq *apiv1.StartOIDCLoginRequest) (*apiv1.StartOIDCLoginResponse, error) {
+	return h.biz.UserV1().StartOIDCLogin(ctx, rq)
+}
+
+// CompleteOIDCLogin 完成单点登录.
+func (h *Handler) CompleteOIDCLogin(ctx context.Context, rq *apiv1.CompleteOIDCLoginRequest) (*apiv1.CompleteOIDCLoginResponse, error) {
+	return h.biz.UserV1().CompleteOIDCLogin(ctx, rq)
+}
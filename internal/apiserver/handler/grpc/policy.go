@@ -0,0 +1,52 @@
+package grpc
+
+import (
+	"context"
+
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// ListPolicies 列出授权策略.
+func (h *Handler) ListPolicies(ctx context.Context, rq *apiv1.ListPoliciesRequest) (*apiv1.ListPoliciesResponse, error) {
+	return h.biz.PolicyV1().ListPolicies(ctx, rq)
+}
+
+// CreatePolicy 添加授权策略.
+func (h *Handler) CreatePolicy(ctx context.Context, rq *apiv1.CreatePolicyRequest) (*apiv1.CreatePolicyResponse, error) {
+	return h.biz.PolicyV1().CreatePolicy(ctx, rq)
+}
+
+// DeletePolicy 删除授权策略.
+func (h *Handler) DeletePolicy(ctx context.Context, rq *apiv1.DeletePolicyRequest) (*apiv1.DeletePolicyResponse, error) {
+	return h.biz.PolicyV1().DeletePolicy(ctx, rq)
+}
+
+// ReplacePolicies 批量替换授权策略.
+func (h *Handler) ReplacePolicies(ctx context.Context, rq *apiv1.ReplacePoliciesRequest) (*apiv1.ReplacePoliciesResponse, error) {
+	return h.biz.PolicyV1().ReplacePolicies(ctx, rq)
+}
+
+// ListRoleAssignments 列出角色分配.
+func (h *Handler) ListRoleAssignments(ctx context.Context, rq *apiv1.ListRoleAssignmentsRequest) (*apiv1.ListRoleAssignmentsResponse, error) {
+	return h.biz.PolicyV1().ListRoleAssignments(ctx, rq)
+}
+
+// AssignRole 为用户分配角色.
+func (h *Handler) AssignRole(ctx context.Context, rq *apiv1.AssignRoleRequest) (*apiv1.AssignRoleResponse, error) {
+	return h.biz.PolicyV1().AssignRole(ctx, rq)
+}
+
+// UnassignRole 移除用户角色.
+func (h *Handler) UnassignRole(ctx context.Context, rq *apiv1.UnassignRoleRequest) (*apiv1.UnassignRoleResponse, error) {
+	return h.biz.PolicyV1().UnassignRole(ctx, rq)
+}
+
+// ListUserRoles 列出用户拥有的角色.
+func (h *Handler) ListUserRoles(ctx context.Context, rq *apiv1.ListUserRolesRequest) (*apiv1.ListUserRolesResponse, error) {
+	return h.biz.PolicyV1().ListUserRoles(ctx, rq)
+}
+
+// ListRoleUsers 列出拥有某个角色的用户.
+func (h *Handler) ListRoleUsers(ctx context.Context, rq *apiv1.ListRoleUsersRequest) (*apiv1.ListRoleUsersResponse, error) {
+	return h.biz.PolicyV1().ListRoleUsers(ctx, rq)
+}
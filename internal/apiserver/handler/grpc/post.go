@@ -3,6 +3,8 @@ package grpc
 import (
 	"context"
 
+	"google.golang.org/grpc/metadata"
+
 	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
 )
 
@@ -21,8 +23,19 @@ func (h *Handler) DeletePost(ctx context.Context, rq *apiv1.DeletePostRequest) (
 	return h.biz.PostV1().Delete(ctx, rq)
 }
 
-// GetPost 获取博客帖子.
+// GetPost 获取博客帖子. 未通过 locale 字段指定语言时，根据请求元数据中的 Accept-Language 选择语言.
+// 通过 grpc-gateway 转发的 HTTP 请求，Accept-Language 请求头会以 grpcgateway-accept-language 的形式出现在元数据中.
 func (h *Handler) GetPost(ctx context.Context, rq *apiv1.GetPostRequest) (*apiv1.GetPostResponse, error) {
+	if rq.GetLocale() == "" {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			for _, key := range []string{"accept-language", "grpcgateway-accept-language"} {
+				if values := md.Get(key); len(values) > 0 {
+					rq.Locale = values[0]
+					break
+				}
+			}
+		}
+	}
 	return h.biz.PostV1().Get(ctx, rq)
 }
 
@@ -30,3 +43,48 @@ func (h *Handler) GetPost(ctx context.Context, rq *apiv1.GetPostRequest) (*apiv1
 func (h *Handler) ListPost(ctx context.Context, rq *apiv1.ListPostRequest) (*apiv1.ListPostResponse, error) {
 	return h.biz.PostV1().List(ctx, rq)
 }
+
+// ListMentions 列出提及当前用户的博客帖子.
+func (h *Handler) ListMentions(ctx context.Context, rq *apiv1.ListMentionsRequest) (*apiv1.ListMentionsResponse, error) {
+	return h.biz.PostV1().ListMentions(ctx, rq)
+}
+
+// ListRelatedPosts 列出和指定博客相关的博客帖子.
+func (h *Handler) ListRelatedPosts(ctx context.Context, rq *apiv1.ListRelatedPostsRequest) (*apiv1.ListRelatedPostsResponse, error) {
+	return h.biz.PostV1().ListRelatedPosts(ctx, rq)
+}
+
+// PinPost 置顶博客帖子.
+func (h *Handler) PinPost(ctx context.Context, rq *apiv1.PinPostRequest) (*apiv1.PinPostResponse, error) {
+	return h.biz.PostV1().Pin(ctx, rq)
+}
+
+// UnpinPost 取消置顶博客帖子.
+func (h *Handler) UnpinPost(ctx context.Context, rq *apiv1.UnpinPostRequest) (*apiv1.UnpinPostResponse, error) {
+	return h.biz.PostV1().Unpin(ctx, rq)
+}
+
+// FeaturePost 将博客帖子设为精选.
+func (h *Handler) FeaturePost(ctx context.Context, rq *apiv1.FeaturePostRequest) (*apiv1.FeaturePostResponse, error) {
+	return h.biz.PostV1().Feature(ctx, rq)
+}
+
+// UnfeaturePost 取消博客帖子的精选.
+func (h *Handler) UnfeaturePost(ctx context.Context, rq *apiv1.UnfeaturePostRequest) (*apiv1.UnfeaturePostResponse, error) {
+	return h.biz.PostV1().Unfeature(ctx, rq)
+}
+
+// CreatePostTranslation 为博客帖子添加译文.
+func (h *Handler) CreatePostTranslation(ctx context.Context, rq *apiv1.CreatePostTranslationRequest) (*apiv1.CreatePostTranslationResponse, error) {
+	return h.biz.PostV1().CreateTranslation(ctx, rq)
+}
+
+// UpdatePostTranslation 更新博客帖子的译文.
+func (h *Handler) UpdatePostTranslation(ctx context.Context, rq *apiv1.UpdatePostTranslationRequest) (*apiv1.UpdatePostTranslationResponse, error) {
+	return h.biz.PostV1().UpdateTranslation(ctx, rq)
+}
+
+// DeletePostTranslation 删除博客帖子的译文.
+func (h *Handler) DeletePostTranslation(ctx context.Context, rq *apiv1.DeletePostTranslationRequest) (*apiv1.DeletePostTranslationResponse, error) {
+	return h.biz.PostV1().DeleteTranslation(ctx, rq)
+}
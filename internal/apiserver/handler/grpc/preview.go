@@ -0,0 +1,27 @@
+package grpc
+
+import (
+	"context"
+
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// CreatePreviewLink 为博客创建带签名的预览链接.
+func (h *Handler) CreatePreviewLink(ctx context.Context, rq *apiv1.CreatePreviewLinkRequest) (*apiv1.CreatePreviewLinkResponse, error) {
+	return h.biz.PreviewV1().Create(ctx, rq)
+}
+
+// ListPreviewLinks 列出博客未过期的预览链接.
+func (h *Handler) ListPreviewLinks(ctx context.Context, rq *apiv1.ListPreviewLinksRequest) (*apiv1.ListPreviewLinksResponse, error) {
+	return h.biz.PreviewV1().List(ctx, rq)
+}
+
+// RevokePreviewLink 撤销博客的预览链接.
+func (h *Handler) RevokePreviewLink(ctx context.Context, rq *apiv1.RevokePreviewLinkRequest) (*apiv1.RevokePreviewLinkResponse, error) {
+	return h.biz.PreviewV1().Revoke(ctx, rq)
+}
+
+// GetPreview 通过预览链接查看博客.
+func (h *Handler) GetPreview(ctx context.Context, rq *apiv1.GetPreviewRequest) (*apiv1.GetPreviewResponse, error) {
+	return h.biz.PreviewV1().Get(ctx, rq)
+}
@@ -0,0 +1,22 @@
+package grpc
+
+import (
+	"context"
+
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// AddReaction 对博客添加反应.
+func (h *Handler) AddReaction(ctx context.Context, rq *apiv1.AddReactionRequest) (*apiv1.AddReactionResponse, error) {
+	return h.biz.ReactionV1().Add(ctx, rq)
+}
+
+// RemoveReaction 移除对博客的反应.
+func (h *Handler) RemoveReaction(ctx context.Context, rq *apiv1.RemoveReactionRequest) (*apiv1.RemoveReactionResponse, error) {
+	return h.biz.ReactionV1().Remove(ctx, rq)
+}
+
+// ListReactors 列出对博客做出反应的用户.
+func (h *Handler) ListReactors(ctx context.Context, rq *apiv1.ListReactorsRequest) (*apiv1.ListReactorsResponse, error) {
+	return h.biz.ReactionV1().ListReactors(ctx, rq)
+}
@@ -0,0 +1,22 @@
+package grpc
+
+import (
+	"context"
+
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// ListSessions 列出当前用户的登录会话.
+func (h *Handler) ListSessions(ctx context.Context, rq *apiv1.ListSessionsRequest) (*apiv1.ListSessionsResponse, error) {
+	return h.biz.UserV1().ListSessions(ctx, rq)
+}
+
+// RevokeSession 撤销当前用户的登录会话.
+func (h *Handler) RevokeSession(ctx context.Context, rq *apiv1.RevokeSessionRequest) (*apiv1.RevokeSessionResponse, error) {
+	return h.biz.UserV1().RevokeSession(ctx, rq)
+}
+
+// RevokeAllOtherSessions 撤销当前用户除当前会话之外的所有登录会话.
+func (h *Handler) RevokeAllOtherSessions(ctx context.Context, rq *apiv1.RevokeAllOtherSessionsRequest) (*apiv1.RevokeAllOtherSessionsResponse, error) {
+	return h.biz.UserV1().RevokeAllOtherSessions(ctx, rq)
+}
@@ -0,0 +1,37 @@
+package grpc
+
+import (
+	"context"
+
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// CreatePostTemplate 创建博客模板.
+func (h *Handler) CreatePostTemplate(ctx context.Context, rq *apiv1.CreatePostTemplateRequest) (*apiv1.CreatePostTemplateResponse, error) {
+	return h.biz.TemplateV1().Create(ctx, rq)
+}
+
+// UpdatePostTemplate 更新博客模板.
+func (h *Handler) UpdatePostTemplate(ctx context.Context, rq *apiv1.UpdatePostTemplateRequest) (*apiv1.UpdatePostTemplateResponse, error) {
+	return h.biz.TemplateV1().Update(ctx, rq)
+}
+
+// DeletePostTemplate 删除博客模板.
+func (h *Handler) DeletePostTemplate(ctx context.Context, rq *apiv1.DeletePostTemplateRequest) (*apiv1.DeletePostTemplateResponse, error) {
+	return h.biz.TemplateV1().Delete(ctx, rq)
+}
+
+// GetPostTemplate 获取博客模板.
+func (h *Handler) GetPostTemplate(ctx context.Context, rq *apiv1.GetPostTemplateRequest) (*apiv1.GetPostTemplateResponse, error) {
+	return h.biz.TemplateV1().Get(ctx, rq)
+}
+
+// ListPostTemplate 列出当前用户的博客模板.
+func (h *Handler) ListPostTemplate(ctx context.Context, rq *apiv1.ListPostTemplateRequest) (*apiv1.ListPostTemplateResponse, error) {
+	return h.biz.TemplateV1().List(ctx, rq)
+}
+
+// CreatePostFromTemplate 从博客模板创建博客.
+func (h *Handler) CreatePostFromTemplate(ctx context.Context, rq *apiv1.CreatePostFromTemplateRequest) (*apiv1.CreatePostFromTemplateResponse, error) {
+	return h.biz.TemplateV1().CreatePost(ctx, rq)
+}
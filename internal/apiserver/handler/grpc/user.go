@@ -16,6 +16,11 @@ func (h *Handler) RefreshToken(ctx context.Context, rq *apiv1.RefreshTokenReques
 	return h.biz.UserV1().RefreshToken(ctx, rq)
 }
 
+// Logout 退出登录.
+func (h *Handler) Logout(ctx context.Context, rq *apiv1.LogoutRequest) (*apiv1.LogoutResponse, error) {
+	return h.biz.UserV1().Logout(ctx, rq)
+}
+
 // ChangePassword 修改用户密码.
 func (h *Handler) ChangePassword(ctx context.Context, rq *apiv1.ChangePasswordRequest) (*apiv1.ChangePasswordResponse, error) {
 	return h.biz.UserV1().ChangePassword(ctx, rq)
@@ -45,3 +50,48 @@ func (h *Handler) GetUser(ctx context.Context, rq *apiv1.GetUserRequest) (*apiv1
 func (h *Handler) ListUser(ctx context.Context, rq *apiv1.ListUserRequest) (*apiv1.ListUserResponse, error) {
 	return h.biz.UserV1().List(ctx, rq)
 }
+
+// SendVerificationEmail 重新发送邮箱验证邮件.
+func (h *Handler) SendVerificationEmail(ctx context.Context, rq *apiv1.SendVerificationEmailRequest) (*apiv1.SendVerificationEmailResponse, error) {
+	return h.biz.UserV1().SendVerificationEmail(ctx, rq)
+}
+
+// VerifyEmail 验证用户邮箱.
+func (h *Handler) VerifyEmail(ctx context.Context, rq *apiv1.VerifyEmailRequest) (*apiv1.VerifyEmailResponse, error) {
+	return h.biz.UserV1().VerifyEmail(ctx, rq)
+}
+
+// RequestPasswordReset 申请重置密码.
+func (h *Handler) RequestPasswordReset(ctx context.Context, rq *apiv1.RequestPasswordResetRequest) (*apiv1.RequestPasswordResetResponse, error) {
+	return h.biz.UserV1().RequestPasswordReset(ctx, rq)
+}
+
+// ResetPassword 使用重置密码令牌设置新密码.
+func (h *Handler) ResetPassword(ctx context.Context, rq *apiv1.ResetPasswordRequest) (*apiv1.ResetPasswordResponse, error) {
+	return h.biz.UserV1().ResetPassword(ctx, rq)
+}
+
+// UnlockUser 解除用户登录锁定.
+func (h *Handler) UnlockUser(ctx context.Context, rq *apiv1.UnlockUserRequest) (*apiv1.UnlockUserResponse, error) {
+	return h.biz.UserV1().UnlockUser(ctx, rq)
+}
+
+// EnrollTwoFactor 绑定两步验证.
+func (h *Handler) EnrollTwoFactor(ctx context.Context, rq *apiv1.EnrollTwoFactorRequest) (*apiv1.EnrollTwoFactorResponse, error) {
+	return h.biz.UserV1().EnrollTwoFactor(ctx, rq)
+}
+
+// ConfirmTwoFactor 启用两步验证.
+func (h *Handler) ConfirmTwoFactor(ctx context.Context, rq *apiv1.ConfirmTwoFactorRequest) (*apiv1.ConfirmTwoFactorResponse, error) {
+	return h.biz.UserV1().ConfirmTwoFactor(ctx, rq)
+}
+
+// VerifyTwoFactor 两步验证登录.
+func (h *Handler) VerifyTwoFactor(ctx context.Context, rq *apiv1.VerifyTwoFactorRequest) (*apiv1.VerifyTwoFactorResponse, error) {
+	return h.biz.UserV1().VerifyTwoFactor(ctx, rq)
+}
+
+// ResetTwoFactor 重置用户两步验证.
+func (h *Handler) ResetTwoFactor(ctx context.Context, rq *apiv1.ResetTwoFactorRequest) (*apiv1.ResetTwoFactorResponse, error) {
+	return h.biz.UserV1().ResetTwoFactor(ctx, rq)
+}
@@ -0,0 +1,22 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/ra1n6ow/gpkg/core"
+)
+
+// CreateAccessToken 为当前用户创建个人访问令牌.
+func (h *Handler) CreateAccessToken(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.UserV1().CreateAccessToken, h.val.ValidateCreateAccessTokenRequest)
+}
+
+// ListAccessTokens 列出当前用户的个人访问令牌.
+func (h *Handler) ListAccessTokens(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.UserV1().ListAccessTokens, h.val.ValidateListAccessTokensRequest)
+}
+
+// RevokeAccessToken 撤销当前用户的个人访问令牌.
+func (h *Handler) RevokeAccessToken(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.UserV1().RevokeAccessToken, h.val.ValidateRevokeAccessTokenRequest)
+}
@@ -0,0 +1,27 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/ra1n6ow/gpkg/core"
+)
+
+// ListPostActivity 按天或按周列出用户创建博客的数量.
+func (h *Handler) ListPostActivity(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.AnalyticsV1().ListPostActivity, h.val.ValidateListPostActivityRequest)
+}
+
+// ListTopAuthors 列出创建博客最多的用户.
+func (h *Handler) ListTopAuthors(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.AnalyticsV1().ListTopAuthors, h.val.ValidateListTopAuthorsRequest)
+}
+
+// ListSiteStats 按天或按周列出全站用户和博客数量.
+func (h *Handler) ListSiteStats(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.AnalyticsV1().ListSiteStats, h.val.ValidateListSiteStatsRequest)
+}
+
+// GetMyPostStats 获取当前用户的博客统计.
+func (h *Handler) GetMyPostStats(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.AnalyticsV1().GetMyPostStats, h.val.ValidateGetMyPostStatsRequest)
+}
@@ -0,0 +1,32 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/ra1n6ow/gpkg/core"
+)
+
+// AddBookmark 收藏博客.
+func (h *Handler) AddBookmark(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.BookmarkV1().Add, h.val.ValidateAddBookmarkRequest)
+}
+
+// RemoveBookmark 取消收藏博客.
+func (h *Handler) RemoveBookmark(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.BookmarkV1().Remove, h.val.ValidateRemoveBookmarkRequest)
+}
+
+// ListBookmark 列出当前用户的书签.
+func (h *Handler) ListBookmark(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.BookmarkV1().List, h.val.ValidateListBookmarkRequest)
+}
+
+// ReorderBookmarks 调整阅读列表中书签的顺序.
+func (h *Handler) ReorderBookmarks(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.BookmarkV1().Reorder, h.val.ValidateReorderBookmarksRequest)
+}
+
+// ListReadingList 列出当前用户的阅读列表.
+func (h *Handler) ListReadingList(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.BookmarkV1().ListReadingList, h.val.ValidateListReadingListRequest)
+}
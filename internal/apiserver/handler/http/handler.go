@@ -6,6 +6,8 @@
 package http
 
 import (
+	"github.com/gin-gonic/gin"
+
 	"github.com/ra1n6ow/miniblog/internal/apiserver/biz"
 	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/validation"
 )
@@ -23,3 +25,26 @@ func NewHandler(biz biz.IBiz, val *validation.Validator) *Handler {
 		val: val,
 	}
 }
+
+// bindUriAndJSON 返回同时绑定路径参数和 JSON 请求体的绑定函数，请求体为空时只绑定路径参数.
+func bindUriAndJSON(c *gin.Context) func(any) error {
+	return func(rq any) error {
+		if err := c.ShouldBindUri(rq); err != nil {
+			return err
+		}
+		if c.Request.ContentLength == 0 {
+			return nil
+		}
+		return c.ShouldBindJSON(rq)
+	}
+}
+
+// bindUriAndQuery 返回同时绑定路径参数和查询参数的绑定函数，用于路径中带有资源 ID 的列表接口.
+func bindUriAndQuery(c *gin.Context) func(any) error {
+	return func(rq any) error {
+		if err := c.ShouldBindUri(rq); err != nil {
+			return err
+		}
+		return c.ShouldBindQuery(rq)
+	}
+}
@@ -0,0 +1,17 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/ra1n6ow/gpkg/core"
+)
+
+// StartOIDCLogin 发起单点登录.
+func (h *Handler) StartOIDCLogin(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.UserV1().StartOIDCLogin, h.val.ValidateStartOIDCLoginRequest)
+}
+
+// CompleteOIDCLogin 完成单点登录.
+func (h *Handler) CompleteOIDCLogin(c *gin.Context) {
+	core.HandleRequest(c, bindUriAndQuery(c), h.biz.UserV1().CompleteOIDCLogin, h.val.ValidateCompleteOIDCLoginRequest)
+}
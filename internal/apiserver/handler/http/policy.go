@@ -0,0 +1,52 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/ra1n6ow/gpkg/core"
+)
+
+// ListPolicies 列出授权策略.
+func (h *Handler) ListPolicies(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.PolicyV1().ListPolicies, h.val.ValidateListPoliciesRequest)
+}
+
+// CreatePolicy 添加授权策略.
+func (h *Handler) CreatePolicy(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.PolicyV1().CreatePolicy, h.val.ValidateCreatePolicyRequest)
+}
+
+// DeletePolicy 删除授权策略.
+func (h *Handler) DeletePolicy(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.PolicyV1().DeletePolicy, h.val.ValidateDeletePolicyRequest)
+}
+
+// ReplacePolicies 批量替换授权策略.
+func (h *Handler) ReplacePolicies(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.PolicyV1().ReplacePolicies, h.val.ValidateReplacePoliciesRequest)
+}
+
+// ListRoleAssignments 列出角色分配.
+func (h *Handler) ListRoleAssignments(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.PolicyV1().ListRoleAssignments, h.val.ValidateListRoleAssignmentsRequest)
+}
+
+// AssignRole 为用户分配角色.
+func (h *Handler) AssignRole(c *gin.Context) {
+	core.HandleRequest(c, bindUriAndJSON(c), h.biz.PolicyV1().AssignRole, h.val.ValidateAssignRoleRequest)
+}
+
+// UnassignRole 移除用户角色.
+func (h *Handler) UnassignRole(c *gin.Context) {
+	core.HandleRequest(c, bindUriAndJSON(c), h.biz.PolicyV1().UnassignRole, h.val.ValidateUnassignRoleRequest)
+}
+
+// ListUserRoles 列出用户拥有的角色.
+func (h *Handler) ListUserRoles(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.PolicyV1().ListUserRoles, h.val.ValidateListUserRolesRequest)
+}
+
+// ListRoleUsers 列出拥有某个角色的用户.
+func (h *Handler) ListRoleUsers(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.PolicyV1().ListRoleUsers, h.val.ValidateListRoleUsersRequest)
+}
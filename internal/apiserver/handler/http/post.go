@@ -4,6 +4,8 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/ra1n6ow/gpkg/core"
+
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
 )
 
 // CreatePost 创建博客帖子.
@@ -21,12 +23,66 @@ func (h *Handler) DeletePost(c *gin.Context) {
 	core.HandleJSONRequest(c, h.biz.PostV1().Delete, h.val.ValidateDeletePostRequest)
 }
 
-// GetPost 获取博客帖子.
+// GetPost 获取博客帖子. 未通过 locale 查询参数指定语言时，根据 Accept-Language 请求头选择语言.
 func (h *Handler) GetPost(c *gin.Context) {
-	core.HandleUriRequest(c, h.biz.PostV1().Get, h.val.ValidateGetPostRequest)
+	bind := bindUriAndQuery(c)
+	core.HandleRequest(c, func(rq any) error {
+		if err := bind(rq); err != nil {
+			return err
+		}
+		if r := rq.(*apiv1.GetPostRequest); r.Locale == "" {
+			r.Locale = c.GetHeader("Accept-Language")
+		}
+		return nil
+	}, h.biz.PostV1().Get, h.val.ValidateGetPostRequest)
 }
 
 // ListPosts 列出用户的所有博客帖子.
 func (h *Handler) ListPost(c *gin.Context) {
 	core.HandleQueryRequest(c, h.biz.PostV1().List, h.val.ValidateListPostRequest)
 }
+
+// ListMentions 列出提及当前用户的博客帖子.
+func (h *Handler) ListMentions(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.PostV1().ListMentions, h.val.ValidateListMentionsRequest)
+}
+
+// ListRelatedPosts 列出和指定博客相关的博客帖子.
+func (h *Handler) ListRelatedPosts(c *gin.Context) {
+	core.HandleRequest(c, bindUriAndQuery(c), h.biz.PostV1().ListRelatedPosts, h.val.ValidateListRelatedPostsRequest)
+}
+
+// PinPost 置顶博客帖子.
+func (h *Handler) PinPost(c *gin.Context) {
+	core.HandleRequest(c, bindUriAndJSON(c), h.biz.PostV1().Pin, h.val.ValidatePinPostRequest)
+}
+
+// UnpinPost 取消置顶博客帖子.
+func (h *Handler) UnpinPost(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.PostV1().Unpin, h.val.ValidateUnpinPostRequest)
+}
+
+// FeaturePost 将博客帖子设为精选.
+func (h *Handler) FeaturePost(c *gin.Context) {
+	core.HandleRequest(c, bindUriAndJSON(c), h.biz.PostV1().Feature, h.val.ValidateFeaturePostRequest)
+}
+
+// UnfeaturePost 取消博客帖子的精选.
+func (h *Handler) UnfeaturePost(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.PostV1().Unfeature, h.val.ValidateUnfeaturePostRequest)
+}
+
+// CreatePostTranslation 为博客帖子添加译文.
+func (h *Handler) CreatePostTranslation(c *gin.Context) {
+	core.HandleRequest(c, bindUriAndJSON(c), h.biz.PostV1().CreateTranslation, h.val.ValidateCreatePostTranslationRequest)
+}
+
+// UpdatePostTranslation 更新博客帖子的译文.
+func (h *Handler) UpdatePostTranslation(c *gin.Context) {
+	core.HandleRequest(c, bindUriAndJSON(c), h.biz.PostV1().UpdateTranslation, h.val.ValidateUpdatePostTranslationRequest)
+}
+
+// DeletePostTranslation 删除博客帖子的译文.
+func (h *Handler) DeletePostTranslation(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.PostV1().DeleteTranslation, h.val.ValidateDeletePostTranslationRequest)
+}
@@ -0,0 +1,27 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/ra1n6ow/gpkg/core"
+)
+
+// CreatePreviewLink 为博客创建带签名的预览链接.
+func (h *Handler) CreatePreviewLink(c *gin.Context) {
+	core.HandleRequest(c, bindUriAndJSON(c), h.biz.PreviewV1().Create, h.val.ValidateCreatePreviewLinkRequest)
+}
+
+// ListPreviewLinks 列出博客未过期的预览链接.
+func (h *Handler) ListPreviewLinks(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.PreviewV1().List, h.val.ValidateListPreviewLinksRequest)
+}
+
+// RevokePreviewLink 撤销博客的预览链接.
+func (h *Handler) RevokePreviewLink(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.PreviewV1().Revoke, h.val.ValidateRevokePreviewLinkRequest)
+}
+
+// GetPreview 通过预览链接查看博客.
+func (h *Handler) GetPreview(c *gin.Context) {
+	core.HandleRequest(c, bindUriAndQuery(c), h.biz.PreviewV1().Get, h.val.ValidateGetPreviewRequest)
+}
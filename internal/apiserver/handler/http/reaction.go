@@ -0,0 +1,22 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/ra1n6ow/gpkg/core"
+)
+
+// AddReaction 对博客添加反应.
+func (h *Handler) AddReaction(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.ReactionV1().Add, h.val.ValidateAddReactionRequest)
+}
+
+// RemoveReaction 移除对博客的反应.
+func (h *Handler) RemoveReaction(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.ReactionV1().Remove, h.val.ValidateRemoveReactionRequest)
+}
+
+// ListReactors 列出对博客做出反应的用户.
+func (h *Handler) ListReactors(c *gin.Context) {
+	core.HandleRequest(c, bindUriAndQuery(c), h.biz.ReactionV1().ListReactors, h.val.ValidateListReactorsRequest)
+}
@@ -0,0 +1,22 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/ra1n6ow/gpkg/core"
+)
+
+// ListSessions 列出当前用户的登录会话.
+func (h *Handler) ListSessions(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.UserV1().ListSessions, h.val.ValidateListSessionsRequest)
+}
+
+// RevokeSession 撤销当前用户的登录会话.
+func (h *Handler) RevokeSession(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.UserV1().RevokeSession, h.val.ValidateRevokeSessionRequest)
+}
+
+// RevokeAllOtherSessions 撤销当前用户除当前会话之外的所有登录会话.
+func (h *Handler) RevokeAllOtherSessions(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.UserV1().RevokeAllOtherSessions)
+}
@@ -0,0 +1,37 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/ra1n6ow/gpkg/core"
+)
+
+// CreatePostTemplate 创建博客模板.
+func (h *Handler) CreatePostTemplate(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.TemplateV1().Create, h.val.ValidateCreatePostTemplateRequest)
+}
+
+// UpdatePostTemplate 更新博客模板.
+func (h *Handler) UpdatePostTemplate(c *gin.Context) {
+	core.HandleRequest(c, bindUriAndJSON(c), h.biz.TemplateV1().Update, h.val.ValidateUpdatePostTemplateRequest)
+}
+
+// DeletePostTemplate 删除博客模板.
+func (h *Handler) DeletePostTemplate(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.TemplateV1().Delete, h.val.ValidateDeletePostTemplateRequest)
+}
+
+// GetPostTemplate 获取博客模板.
+func (h *Handler) GetPostTemplate(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.TemplateV1().Get, h.val.ValidateGetPostTemplateRequest)
+}
+
+// ListPostTemplate 列出当前用户的博客模板.
+func (h *Handler) ListPostTemplate(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.TemplateV1().List, h.val.ValidateListPostTemplateRequest)
+}
+
+// CreatePostFromTemplate 从博客模板创建博客.
+func (h *Handler) CreatePostFromTemplate(c *gin.Context) {
+	core.HandleRequest(c, bindUriAndJSON(c), h.biz.TemplateV1().CreatePost, h.val.ValidateCreatePostFromTemplateRequest)
+}
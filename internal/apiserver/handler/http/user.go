@@ -12,7 +12,12 @@ func (h *Handler) Login(c *gin.Context) {
 
 // RefreshToken 刷新 JWT Token.
 func (h *Handler) RefreshToken(c *gin.Context) {
-	core.HandleJSONRequest(c, h.biz.UserV1().RefreshToken)
+	core.HandleJSONRequest(c, h.biz.UserV1().RefreshToken, h.val.ValidateRefreshTokenRequest)
+}
+
+// Logout 退出登录，撤销当前使用的 JWT Token.
+func (h *Handler) Logout(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.UserV1().Logout)
 }
 
 // ChangeUserPassword 修改用户密码.
@@ -44,3 +49,48 @@ func (h *Handler) GetUser(c *gin.Context) {
 func (h *Handler) ListUser(c *gin.Context) {
 	core.HandleQueryRequest(c, h.biz.UserV1().List, h.val.ValidateListUserRequest)
 }
+
+// SendVerificationEmail 重新发送邮箱验证邮件.
+func (h *Handler) SendVerificationEmail(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.UserV1().SendVerificationEmail, h.val.ValidateSendVerificationEmailRequest)
+}
+
+// VerifyEmail 验证用户邮箱.
+func (h *Handler) VerifyEmail(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.UserV1().VerifyEmail, h.val.ValidateVerifyEmailRequest)
+}
+
+// RequestPasswordReset 申请重置密码.
+func (h *Handler) RequestPasswordReset(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.UserV1().RequestPasswordReset, h.val.ValidateRequestPasswordResetRequest)
+}
+
+// ResetPassword 使用重置密码令牌设置新密码.
+func (h *Handler) ResetPassword(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.UserV1().ResetPassword, h.val.ValidateResetPasswordRequest)
+}
+
+// UnlockUser 解除用户登录锁定.
+func (h *Handler) UnlockUser(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.UserV1().UnlockUser, h.val.ValidateUnlockUserRequest)
+}
+
+// EnrollTwoFactor 绑定两步验证.
+func (h *Handler) EnrollTwoFactor(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.UserV1().EnrollTwoFactor, h.val.ValidateEnrollTwoFactorRequest)
+}
+
+// ConfirmTwoFactor 启用两步验证.
+func (h *Handler) ConfirmTwoFactor(c *gin.Context) {
+	core.HandleRequest(c, bindUriAndJSON(c), h.biz.UserV1().ConfirmTwoFactor, h.val.ValidateConfirmTwoFactorRequest)
+}
+
+// VerifyTwoFactor 两步验证登录.
+func (h *Handler) VerifyTwoFactor(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.UserV1().VerifyTwoFactor, h.val.ValidateVerifyTwoFactorRequest)
+}
+
+// ResetTwoFactor 重置用户两步验证.
+func (h *Handler) ResetTwoFactor(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.UserV1().ResetTwoFactor, h.val.ValidateResetTwoFactorRequest)
+}
@@ -0,0 +1,168 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+// Package web 实现服务端渲染的 HTML 前端，包括首页、博客详情页、作者页以及登录和写博客表单.
+// 页面处理器直接调用业务层，因此在 Gin 和 gRPC-Gateway 两种服务模式下行为一致.
+package web
+
+import (
+	"bytes"
+	"embed"
+	"html/template"
+	"io/fs"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ra1n6ow/gpkg/errorsx"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/biz"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/validation"
+	"github.com/ra1n6ow/miniblog/internal/pkg/clientip"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+	"github.com/ra1n6ow/miniblog/internal/pkg/lru"
+	mw "github.com/ra1n6ow/miniblog/internal/pkg/middleware/gin"
+	"github.com/ra1n6ow/miniblog/internal/pkg/theme"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// defaultTheme 是内置的默认主题.
+//
+//go:embed templates
+var defaultTheme embed.FS
+
+// pageFiles 是主题中包含的页面模板，每个页面都会和 layout.html 一起解析.
+var pageFiles = []string{"home", "post", "author", "signin", "compose", "error"}
+
+// Handler 处理 HTML 前端的请求.
+type Handler struct {
+	biz       biz.IBiz
+	val       *validation.Validator
+	retriever mw.UserRetriever
+	checker   mw.TokenChecker
+	authz     mw.Authorizer
+	// clientIP 解析请求的客户端 IP.
+	clientIP *clientip.Resolver
+	// refreshes 合并使用同一个刷新令牌的并发刷新请求，key 为刷新令牌的摘要.
+	refreshes singleflight.Group
+	// rotated 缓存最近的刷新结果，key 为刷新令牌的摘要.
+	rotated *lru.Cache[string, *apiv1.RefreshTokenResponse]
+	// pages 保存解析后的页面模板，键为页面名称.
+	pages map[string]*template.Template
+	// static 为主题中的静态资源.
+	static http.FileSystem
+}
+
+// NewHandler 创建新的 Handler 实例. themeDir 为自定义主题目录，其中的文件会覆盖内置主题中的同名文件.
+func NewHandler(biz biz.IBiz, val *validation.Validator, retriever mw.UserRetriever, checker mw.TokenChecker, authz mw.Authorizer, clientIP *clientip.Resolver, themeDir string) (*Handler, error) {
+	embedded, _ := fs.Sub(defaultTheme, "templates")
+	th, err := theme.New(embedded, themeDir)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &Handler{
+		biz:       biz,
+		val:       val,
+		retriever: retriever,
+		checker:   checker,
+		authz:     authz,
+		clientIP:  clientIP,
+		rotated:   lru.New[string, *apiv1.RefreshTokenResponse](rotatedCacheSize),
+		pages:     make(map[string]*template.Template, len(pageFiles)),
+	}
+
+	// 启动时解析所有页面模板，尽早暴露自定义主题中的错误
+	for _, name := range pageFiles {
+		tmpl, err := th.Parse(h.funcs(), "layout.html", name+".html")
+		if err != nil {
+			return nil, err
+		}
+		h.pages[name] = tmpl
+	}
+
+	static, err := fs.Sub(th.FS(), "static")
+	if err != nil {
+		return nil, err
+	}
+	h.static = http.FS(static)
+
+	return h, nil
+}
+
+// Install 注册 HTML 前端的路由.
+func (h *Handler) Install(r gin.IRouter) {
+	r.GET("/static/*filepath", h.Static)
+
+	pages := r.Group("", h.identify)
+	{
+		pages.GET("/", h.Home)
+		pages.GET("/posts/:postID", h.Post)
+		pages.GET("/authors/:userID", h.Author)
+		pages.GET("/signin", h.SigninForm)
+		pages.POST("/signin", h.csrf, h.Signin)
+		pages.POST("/signin/two-factor", h.csrf, h.SigninTwoFactor)
+		pages.POST("/signout", h.csrf, h.Signout)
+		pages.GET("/compose", h.requireUser, h.ComposeForm)
+		pages.POST("/compose", h.requireUser, h.csrf, h.Compose)
+	}
+}
+
+// Wrap 返回一个 http.Handler，优先处理 HTML 前端的路由，其余请求交给 next 处理.
+// 用于在 gRPC-Gateway 模式下将 HTML 前端和 gRPC-Gateway 挂载在同一个 HTTP 服务器上.
+func (h *Handler) Wrap(next http.Handler) http.Handler {
+	engine := gin.New()
+	// 客户端 IP 由 ClientIPMiddleware 解析，这里同步设置可信代理，避免 Gin 信任所有代理
+	_ = engine.SetTrustedProxies(h.clientIP.Proxies())
+	engine.Use(gin.Recovery())
+	// 请求 ID 和客户端 IP 中间件只作用于 HTML 前端的路由，gRPC-Gateway 的请求由 gRPC 拦截器设置
+	h.Install(engine.Group("", mw.RequestIDMiddleware(), mw.ClientIPMiddleware(h.clientIP)))
+	engine.NoRoute(func(c *gin.Context) {
+		// Gin 在 NoRoute 中会预置 404 状态码，这里重置为 200，由 next 决定最终的状态码
+		c.Status(http.StatusOK)
+		next.ServeHTTP(c.Writer, c.Request)
+	})
+	return engine
+}
+
+// NotFound 为浏览器请求渲染 HTML 格式的 404 页面，其余请求交给后续的处理函数.
+func (h *Handler) NotFound(c *gin.Context) {
+	if !strings.Contains(c.GetHeader("Accept"), "text/html") {
+		c.Next()
+		return
+	}
+
+	h.identify(c)
+	h.renderError(c, errno.ErrPageNotFound)
+	c.Abort()
+}
+
+// Static 返回主题中的静态资源.
+func (h *Handler) Static(c *gin.Context) {
+	c.FileFromFS(c.Param("filepath"), h.static)
+}
+
+// render 使用名为 name 的页面模板渲染页面.
+func (h *Handler) render(c *gin.Context, status int, name string, data gin.H) {
+	data["User"] = currentUser(c)
+	data["CSRF"] = h.csrfToken(c)
+
+	var buf bytes.Buffer
+	if err := h.pages[name].ExecuteTemplate(&buf, "layout", data); err != nil {
+		log.W(c.Request.Context()).Errorw("Failed to render page", "page", name, "err", err)
+		c.String(http.StatusInternalServerError, "Internal server error.")
+		return
+	}
+
+	c.Data(status, "text/html; charset=utf-8", buf.Bytes())
+}
+
+// renderError 根据错误渲染错误页面.
+func (h *Handler) renderError(c *gin.Context, err error) {
+	errx := errorsx.FromError(err)
+	h.render(c, errx.Code, "error", gin.H{"Status": errx.Code, "Message": errx.Message})
+}
@@ -0,0 +1,177 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+	"github.com/ra1n6ow/miniblog/internal/pkg/theme"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+const (
+	// pageSize 定义列表页每页展示的博客数量.
+	pageSize = 10
+	// relatedSize 定义博客详情页展示的相关博客数量.
+	relatedSize = 5
+)
+
+// author 表示博客的作者.
+type author struct {
+	UserID string
+	Name   string
+}
+
+// post 表示页面中展示的博客及其作者.
+type post struct {
+	*apiv1.Post
+	Author author
+}
+
+// pagination 表示列表页的分页信息.
+type pagination struct {
+	Page       int
+	TotalPages int
+	PrevURL    string
+	NextURL    string
+}
+
+// Home 渲染首页，展示所有用户的博客.
+func (h *Handler) Home(c *gin.Context) {
+	h.renderList(c, "home", "", "/", gin.H{})
+}
+
+// Author 渲染作者页，展示指定用户的博客.
+func (h *Handler) Author(c *gin.Context) {
+	userID := c.Param("userID")
+	user, err := h.retriever.GetUser(c.Request.Context(), userID)
+	if err != nil {
+		h.renderError(c, err)
+		return
+	}
+
+	h.renderList(c, "author", userID, authorURL(userID), gin.H{"Author": author{UserID: user.UserID, Name: displayName(user.Nickname, user.Username)}})
+}
+
+// Post 渲染博客详情页.
+func (h *Handler) Post(c *gin.Context) {
+	ctx := c.Request.Context()
+	p, err := h.biz.PostV1().GetPublic(ctx, c.Param("postID"))
+	if err != nil {
+		h.renderError(c, err)
+		return
+	}
+
+	// 相关博客只是辅助信息，查询失败时不影响博客详情的展示
+	var related []*apiv1.Post
+	if resp, err := h.biz.PostV1().ListRelatedPosts(ctx, &apiv1.ListRelatedPostsRequest{PostID: p.PostID, Limit: relatedSize}); err != nil {
+		log.W(ctx).Errorw("Failed to list related posts", "postID", p.PostID, "err", err)
+	} else {
+		related = resp.GetPosts()
+	}
+
+	h.render(c, http.StatusOK, "post", gin.H{"Post": post{Post: p, Author: h.author(ctx, p.UserID, nil)}, "Related": related})
+}
+
+// renderList 渲染博客列表页. userID 不为空时只展示该用户的博客，baseURL 用于生成分页链接.
+func (h *Handler) renderList(c *gin.Context, name string, userID string, baseURL string, data gin.H) {
+	page, _ := strconv.Atoi(c.Query("page"))
+	if page < 1 {
+		page = 1
+	}
+
+	ctx := c.Request.Context()
+	count, posts, err := h.biz.PostV1().ListPublic(ctx, userID, int64((page-1)*pageSize), pageSize)
+	if err != nil {
+		h.renderError(c, err)
+		return
+	}
+
+	totalPages := int((count + pageSize - 1) / pageSize)
+	if page > 1 && page > totalPages {
+		h.renderError(c, errno.ErrPageNotFound)
+		return
+	}
+
+	// 同一页面中的作者信息只查询一次
+	authors := make(map[string]author)
+	views := make([]post, 0, len(posts))
+	for _, p := range posts {
+		views = append(views, post{Post: p, Author: h.author(ctx, p.UserID, authors)})
+	}
+
+	pager := pagination{Page: page, TotalPages: totalPages}
+	if page > 1 {
+		pager.PrevURL = pageURL(baseURL, page-1)
+	}
+	if page < totalPages {
+		pager.NextURL = pageURL(baseURL, page+1)
+	}
+
+	data["Posts"] = views
+	data["Pagination"] = pager
+	h.render(c, http.StatusOK, name, data)
+}
+
+// author 查询博客作者的展示信息. 作者不存在时使用用户 ID 作为名称.
+func (h *Handler) author(ctx context.Context, userID string, cache map[string]author) author {
+	if a, ok := cache[userID]; ok {
+		return a
+	}
+
+	a := author{UserID: userID, Name: userID}
+	if user, err := h.retriever.GetUser(ctx, userID); err == nil {
+		a.Name = displayName(user.Nickname, user.Username)
+	}
+	if cache != nil {
+		cache[userID] = a
+	}
+	return a
+}
+
+// funcs 返回模板中可以使用的函数.
+func (h *Handler) funcs() template.FuncMap {
+	return template.FuncMap{
+		"postURL":    postURL,
+		"authorURL":  authorURL,
+		"paragraphs": theme.Paragraphs,
+		"excerpt":    theme.Excerpt,
+		"date": func(t *timestamppb.Timestamp) string {
+			return t.AsTime().Local().Format(time.DateOnly)
+		},
+	}
+}
+
+// displayName 返回用户的展示名称，优先使用昵称.
+func displayName(nickname string, username string) string {
+	if nickname != "" {
+		return nickname
+	}
+	return username
+}
+
+// postURL 返回博客详情页的地址.
+func postURL(postID string) string {
+	return "/posts/" + postID
+}
+
+// authorURL 返回作者页的地址.
+func authorURL(userID string) string {
+	return "/authors/" + userID
+}
+
+// pageURL 返回列表页第 page 页的地址.
+func pageURL(baseURL string, page int) string {
+	if page <= 1 {
+		return baseURL
+	}
+	return fmt.Sprintf("%s?page=%d", baseURL, page)
+}
@@ -0,0 +1,310 @@
+package web
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ra1n6ow/gpkg/errorsx"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/pkg/contextx"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+	"github.com/ra1n6ow/miniblog/pkg/token"
+)
+
+const (
+	// tokenCookie 是保存登录令牌的 Cookie 名称.
+	tokenCookie = "mb_token"
+	// refreshCookie 是保存刷新令牌的 Cookie 名称.
+	refreshCookie = "mb_refresh"
+	// csrfCookie 是保存 CSRF 令牌的 Cookie 名称，表单中的 csrf 字段需要和它一致.
+	csrfCookie = "mb_csrf"
+	// userKey 是当前登录用户在 gin.Context 中的键.
+	userKey = "web.user"
+	// csrfKey 是当前请求的 CSRF 令牌在 gin.Context 中的键.
+	csrfKey = "web.csrf"
+	// refreshGrace 为刷新结果的复用时间. 浏览器收到新的 Cookie 之前发出的请求仍然携带旧的刷新令牌，
+	// 在该时间内直接复用刷新结果，避免重复使用刷新令牌被判定为泄露.
+	refreshGrace = 10 * time.Second
+	// rotatedCacheSize 为缓存的刷新结果数量.
+	rotatedCacheSize = 1000
+)
+
+// identify 从 Cookie 中解析登录令牌，令牌有效时将当前用户保存到上下文中. 未登录的请求不会被拦截.
+// 登录令牌过期时使用刷新令牌换取新的令牌.
+func (h *Handler) identify(c *gin.Context) {
+	tokenStr, _ := c.Cookie(tokenCookie)
+	claims, err := token.ParseString(tokenStr)
+	if err != nil {
+		if claims, err = h.refresh(c); err != nil {
+			return
+		}
+	}
+
+	user, err := h.retriever.GetUser(c.Request.Context(), claims.Identity)
+	if err != nil {
+		return
+	}
+	if err := h.checker.CheckToken(c.Request.Context(), claims, user); err != nil {
+		return
+	}
+
+	ctx := contextx.WithUserID(c.Request.Context(), user.UserID)
+	ctx = contextx.WithUsername(ctx, user.Username)
+	ctx = contextx.WithTokenID(ctx, claims.ID)
+	ctx = contextx.WithTokenExpiresAt(ctx, claims.ExpiresAt)
+	ctx = contextx.WithSessionID(ctx, claims.SessionID)
+	c.Request = c.Request.WithContext(ctx)
+	c.Set(userKey, user)
+}
+
+// refresh 使用 Cookie 中的刷新令牌换取新的令牌并写入 Cookie，返回新令牌中的声明.
+func (h *Handler) refresh(c *gin.Context) (*token.Claims, error) {
+	refreshToken, err := c.Cookie(refreshCookie)
+	if err != nil || refreshToken == "" {
+		return nil, errno.ErrUnauthenticated
+	}
+
+	resp, err := h.rotate(c.Request.Context(), refreshToken)
+	if err != nil {
+		// 刷新令牌已经失效，清除 Cookie，避免之后的每个请求都重复刷新
+		if errors.Is(err, errno.ErrRefreshTokenInvalid) || errors.Is(err, errno.ErrRefreshTokenReused) {
+			setCookie(c, refreshCookie, "", time.Unix(0, 0))
+		}
+		return nil, err
+	}
+	setTokenCookies(c, resp.GetToken(), resp.GetExpireAt().AsTime(), resp.GetRefreshToken(), resp.GetRefreshExpireAt().AsTime())
+
+	return token.ParseString(resp.GetToken())
+}
+
+// rotate 使用刷新令牌换取新的令牌. 登录令牌过期后，页面和静态资源等并发请求携带的是同一个刷新令牌，
+// 而刷新令牌只能使用一次，重复使用会撤销整个令牌族. 因此同一个刷新令牌的并发请求只刷新一次，刷新结果在
+// refreshGrace 内复用. 多实例部署时，同一个浏览器的请求需要路由到同一个实例.
+func (h *Handler) rotate(ctx context.Context, refreshToken string) (*apiv1.RefreshTokenResponse, error) {
+	sum := sha256.Sum256([]byte(refreshToken))
+	key := hex.EncodeToString(sum[:])
+	if resp, ok := h.rotated.Get(key); ok {
+		return resp, nil
+	}
+
+	v, err, _ := h.refreshes.Do(key, func() (any, error) {
+		// 发起刷新的请求被取消时，等待同一结果的其他请求仍然需要新的令牌
+		resp, err := h.biz.UserV1().RefreshToken(context.WithoutCancel(ctx), &apiv1.RefreshTokenRequest{RefreshToken: refreshToken})
+		if err != nil {
+			return nil, err
+		}
+		h.rotated.Add(key, resp, time.Now().Add(refreshGrace))
+		return resp, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*apiv1.RefreshTokenResponse), nil
+}
+
+// requireUser 要求请求已登录，未登录时跳转到登录页.
+func (h *Handler) requireUser(c *gin.Context) {
+	if currentUser(c) == nil {
+		c.Redirect(http.StatusSeeOther, "/signin?next="+url.QueryEscape(c.Request.URL.RequestURI()))
+		c.Abort()
+	}
+}
+
+// csrf 校验表单中的 CSRF 令牌，采用 Double Submit Cookie 方式防御跨站请求伪造.
+func (h *Handler) csrf(c *gin.Context) {
+	expected, err := c.Cookie(csrfCookie)
+	if err != nil || expected == "" || subtle.ConstantTimeCompare([]byte(expected), []byte(c.PostForm("csrf"))) != 1 {
+		h.renderError(c, errno.ErrPermissionDenied.WithMessage("Invalid or missing CSRF token, please reload the page and try again."))
+		c.Abort()
+	}
+}
+
+// csrfToken 返回当前请求的 CSRF 令牌，不存在时生成新的令牌并写入 Cookie.
+func (h *Handler) csrfToken(c *gin.Context) string {
+	if v := c.GetString(csrfKey); v != "" {
+		return v
+	}
+	if v, err := c.Cookie(csrfCookie); err == nil && v != "" {
+		return v
+	}
+
+	buf := make([]byte, 32)
+	_, _ = rand.Read(buf)
+	v := hex.EncodeToString(buf)
+	setCookie(c, csrfCookie, v, time.Time{})
+	c.Set(csrfKey, v)
+	return v
+}
+
+// currentUser 返回当前登录的用户，未登录时返回 nil.
+func currentUser(c *gin.Context) *model.UserM {
+	if v, ok := c.Get(userKey); ok {
+		return v.(*model.UserM)
+	}
+	return nil
+}
+
+// SigninForm 渲染登录表单.
+func (h *Handler) SigninForm(c *gin.Context) {
+	h.render(c, http.StatusOK, "signin", gin.H{"Next": c.Query("next")})
+}
+
+// Signin 处理登录表单，登录成功后将令牌写入 Cookie.
+func (h *Handler) Signin(c *gin.Context) {
+	ctx := c.Request.Context()
+	rq := &apiv1.LoginRequest{Username: c.PostForm("username"), Password: c.PostForm("password")}
+	data := gin.H{"Next": c.PostForm("next"), "Username": rq.Username}
+
+	if err := h.val.ValidateLoginRequest(ctx, rq); err != nil {
+		data["Error"] = errorsx.FromError(err).Message
+		h.render(c, errorsx.FromError(err).Code, "signin", data)
+		return
+	}
+
+	resp, err := h.biz.UserV1().Login(ctx, rq)
+	if err != nil {
+		log.W(ctx).Errorw("Failed to sign in", "username", rq.Username, "err", err)
+		data["Error"] = errorsx.FromError(err).Message
+		h.render(c, errorsx.FromError(err).Code, "signin", data)
+		return
+	}
+
+	// 启用了两步验证的用户需要继续提交验证码
+	if resp.GetTwoFactorRequired() {
+		data["Challenge"] = resp.GetChallengeToken()
+		h.render(c, http.StatusOK, "signin", data)
+		return
+	}
+
+	setTokenCookies(c, resp.GetToken(), resp.GetExpireAt().AsTime(), resp.GetRefreshToken(), resp.GetRefreshExpireAt().AsTime())
+	c.Redirect(http.StatusSeeOther, safeRedirect(c.PostForm("next")))
+}
+
+// SigninTwoFactor 处理两步验证表单，验证通过后将令牌写入 Cookie.
+func (h *Handler) SigninTwoFactor(c *gin.Context) {
+	ctx := c.Request.Context()
+	rq := &apiv1.VerifyTwoFactorRequest{ChallengeToken: c.PostForm("challenge"), Code: c.PostForm("code")}
+	data := gin.H{"Next": c.PostForm("next"), "Username": c.PostForm("username"), "Challenge": rq.ChallengeToken}
+
+	if err := h.val.ValidateVerifyTwoFactorRequest(ctx, rq); err != nil {
+		data["Error"] = errorsx.FromError(err).Message
+		h.render(c, errorsx.FromError(err).Code, "signin", data)
+		return
+	}
+
+	resp, err := h.biz.UserV1().VerifyTwoFactor(ctx, rq)
+	if err != nil {
+		log.W(ctx).Errorw("Failed to verify two-factor code", "username", c.PostForm("username"), "err", err)
+		data["Error"] = errorsx.FromError(err).Message
+		// 挑战令牌失效后需要重新输入密码
+		if errors.Is(err, errno.ErrUserTokenInvalid) {
+			delete(data, "Challenge")
+		}
+		h.render(c, errorsx.FromError(err).Code, "signin", data)
+		return
+	}
+
+	setTokenCookies(c, resp.GetToken(), resp.GetExpireAt().AsTime(), resp.GetRefreshToken(), resp.GetRefreshExpireAt().AsTime())
+	c.Redirect(http.StatusSeeOther, safeRedirect(c.PostForm("next")))
+}
+
+// Signout 撤销并清除登录令牌和刷新令牌.
+func (h *Handler) Signout(c *gin.Context) {
+	if currentUser(c) != nil {
+		refreshToken, _ := c.Cookie(refreshCookie)
+		if _, err := h.biz.UserV1().Logout(c.Request.Context(), &apiv1.LogoutRequest{RefreshToken: refreshToken}); err != nil {
+			log.W(c.Request.Context()).Errorw("Failed to sign out", "err", err)
+		}
+	}
+
+	setCookie(c, tokenCookie, "", time.Unix(0, 0))
+	setCookie(c, refreshCookie, "", time.Unix(0, 0))
+	c.Redirect(http.StatusSeeOther, "/")
+}
+
+// ComposeForm 渲染写博客表单.
+func (h *Handler) ComposeForm(c *gin.Context) {
+	if !h.authorize(c) {
+		return
+	}
+
+	h.render(c, http.StatusOK, "compose", gin.H{})
+}
+
+// Compose 处理写博客表单，创建成功后跳转到博客详情页.
+func (h *Handler) Compose(c *gin.Context) {
+	if !h.authorize(c) {
+		return
+	}
+
+	ctx := c.Request.Context()
+	rq := &apiv1.CreatePostRequest{Title: c.PostForm("title"), Content: c.PostForm("content")}
+	data := gin.H{"Title": rq.Title, "Content": rq.Content}
+
+	if err := h.val.ValidateCreatePostRequest(ctx, rq); err != nil {
+		data["Error"] = errorsx.FromError(err).Message
+		h.render(c, errorsx.FromError(err).Code, "compose", data)
+		return
+	}
+
+	resp, err := h.biz.PostV1().Create(ctx, rq)
+	if err != nil {
+		data["Error"] = errorsx.FromError(err).Message
+		h.render(c, errorsx.FromError(err).Code, "compose", data)
+		return
+	}
+
+	c.Redirect(http.StatusSeeOther, postURL(resp.GetPostID()))
+}
+
+// authorize 使用和 REST API 创建博客接口相同的授权策略，校验当前用户是否可以创建博客.
+func (h *Handler) authorize(c *gin.Context) bool {
+	subject := contextx.UserID(c.Request.Context())
+	if allowed, err := h.authz.Authorize(subject, "/v1/posts", http.MethodPost); err != nil || !allowed {
+		h.renderError(c, errno.ErrPermissionDenied)
+		return false
+	}
+	return true
+}
+
+// setCookie 写入仅限 HTTP 访问的 Cookie. expires 为零值时写入会话 Cookie.
+func setCookie(c *gin.Context, name string, value string, expires time.Time) {
+	cookie := &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   c.Request.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	}
+	if !expires.IsZero() {
+		cookie.Expires = expires
+	}
+	http.SetCookie(c.Writer, cookie)
+}
+
+// setTokenCookies 将登录令牌和刷新令牌写入 Cookie.
+func setTokenCookies(c *gin.Context, tokenStr string, expireAt time.Time, refreshToken string, refreshExpireAt time.Time) {
+	setCookie(c, tokenCookie, tokenStr, expireAt)
+	setCookie(c, refreshCookie, refreshToken, refreshExpireAt)
+}
+
+// safeRedirect 校验登录后的跳转地址，只允许跳转到本站的路径.
+func safeRedirect(next string) string {
+	if !strings.HasPrefix(next, "/") || strings.HasPrefix(next, "//") || strings.HasPrefix(next, "/\\") {
+		return "/"
+	}
+	return next
+}
@@ -0,0 +1,105 @@
+package web
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/biz"
+	userv1 "github.com/ra1n6ow/miniblog/internal/apiserver/biz/v1/user"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/lru"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// fakeBiz 只实现刷新令牌需要的业务方法.
+type fakeBiz struct {
+	biz.IBiz
+	users *fakeUserBiz
+}
+
+func (b *fakeBiz) UserV1() userv1.UserBiz { return b.users }
+
+// fakeUserBiz 模拟只能使用一次的刷新令牌，重复使用时返回 errno.ErrRefreshTokenReused.
+type fakeUserBiz struct {
+	userv1.UserBiz
+	calls   atomic.Int32
+	release chan struct{}
+	mu      sync.Mutex
+	used    map[string]bool
+}
+
+func (b *fakeUserBiz) RefreshToken(ctx context.Context, rq *apiv1.RefreshTokenRequest) (*apiv1.RefreshTokenResponse, error) {
+	b.calls.Add(1)
+	<-b.release
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.used[rq.GetRefreshToken()] {
+		return nil, errno.ErrRefreshTokenReused
+	}
+	b.used[rq.GetRefreshToken()] = true
+	return &apiv1.RefreshTokenResponse{Token: "token-" + rq.GetRefreshToken(), RefreshToken: "next-" + rq.GetRefreshToken()}, nil
+}
+
+func newTestHandler() (*Handler, *fakeUserBiz) {
+	users := &fakeUserBiz{release: make(chan struct{}), used: make(map[string]bool)}
+	return &Handler{biz: &fakeBiz{users: users}, rotated: lru.New[string, *apiv1.RefreshTokenResponse](rotatedCacheSize)}, users
+}
+
+func TestRotateConcurrentRequests(t *testing.T) {
+	h, users := newTestHandler()
+
+	// 登录令牌过期后，页面和静态资源的请求同时使用同一个刷新令牌
+	const requests = 5
+	var wg sync.WaitGroup
+	results := make([]*apiv1.RefreshTokenResponse, requests)
+	errs := make([]error, requests)
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = h.rotate(context.Background(), "refresh-1")
+		}(i)
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(users.release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), users.calls.Load())
+	for i := 0; i < requests; i++ {
+		require.NoError(t, errs[i])
+		assert.Equal(t, "next-refresh-1", results[i].GetRefreshToken())
+	}
+
+	// 浏览器收到新的 Cookie 之前发出的请求复用刷新结果
+	resp, err := h.rotate(context.Background(), "refresh-1")
+	require.NoError(t, err)
+	assert.Equal(t, "next-refresh-1", resp.GetRefreshToken())
+	assert.Equal(t, int32(1), users.calls.Load())
+
+	// 不同的刷新令牌分别刷新
+	resp, err = h.rotate(context.Background(), "refresh-2")
+	require.NoError(t, err)
+	assert.Equal(t, "next-refresh-2", resp.GetRefreshToken())
+	assert.Equal(t, int32(2), users.calls.Load())
+}
+
+func TestRotateAfterGrace(t *testing.T) {
+	h, users := newTestHandler()
+	close(users.release)
+
+	_, err := h.rotate(context.Background(), "refresh-1")
+	require.NoError(t, err)
+
+	// 复用时间过后缓存的刷新结果被清除，再次使用旧的刷新令牌视为重复使用
+	require.Equal(t, 1, h.rotated.Len())
+	h.rotated = lru.New[string, *apiv1.RefreshTokenResponse](rotatedCacheSize)
+	_, err = h.rotate(context.Background(), "refresh-1")
+	assert.ErrorIs(t, err, errno.ErrRefreshTokenReused)
+}
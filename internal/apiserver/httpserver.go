@@ -8,12 +8,15 @@ package apiserver
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/gin-contrib/pprof"
 	"github.com/gin-gonic/gin"
 	handler "github.com/ra1n6ow/miniblog/internal/apiserver/handler/http"
 	mw "github.com/ra1n6ow/miniblog/internal/pkg/middleware/gin"
+	"github.com/ra1n6ow/miniblog/internal/pkg/ratelimit"
 	"github.com/ra1n6ow/miniblog/internal/pkg/server"
+	"github.com/ra1n6ow/miniblog/pkg/token"
 )
 
 // ginServer 定义一个使用 Gin 框架开发的 HTTP 服务器.
@@ -25,19 +28,24 @@ type ginServer struct {
 var _ server.Server = (*ginServer)(nil)
 
 // NewGinServer 初始化一个新的 Gin 服务器实例.
-func (c *ServerConfig) NewGinServer() server.Server {
+func (c *ServerConfig) NewGinServer() (server.Server, error) {
 	// 创建 Gin 引擎
 	engine := gin.New()
 
-	// 注册全局中间件，用于恢复 panic、设置 HTTP 头、添加请求 ID 等
-	engine.Use(gin.Recovery(), mw.NoCache, mw.Cors, mw.Secure, mw.RequestIDMiddleware())
+	// 默认不信任任何代理，只有请求来自配置的可信代理时才使用 X-Forwarded-For 中的客户端 IP
+	if err := engine.SetTrustedProxies(c.cfg.ClientIP.Proxies()); err != nil {
+		return nil, err
+	}
+
+	// 注册全局中间件，用于恢复 panic、设置 HTTP 头、添加请求 ID 和客户端 IP 等
+	engine.Use(gin.Recovery(), mw.NoCache, mw.Cors, mw.Secure, mw.RequestIDMiddleware(), mw.ClientIPMiddleware(c.cfg.ClientIP))
 
 	// 注册 REST API 路由
 	c.InstallRESTAPI(engine)
 
 	httpsrv := server.NewHTTPServer(c.cfg.HTTPOptions, c.cfg.TLSOptions, engine)
 
-	return &ginServer{srv: httpsrv}
+	return &ginServer{srv: httpsrv}, nil
 }
 
 // 注册 API 路由。路由的路径和 HTTP 方法，严格遵循 REST 规范.
@@ -51,11 +59,20 @@ func (c *ServerConfig) InstallRESTAPI(engine *gin.Engine) {
 	// 注册健康检查接口
 	engine.GET("/healthz", handler.Healthz)
 
-	// 注册用户登录和令牌刷新接口。这2个接口比较简单，所以没有 API 版本
+	// 发布签名公钥，其他服务可以使用公钥校验 miniblog 签发的 token
+	engine.GET(jwksPath, gin.WrapH(token.JWKSHandler()))
+
+	// 注册用户登录和令牌刷新接口。这些接口比较简单，所以没有 API 版本
 	engine.POST("/login", handler.Login)
-	engine.PUT("/refresh-token", mw.AuthnMiddleware(c.retriever), handler.RefreshToken)
+	engine.POST("/login/two-factor", handler.VerifyTwoFactor)
+	engine.GET("/login/oidc/:provider", handler.StartOIDCLogin)
+	engine.GET("/login/oidc/:provider/callback", handler.CompleteOIDCLogin)
+	// 刷新令牌本身就是凭证，访问令牌过期后仍然可以调用，因此不经过认证中间件
+	engine.PUT("/refresh-token", handler.RefreshToken)
+	engine.POST("/logout", mw.AuthnMiddleware(c.retriever, c.checker), mw.ScopeMiddleware(httpScopes), handler.Logout)
 
-	authMiddlewares := []gin.HandlerFunc{mw.AuthnMiddleware(c.retriever), mw.AuthzMiddleware(c.authz)}
+	// 使用个人访问令牌认证的请求，在 casbin 授权之后还需要校验令牌的权限范围
+	authMiddlewares := []gin.HandlerFunc{mw.AuthnMiddleware(c.retriever, c.checker), mw.AuthzMiddleware(c.authz), mw.ScopeMiddleware(httpScopes)}
 
 	// 注册 v1 版本 API 路由分组
 	v1 := engine.Group("/v1")
@@ -66,11 +83,19 @@ func (c *ServerConfig) InstallRESTAPI(engine *gin.Engine) {
 			// 创建用户。这里要注意：创建用户是不用进行认证和授权的
 			userv1.POST("", handler.CreateUser)
 			userv1.Use(authMiddlewares...)
-			userv1.PUT(":userID/change-password", handler.ChangePassword) // 修改用户密码
-			userv1.PUT(":userID", handler.UpdateUser)                     // 更新用户信息
-			userv1.DELETE(":userID", handler.DeleteUser)                  // 删除用户
-			userv1.GET(":userID", handler.GetUser)                        // 查询用户详情
-			userv1.GET("", handler.ListUser)                              // 查询用户列表.
+			userv1.PUT(":userID/change-password", handler.ChangePassword)            // 修改用户密码
+			userv1.PUT(":userID", handler.UpdateUser)                                // 更新用户信息
+			userv1.DELETE(":userID", handler.DeleteUser)                             // 删除用户
+			userv1.GET(":userID", handler.GetUser)                                   // 查询用户详情
+			userv1.GET("", handler.ListUser)                                         // 查询用户列表.
+			userv1.POST(":userID/verification-email", handler.SendVerificationEmail) // 重新发送邮箱验证邮件
+			userv1.POST(":userID/unlock", handler.UnlockUser)                        // 解除用户登录锁定（仅管理员）
+			userv1.POST(":userID/two-factor", handler.EnrollTwoFactor)               // 绑定两步验证
+			userv1.POST(":userID/two-factor/confirm", handler.ConfirmTwoFactor)      // 启用两步验证
+			userv1.DELETE(":userID/two-factor", handler.ResetTwoFactor)              // 重置用户两步验证（仅管理员）
+			userv1.POST(":userID/roles", handler.AssignRole)                         // 为用户分配角色（仅管理员）
+			userv1.DELETE(":userID/roles", handler.UnassignRole)                     // 移除用户角色（仅管理员）
+			userv1.GET(":userID/roles", handler.ListUserRoles)                       // 查询用户角色列表（仅管理员）
 		}
 
 		// 博客相关路由
@@ -81,8 +106,100 @@ func (c *ServerConfig) InstallRESTAPI(engine *gin.Engine) {
 			postv1.DELETE("", handler.DeletePost)     // 删除博客
 			postv1.GET(":postID", handler.GetPost)    // 查询博客详情
 			postv1.GET("", handler.ListPost)          // 查询博客列表
+
+			postv1.POST(":postID/reactions", handler.AddReaction)                        // 添加博客反应
+			postv1.DELETE(":postID/reactions", handler.RemoveReaction)                   // 移除博客反应
+			postv1.GET(":postID/reactors", handler.ListReactors)                         // 查询博客反应用户列表
+			postv1.GET(":postID/related", handler.ListRelatedPosts)                      // 查询相关博客列表
+			postv1.POST(":postID/pin", handler.PinPost)                                  // 置顶博客
+			postv1.DELETE(":postID/pin", handler.UnpinPost)                              // 取消置顶博客
+			postv1.POST(":postID/feature", handler.FeaturePost)                          // 精选博客（仅管理员）
+			postv1.DELETE(":postID/feature", handler.UnfeaturePost)                      // 取消精选博客（仅管理员）
+			postv1.POST(":postID/translations", handler.CreatePostTranslation)           // 添加博客译文
+			postv1.PUT(":postID/translations/:locale", handler.UpdatePostTranslation)    // 更新博客译文
+			postv1.DELETE(":postID/translations/:locale", handler.DeletePostTranslation) // 删除博客译文
+			postv1.POST(":postID/preview-links", handler.CreatePreviewLink)              // 创建博客预览链接
+			postv1.GET(":postID/preview-links", handler.ListPreviewLinks)                // 查询博客预览链接列表
+			postv1.DELETE(":postID/preview-links/:linkID", handler.RevokePreviewLink)    // 撤销博客预览链接
+		}
+
+		// 书签相关路由
+		bookmarkv1 := v1.Group("/bookmarks", authMiddlewares...)
+		{
+			bookmarkv1.POST("", handler.AddBookmark)             // 收藏博客
+			bookmarkv1.DELETE(":postID", handler.RemoveBookmark) // 取消收藏博客
+			bookmarkv1.GET("", handler.ListBookmark)             // 查询书签列表
+			bookmarkv1.PUT("order", handler.ReorderBookmarks)    // 调整书签顺序
+		}
+		v1.GET("/reading-lists", append(authMiddlewares, handler.ListReadingList)...) // 查询阅读列表
+
+		// 登录会话相关路由
+		sessionv1 := v1.Group("/sessions", authMiddlewares...)
+		{
+			sessionv1.GET("", handler.ListSessions)                         // 查询登录会话列表
+			sessionv1.DELETE(":sessionID", handler.RevokeSession)           // 撤销登录会话
+			sessionv1.POST("revoke-others", handler.RevokeAllOtherSessions) // 撤销其他所有登录会话
+		}
+
+		// 个人访问令牌相关路由
+		accessTokenv1 := v1.Group("/access-tokens", authMiddlewares...)
+		{
+			accessTokenv1.POST("", handler.CreateAccessToken)           // 创建个人访问令牌
+			accessTokenv1.GET("", handler.ListAccessTokens)             // 查询个人访问令牌列表
+			accessTokenv1.DELETE(":tokenID", handler.RevokeAccessToken) // 撤销个人访问令牌
+		}
+
+		// 授权策略和角色分配相关路由（仅管理员）
+		policyv1 := v1.Group("/policies", authMiddlewares...)
+		{
+			policyv1.GET("", handler.ListPolicies)    // 查询授权策略列表
+			policyv1.POST("", handler.CreatePolicy)   // 添加授权策略
+			policyv1.DELETE("", handler.DeletePolicy) // 删除授权策略
+			policyv1.PUT("", handler.ReplacePolicies) // 批量替换授权策略
+		}
+		v1.GET("/role-assignments", append(authMiddlewares, handler.ListRoleAssignments)...) // 查询角色分配列表（仅管理员）
+		v1.GET("/roles/:role/users", append(authMiddlewares, handler.ListRoleUsers)...)      // 查询角色用户列表（仅管理员）
+
+		// 验证用户邮箱。验证链接通过邮件发送，点击链接时用户可能没有登录，因此不做认证和授权
+		v1.GET("/verify-email", handler.VerifyEmail)
+
+		// 重置密码。忘记密码的用户无法登录，因此不做认证和授权，而是按客户端 IP 限流
+		resetLimiter := ratelimit.New(c.cfg.PasswordResetRateLimit, time.Minute)
+		v1.POST("/password-reset", mw.RateLimitMiddleware(resetLimiter), handler.RequestPasswordReset)
+		v1.POST("/password-reset/confirm", mw.RateLimitMiddleware(resetLimiter), handler.ResetPassword)
+
+		// 通过预览链接查看博客。持有预览链接的人不需要登录，因此不做认证和授权，而是按客户端 IP 限流
+		previewLimiter := ratelimit.New(c.cfg.PreviewRateLimit, time.Minute)
+		v1.GET("/previews/:linkID", mw.RateLimitMiddleware(previewLimiter), handler.GetPreview)
+
+		// 博客模板相关路由
+		templatev1 := v1.Group("/post-templates", authMiddlewares...)
+		{
+			templatev1.POST("", handler.CreatePostTemplate)                      // 创建博客模板
+			templatev1.PUT(":templateID", handler.UpdatePostTemplate)            // 更新博客模板
+			templatev1.DELETE(":templateID", handler.DeletePostTemplate)         // 删除博客模板
+			templatev1.GET(":templateID", handler.GetPostTemplate)               // 查询博客模板详情
+			templatev1.GET("", handler.ListPostTemplate)                         // 查询博客模板列表
+			templatev1.POST(":templateID/posts", handler.CreatePostFromTemplate) // 从博客模板创建博客
+		}
+
+		v1.GET("/mentions", append(authMiddlewares, handler.ListMentions)...) // 查询提及我的博客列表
+
+		// 统计分析相关路由
+		analyticsv1 := v1.Group("/analytics", authMiddlewares...)
+		{
+			analyticsv1.GET("posts", handler.ListPostActivity)     // 查询用户发布博客统计（仅管理员）
+			analyticsv1.GET("top-authors", handler.ListTopAuthors) // 查询最活跃的作者（仅管理员）
+			analyticsv1.GET("site", handler.ListSiteStats)         // 查询全站统计（仅管理员）
+			analyticsv1.GET("me", handler.GetMyPostStats)          // 查询当前用户的博客统计
 		}
 	}
+
+	// 注册内置 HTML 前端路由，浏览器访问不存在的页面时返回 HTML 格式的 404 页面
+	if c.web != nil {
+		c.web.Install(engine)
+		engine.NoRoute(c.web.NotFound, pageNotFound)
+	}
 }
 
 // InstallGenericAPI 注册业务无关的路由，例如 pprof、404 处理等.
@@ -91,9 +208,12 @@ func InstallGenericAPI(engine *gin.Engine) {
 	pprof.Register(engine)
 
 	// 注册 404 路由处理
-	engine.NoRoute(func(c *gin.Context) {
-		c.JSON(http.StatusNotFound, "Page not found.")
-	})
+	engine.NoRoute(pageNotFound)
+}
+
+// pageNotFound 返回 404 响应.
+func pageNotFound(c *gin.Context) {
+	c.JSON(http.StatusNotFound, "Page not found.")
 }
 
 // RunOrDie 启动 Gin 服务器，出错则程序崩溃退出.
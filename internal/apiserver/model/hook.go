@@ -4,8 +4,29 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/ra1n6ow/miniblog/internal/pkg/rid"
+	"github.com/ra1n6ow/miniblog/internal/pkg/textstat"
 )
 
+// BeforeSave 在保存数据库记录之前根据博客内容计算字数、预计阅读时间和摘要.
+func (m *PostM) BeforeSave(tx *gorm.DB) error {
+	stats := textstat.Compute(m.Content)
+	m.WordCount = stats.WordCount
+	m.ReadingTime = stats.ReadingTime
+	m.Excerpt = stats.Excerpt
+
+	return nil
+}
+
+// BeforeSave 在保存数据库记录之前根据译文内容计算字数、预计阅读时间和摘要.
+func (m *PostTranslationM) BeforeSave(tx *gorm.DB) error {
+	stats := textstat.Compute(m.Content)
+	m.WordCount = stats.WordCount
+	m.ReadingTime = stats.ReadingTime
+	m.Excerpt = stats.Excerpt
+
+	return nil
+}
+
 // AfterCreate 在创建数据库记录之后生成 postID.
 func (m *PostM) AfterCreate(tx *gorm.DB) error {
 	m.PostID = rid.PostID.New(uint64(m.ID))
@@ -13,6 +34,20 @@ func (m *PostM) AfterCreate(tx *gorm.DB) error {
 	return tx.Save(m).Error
 }
 
+// AfterCreate 在创建数据库记录之后生成 linkID.
+func (m *PreviewLinkM) AfterCreate(tx *gorm.DB) error {
+	m.LinkID = rid.PreviewLinkID.New(uint64(m.ID))
+
+	return tx.Save(m).Error
+}
+
+// AfterCreate 在创建数据库记录之后生成 templateID.
+func (m *PostTemplateM) AfterCreate(tx *gorm.DB) error {
+	m.TemplateID = rid.TemplateID.New(uint64(m.ID))
+
+	return tx.Save(m).Error
+}
+
 // AfterCreate 在创建数据库记录之后生成 userID.
 func (m *UserM) AfterCreate(tx *gorm.DB) error {
 	m.UserID = rid.UserID.New(uint64(m.ID))
@@ -12,13 +12,17 @@ const TableNamePostM = "post"
 
 // PostM 博文表
 type PostM struct {
-	ID        int64     `gorm:"column:id;primaryKey;autoIncrement:true" json:"id"`
-	UserID    string    `gorm:"column:userID;not null;comment:用户唯一 ID" json:"userID"`                                  // 用户唯一 ID
-	PostID    string    `gorm:"column:postID;not null;uniqueIndex:idx_post_postID;comment:博文唯一 ID" json:"postID"`      // 博文唯一 ID
-	Title     string    `gorm:"column:title;not null;comment:博文标题" json:"title"`                                       // 博文标题
-	Content   string    `gorm:"column:content;not null;comment:博文内容" json:"content"`                                   // 博文内容
-	CreatedAt time.Time `gorm:"column:createdAt;not null;default:current_timestamp;comment:博文创建时间" json:"createdAt"`   // 博文创建时间
-	UpdatedAt time.Time `gorm:"column:updatedAt;not null;default:current_timestamp;comment:博文最后修改时间" json:"updatedAt"` // 博文最后修改时间
+	ID          int64     `gorm:"column:id;primaryKey;autoIncrement:true" json:"id"`
+	UserID      string    `gorm:"column:userID;not null;comment:用户唯一 ID" json:"userID"`                                  // 用户唯一 ID
+	PostID      string    `gorm:"column:postID;not null;uniqueIndex:idx_post_postID;comment:博文唯一 ID" json:"postID"`      // 博文唯一 ID
+	Title       string    `gorm:"column:title;not null;comment:博文标题" json:"title"`                                       // 博文标题
+	Content     string    `gorm:"column:content;not null;comment:博文内容" json:"content"`                                   // 博文内容
+	WordCount   int64     `gorm:"column:wordCount;not null;comment:博文字数" json:"wordCount"`                               // 博文字数
+	ReadingTime int64     `gorm:"column:readingTime;not null;comment:预计阅读时间，单位为分钟" json:"readingTime"`                   // 预计阅读时间，单位为分钟
+	Excerpt     string    `gorm:"column:excerpt;not null;comment:博文纯文本摘要" json:"excerpt"`                                // 博文纯文本摘要
+	Locale      string    `gorm:"column:locale;not null;comment:博文默认语言的语言标签（BCP 47）" json:"locale"`                      // 博文默认语言的语言标签（BCP 47）
+	CreatedAt   time.Time `gorm:"column:createdAt;not null;default:current_timestamp;comment:博文创建时间" json:"createdAt"`   // 博文创建时间
+	UpdatedAt   time.Time `gorm:"column:updatedAt;not null;default:current_timestamp;comment:博文最后修改时间" json:"updatedAt"` // 博文最后修改时间
 }
 
 // TableName PostM's table name
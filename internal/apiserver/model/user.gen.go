@@ -12,15 +12,20 @@ const TableNameUserM = "user"
 
 // UserM 用户表
 type UserM struct {
-	ID        int64     `gorm:"column:id;primaryKey;autoIncrement:true" json:"id"`
-	UserID    string    `gorm:"column:userID;not null;uniqueIndex:idx_user_userID;comment:用户唯一 ID" json:"userID"`       // 用户唯一 ID
-	Username  string    `gorm:"column:username;not null;uniqueIndex:idx_user_username;comment:用户名（唯一）" json:"username"` // 用户名（唯一）
-	Password  string    `gorm:"column:password;not null;comment:用户密码（加密后）" json:"password"`                             // 用户密码（加密后）
-	Nickname  string    `gorm:"column:nickname;not null;comment:用户昵称" json:"nickname"`                                  // 用户昵称
-	Email     string    `gorm:"column:email;not null;comment:用户电子邮箱地址" json:"email"`                                    // 用户电子邮箱地址
-	Phone     string    `gorm:"column:phone;not null;uniqueIndex:idx_user_phone;comment:用户手机号" json:"phone"`            // 用户手机号
-	CreatedAt time.Time `gorm:"column:createdAt;not null;default:current_timestamp;comment:用户创建时间" json:"createdAt"`    // 用户创建时间
-	UpdatedAt time.Time `gorm:"column:updatedAt;not null;default:current_timestamp;comment:用户最后修改时间" json:"updatedAt"`  // 用户最后修改时间
+	ID              int64      `gorm:"column:id;primaryKey;autoIncrement:true" json:"id"`
+	UserID          string     `gorm:"column:userID;not null;uniqueIndex:idx_user_userID;comment:用户唯一 ID" json:"userID"`       // 用户唯一 ID
+	Username        string     `gorm:"column:username;not null;uniqueIndex:idx_user_username;comment:用户名（唯一）" json:"username"` // 用户名（唯一）
+	Password        string     `gorm:"column:password;not null;comment:用户密码（加密后）" json:"password"`                             // 用户密码（加密后）
+	Nickname        string     `gorm:"column:nickname;not null;comment:用户昵称" json:"nickname"`                                  // 用户昵称
+	Email           string     `gorm:"column:email;not null;comment:用户电子邮箱地址" json:"email"`                                    // 用户电子邮箱地址
+	EmailVerifiedAt *time.Time `gorm:"column:emailVerifiedAt;comment:电子邮箱验证时间，为空表示未验证" json:"emailVerifiedAt"`                 // 电子邮箱验证时间，为空表示未验证
+	Phone           *string    `gorm:"column:phone;uniqueIndex:idx_user_phone;comment:用户手机号，通过单点登录创建的用户可以为空" json:"phone"`     // 用户手机号，通过单点登录创建的用户可以为空
+	TotpSecret      *string    `gorm:"column:totpSecret;comment:两步验证 TOTP 密钥（Base32），为空表示未绑定" json:"totpSecret"`               // 两步验证 TOTP 密钥（Base32），为空表示未绑定
+	TotpEnabledAt   *time.Time `gorm:"column:totpEnabledAt;comment:两步验证启用时间，为空表示未启用" json:"totpEnabledAt"`                     // 两步验证启用时间，为空表示未启用
+	TotpLastStep    int64      `gorm:"column:totpLastStep;not null;comment:最后一次使用的 TOTP 时间步，用于防止重放" json:"totpLastStep"`       // 最后一次使用的 TOTP 时间步，用于防止重放
+	TokensRevokedAt *time.Time `gorm:"column:tokensRevokedAt;comment:在该时间之前签发的访问令牌全部失效，修改密码时更新" json:"tokensRevokedAt"`        // 在该时间之前签发的访问令牌全部失效，修改密码时更新
+	CreatedAt       time.Time  `gorm:"column:createdAt;not null;default:current_timestamp;comment:用户创建时间" json:"createdAt"`    // 用户创建时间
+	UpdatedAt       time.Time  `gorm:"column:updatedAt;not null;default:current_timestamp;comment:用户最后修改时间" json:"updatedAt"`  // 用户最后修改时间
 }
 
 // TableName UserM's table name
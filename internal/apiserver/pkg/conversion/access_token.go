@@ -0,0 +1,22 @@
+package conversion
+
+import (
+	"github.com/ra1n6ow/gpkg/core"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/pkg/scope"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// AccessTokenModelToAccessTokenV1 将模型层的 AccessTokenM（个人访问令牌模型对象）转换为 Protobuf 层的 AccessToken（v1 个人访问令牌对象）.
+func AccessTokenModelToAccessTokenV1(tokenModel *model.AccessTokenM) *apiv1.AccessToken {
+	var protoToken apiv1.AccessToken
+	_ = core.CopyWithConverters(&protoToken, tokenModel)
+	protoToken.Scopes = scope.Split(tokenModel.Scopes)
+	protoToken.LastUsedAt = nil
+	if tokenModel.LastUsedAt != nil {
+		protoToken.LastUsedAt = timestamppb.New(*tokenModel.LastUsedAt)
+	}
+	return &protoToken
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/ra1n6ow/gpkg/core"
 
 	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/related"
 	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
 )
 
@@ -20,3 +21,14 @@ func PostV1ToPostModel(protoPost *apiv1.Post) *model.PostM {
 	_ = core.CopyWithConverters(&postModel, protoPost)
 	return &postModel
 }
+
+// PostModelToDocument 将模型层的 PostM（博客模型对象）转换为相关博客索引中的文档.
+func PostModelToDocument(postModel *model.PostM) related.Document {
+	return related.Document{
+		PostID:    postModel.PostID,
+		UserID:    postModel.UserID,
+		Title:     postModel.Title,
+		Content:   postModel.Content,
+		CreatedAt: postModel.CreatedAt,
+	}
+}
@@ -0,0 +1,16 @@
+package conversion
+
+import (
+	"github.com/ra1n6ow/gpkg/core"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// PreviewLinkModelToPreviewLinkV1 将模型层的 PreviewLinkM（预览链接模型对象）转换为 Protobuf 层的 PreviewLink（v1 预览链接对象）.
+// 转换结果不包含预览地址，预览地址需要由持有签名密钥的调用方填充.
+func PreviewLinkModelToPreviewLinkV1(linkModel *model.PreviewLinkM) *apiv1.PreviewLink {
+	var protoLink apiv1.PreviewLink
+	_ = core.CopyWithConverters(&protoLink, linkModel)
+	return &protoLink
+}
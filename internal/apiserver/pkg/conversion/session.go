@@ -0,0 +1,15 @@
+package conversion
+
+import (
+	"github.com/ra1n6ow/gpkg/core"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// SessionModelToSessionV1 将模型层的 UserSessionM（登录会话模型对象）转换为 Protobuf 层的 Session（v1 登录会话对象）.
+func SessionModelToSessionV1(sessionModel *model.UserSessionM) *apiv1.Session {
+	var protoSession apiv1.Session
+	_ = core.CopyWithConverters(&protoSession, sessionModel)
+	return &protoSession
+}
@@ -0,0 +1,15 @@
+package conversion
+
+import (
+	"github.com/ra1n6ow/gpkg/core"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// TemplateModelToTemplateV1 将模型层的 PostTemplateM（博客模板模型对象）转换为 Protobuf 层的 PostTemplate（v1 博客模板对象）.
+func TemplateModelToTemplateV1(templateModel *model.PostTemplateM) *apiv1.PostTemplate {
+	var protoTemplate apiv1.PostTemplate
+	_ = core.CopyWithConverters(&protoTemplate, templateModel)
+	return &protoTemplate
+}
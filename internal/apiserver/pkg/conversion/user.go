@@ -11,6 +11,8 @@ import (
 func UserModelToUserV1(userModel *model.UserM) *apiv1.User {
 	var protoUser apiv1.User
 	_ = core.CopyWithConverters(&protoUser, userModel)
+	protoUser.EmailVerified = userModel.EmailVerifiedAt != nil
+	protoUser.TwoFactorEnabled = userModel.TotpEnabledAt != nil
 	return &protoUser
 }
 
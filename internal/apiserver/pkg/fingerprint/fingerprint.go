@@ -0,0 +1,113 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+// Package fingerprint 计算博客内容的指纹，用于识别重复和近似重复的博客.
+//
+// 指纹由两部分组成：规范化文本的 SHA-256 摘要用于识别完全相同的内容；
+// 基于字符 shingle 的 64 位 SimHash 签名用于识别近似重复的内容，两个签名的汉明距离越小，内容越相似.
+package fingerprint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"hash/fnv"
+	"math/bits"
+	"strings"
+	"unicode"
+)
+
+// shingleSize 为计算 SimHash 时使用的字符 shingle 长度.
+const shingleSize = 4
+
+// Fingerprint 表示一段博客内容的指纹.
+type Fingerprint struct {
+	// Hash 为规范化文本的 SHA-256 摘要（十六进制）.
+	Hash string
+	// SimHash 为规范化文本的 64 位 SimHash 签名.
+	SimHash uint64
+}
+
+// Compute 计算博客标题和内容的指纹.
+func Compute(title string, content string) Fingerprint {
+	normalized := Normalize(title + "\n" + content)
+	sum := sha256.Sum256([]byte(normalized))
+	return Fingerprint{Hash: hex.EncodeToString(sum[:]), SimHash: SimHash(normalized)}
+}
+
+// Normalize 规范化文本：转换为小写，去掉标点和符号，并将连续的空白合并为一个空格.
+// 因此只有大小写、标点或空白不同的文本会得到相同的结果.
+func Normalize(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+
+	space := false
+	for _, r := range s {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsNumber(r):
+			if space && b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			space = false
+			b.WriteRune(unicode.ToLower(r))
+		case unicode.IsSpace(r):
+			space = true
+		}
+	}
+
+	return b.String()
+}
+
+// SimHash 计算规范化文本的 64 位 SimHash 签名. 特征为长度为 shingleSize 的字符 shingle，
+// 按字符而不是单词切分，因此同样适用于中文等不以空格分词的语言.
+func SimHash(normalized string) uint64 {
+	runes := []rune(normalized)
+	if len(runes) == 0 {
+		return 0
+	}
+
+	var weights [64]int
+	add := func(shingle []rune) {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(string(shingle)))
+		sum := h.Sum64()
+		for i := range weights {
+			if sum&(1<<uint(i)) != 0 {
+				weights[i]++
+			} else {
+				weights[i]--
+			}
+		}
+	}
+
+	if len(runes) <= shingleSize {
+		add(runes)
+	} else {
+		for i := 0; i+shingleSize <= len(runes); i++ {
+			add(runes[i : i+shingleSize])
+		}
+	}
+
+	var ret uint64
+	for i, w := range weights {
+		if w > 0 {
+			ret |= 1 << uint(i)
+		}
+	}
+	return ret
+}
+
+// Distance 返回两个 SimHash 签名之间的汉明距离.
+func Distance(a uint64, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
+
+// Matches 判断两个指纹是否重复. 摘要相同时视为完全重复；
+// 否则当 maxDistance 不小于 0 且签名的汉明距离不超过 maxDistance 时视为近似重复.
+func (f Fingerprint) Matches(other Fingerprint, maxDistance int) bool {
+	if f.Hash == other.Hash {
+		return true
+	}
+	return maxDistance >= 0 && Distance(f.SimHash, other.SimHash) <= maxDistance
+}
@@ -0,0 +1,67 @@
+package fingerprint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"empty", "", ""},
+		{"case and spaces", "  Hello\t\tWORLD \n", "hello world"},
+		{"punctuation", "Hello, world!!!", "hello world"},
+		{"markdown", "# Title\n\n**bold** `code`", "title bold code"},
+		{"cjk", "你好，世界。", "你好世界"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Normalize(tt.in))
+		})
+	}
+}
+
+func TestCompute_Exact(t *testing.T) {
+	a := Compute("My first post", "Hello, world!")
+	b := Compute("my first post", "  hello   world ")
+	c := Compute("My first post", "Hello, gophers!")
+
+	assert.Equal(t, a, b)
+	assert.NotEqual(t, a.Hash, c.Hash)
+	assert.Len(t, a.Hash, 64)
+}
+
+func TestMatches(t *testing.T) {
+	body := strings.Repeat("The quick brown fox jumps over the lazy dog near the river bank. ", 10)
+	original := Compute("Foxes", body)
+	edited := Compute("Foxes", body+"Edited.")
+	unrelated := Compute("Databases", strings.Repeat("Indexes make lookups fast but slow down writes to the table. ", 10))
+
+	assert.True(t, original.Matches(original, 0))
+	assert.LessOrEqual(t, Distance(original.SimHash, edited.SimHash), 3)
+	assert.True(t, original.Matches(edited, 3))
+	assert.False(t, original.Matches(edited, -1))
+	assert.False(t, original.Matches(unrelated, 3))
+}
+
+func TestMatches_CJK(t *testing.T) {
+	body := strings.Repeat("今天天气很好，我们去公园散步，看到了很多盛开的花朵和嬉戏的孩子。", 8)
+	original := Compute("散步", body)
+	edited := Compute("散步", body+"真开心")
+	unrelated := Compute("数据库", strings.Repeat("索引可以加快查询速度，但是会降低写入数据的性能。", 8))
+
+	assert.True(t, original.Matches(edited, 3))
+	assert.False(t, original.Matches(unrelated, 3))
+}
+
+func TestDistance(t *testing.T) {
+	assert.Equal(t, 0, Distance(0, 0))
+	assert.Equal(t, 64, Distance(0, ^uint64(0)))
+	assert.Equal(t, 2, Distance(0b1010, 0b0000))
+}
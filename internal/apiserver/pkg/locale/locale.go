@@ -0,0 +1,52 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+// Package locale 提供多语言博客使用的语言标签规范化和语言协商函数.
+// 语言标签使用 BCP 47 格式，例如 zh-CN、en、en-US.
+package locale
+
+import (
+	"golang.org/x/text/language"
+)
+
+// MaxLength 为语言标签的最大长度，和数据库中 locale 字段的长度一致.
+const MaxLength = 35
+
+// Canonicalize 校验语言标签，并返回规范化后的语言标签，例如 zh-cn 会被规范化为 zh-CN.
+func Canonicalize(tag string) (string, error) {
+	t, err := language.Parse(tag)
+	if err != nil {
+		return "", err
+	}
+	return t.String(), nil
+}
+
+// Match 从 available 中选出最符合 preference 的语言. preference 可以是单个语言标签，
+// 也可以是 Accept-Language 请求头格式的语言列表（例如 "en-US,en;q=0.9,zh;q=0.8"）.
+// preference 为空、无法解析或者没有可接受的匹配时，返回 defaultLocale.
+func Match(preference string, defaultLocale string, available []string) string {
+	if preference == "" || len(available) == 0 {
+		return defaultLocale
+	}
+	prefs, _, err := language.ParseAcceptLanguage(preference)
+	if err != nil || len(prefs) == 0 {
+		return defaultLocale
+	}
+
+	// Matcher 在没有匹配时会返回第一个支持的语言，所以把默认语言放在最前面
+	supported := make([]string, 0, len(available)+1)
+	supported = append(supported, defaultLocale)
+	supported = append(supported, available...)
+	tags := make([]language.Tag, 0, len(supported))
+	for _, s := range supported {
+		tags = append(tags, language.Make(s))
+	}
+
+	_, index, confidence := language.NewMatcher(tags).Match(prefs...)
+	if confidence == language.No {
+		return defaultLocale
+	}
+	return supported[index]
+}
@@ -0,0 +1,44 @@
+package locale
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanonicalize(t *testing.T) {
+	got, err := Canonicalize("zh-cn")
+	assert.NoError(t, err)
+	assert.Equal(t, "zh-CN", got)
+
+	got, err = Canonicalize("EN")
+	assert.NoError(t, err)
+	assert.Equal(t, "en", got)
+
+	_, err = Canonicalize("not a locale")
+	assert.Error(t, err)
+}
+
+func TestMatch(t *testing.T) {
+	available := []string{"en", "ja"}
+
+	tests := []struct {
+		name       string
+		preference string
+		want       string
+	}{
+		{"empty", "", "zh-CN"},
+		{"exact", "ja", "ja"},
+		{"regional variant", "en-GB", "en"},
+		{"accept language", "fr-FR,fr;q=0.9,en;q=0.8", "en"},
+		{"quality order", "en;q=0.5,zh-CN;q=0.9", "zh-CN"},
+		{"no match", "fr", "zh-CN"},
+		{"invalid", ";;;", "zh-CN"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Match(tt.preference, "zh-CN", available))
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+// Package mention 从博客内容中解析 @username 形式的提及.
+package mention
+
+import "unicode/utf8"
+
+const (
+	// minUsernameLen 和 maxUsernameLen 与用户名的长度限制保持一致.
+	minUsernameLen = 3
+	maxUsernameLen = 20
+)
+
+// Mention 表示内容中的一次提及.
+// Start 和 End 为以 Unicode 码点计算的偏移量，区间为 [Start, End)，包含开头的 @ 字符.
+type Mention struct {
+	Username string
+	Start    int64
+	End      int64
+}
+
+// Parse 解析内容中所有的 @username 提及.
+// @ 前面紧跟用户名字符时（例如邮箱地址 foo@example.com）不视为提及，超过用户名最大长度的也会被忽略.
+func Parse(content string) []Mention {
+	var (
+		mentions []Mention
+		// pos 为当前字节偏移量 i 对应的码点偏移量
+		pos  int64
+		prev rune
+	)
+
+	for i := 0; i < len(content); {
+		r, size := utf8.DecodeRuneInString(content[i:])
+		if r != '@' || isUsernameChar(prev) || prev == '@' {
+			prev = r
+			i += size
+			pos++
+			continue
+		}
+
+		// 用户名只包含 ASCII 字符，因此字节长度和码点长度相同
+		j := i + 1
+		for j < len(content) && isUsernameChar(rune(content[j])) {
+			j++
+		}
+		n := j - i - 1
+		if n >= minUsernameLen && n <= maxUsernameLen {
+			mentions = append(mentions, Mention{Username: content[i+1 : j], Start: pos, End: pos + int64(n) + 1})
+		}
+
+		prev = rune(content[j-1])
+		pos += int64(j - i)
+		i = j
+	}
+
+	return mentions
+}
+
+// Usernames 返回提及中去重后的用户名，顺序与首次出现的顺序一致.
+func Usernames(mentions []Mention) []string {
+	seen := make(map[string]struct{}, len(mentions))
+	names := make([]string, 0, len(mentions))
+	for _, m := range mentions {
+		if _, ok := seen[m.Username]; ok {
+			continue
+		}
+		seen[m.Username] = struct{}{}
+		names = append(names, m.Username)
+	}
+	return names
+}
+
+// isUsernameChar 判断字符是否可以出现在用户名中.
+func isUsernameChar(r rune) bool {
+	return r == '_' || ('0' <= r && r <= '9') || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z')
+}
@@ -0,0 +1,36 @@
+package mention
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []Mention
+	}{
+		{"empty", "", nil},
+		{"single", "hi @alice!", []Mention{{Username: "alice", Start: 3, End: 9}}},
+		{"start of content", "@bob_1 ok", []Mention{{Username: "bob_1", Start: 0, End: 6}}},
+		{"multibyte offsets", "你好 @alice，再见 @carol", []Mention{{Username: "alice", Start: 3, End: 9}, {Username: "carol", Start: 13, End: 19}}},
+		{"email is not a mention", "mail foo@example.com", nil},
+		{"too short", "@ab @", nil},
+		{"too long", "@abcdefghijklmnopqrstu", nil},
+		{"double at", "@@alice", nil},
+		{"repeated", "@alice @alice", []Mention{{Username: "alice", Start: 0, End: 6}, {Username: "alice", Start: 7, End: 13}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Parse(tt.content))
+		})
+	}
+}
+
+func TestUsernames(t *testing.T) {
+	got := Usernames(Parse("@bob @alice @bob"))
+	assert.Equal(t, []string{"bob", "alice"}, got)
+}
@@ -0,0 +1,93 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+// Package period 提供统计分析接口使用的日期范围解析和统计周期划分函数.
+// 所有日期都使用服务器本地时区，和数据库中 DATE(createdAt) 的结果一致.
+package period
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// GranularityDay 表示按天统计.
+	GranularityDay = "day"
+	// GranularityWeek 表示按周统计，每周从周一开始.
+	GranularityWeek = "week"
+
+	// DefaultRangeDays 为未指定开始日期时查询的天数.
+	DefaultRangeDays = 30
+	// MaxRangeDays 为一次最多可以查询的天数.
+	MaxRangeDays = 366
+)
+
+// Range 表示一个包含首尾两天的日期范围.
+type Range struct {
+	From time.Time
+	To   time.Time
+}
+
+// Day 返回 t 所在日期的零点.
+func Day(t time.Time) time.Time {
+	t = t.In(time.Local)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
+
+// ParseRange 解析格式为 2006-01-02 的开始日期和结束日期. 结束日期为空时使用 now 所在的日期，
+// 开始日期为空时使用结束日期之前的第 DefaultRangeDays-1 天.
+func ParseRange(start string, end string, now time.Time) (Range, error) {
+	var r Range
+
+	r.To = Day(now)
+	if end != "" {
+		to, err := time.ParseInLocation(time.DateOnly, end, time.Local)
+		if err != nil {
+			return Range{}, fmt.Errorf("endDate must be a date in the format 2006-01-02")
+		}
+		r.To = to
+	}
+
+	r.From = r.To.AddDate(0, 0, -(DefaultRangeDays - 1))
+	if start != "" {
+		from, err := time.ParseInLocation(time.DateOnly, start, time.Local)
+		if err != nil {
+			return Range{}, fmt.Errorf("startDate must be a date in the format 2006-01-02")
+		}
+		r.From = from
+	}
+
+	if r.From.After(r.To) {
+		return Range{}, fmt.Errorf("startDate must not be after endDate")
+	}
+	if r.Days() > MaxRangeDays {
+		return Range{}, fmt.Errorf("the date range cannot exceed %d days", MaxRangeDays)
+	}
+	return r, nil
+}
+
+// Days 返回日期范围包含的天数.
+func (r Range) Days() int {
+	// 按日历日期计算，避免夏令时切换导致某天不是 24 小时
+	from := time.Date(r.From.Year(), r.From.Month(), r.From.Day(), 0, 0, 0, 0, time.UTC)
+	to := time.Date(r.To.Year(), r.To.Month(), r.To.Day(), 0, 0, 0, 0, time.UTC)
+	return int(to.Sub(from).Hours()/24) + 1
+}
+
+// ValidGranularity 判断统计周期是否合法，空字符串表示使用默认的按天统计.
+func ValidGranularity(granularity string) bool {
+	return granularity == "" || granularity == GranularityDay || granularity == GranularityWeek
+}
+
+// Bucket 返回 day 所在统计周期的第一天. 按周统计时返回所在周的周一.
+func Bucket(day time.Time, granularity string) time.Time {
+	day = Day(day)
+	if granularity != GranularityWeek {
+		return day
+	}
+
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
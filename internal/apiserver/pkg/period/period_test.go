@@ -0,0 +1,68 @@
+package period
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func date(s string) time.Time {
+	t, _ := time.ParseInLocation(time.DateOnly, s, time.Local)
+	return t
+}
+
+func TestParseRange(t *testing.T) {
+	now := time.Date(2024, 3, 15, 18, 30, 0, 0, time.Local)
+
+	tests := []struct {
+		name    string
+		start   string
+		end     string
+		want    Range
+		wantErr bool
+	}{
+		{"defaults", "", "", Range{From: date("2024-02-15"), To: date("2024-03-15")}, false},
+		{"end only", "", "2024-01-31", Range{From: date("2024-01-02"), To: date("2024-01-31")}, false},
+		{"explicit", "2024-03-01", "2024-03-01", Range{From: date("2024-03-01"), To: date("2024-03-01")}, false},
+		{"invalid start", "2024/03/01", "", Range{}, true},
+		{"invalid end", "", "yesterday", Range{}, true},
+		{"reversed", "2024-03-10", "2024-03-01", Range{}, true},
+		{"too long", "2023-01-01", "2024-03-01", Range{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRange(tt.start, tt.end, now)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, tt.want.From.Equal(got.From), "from: %v", got.From)
+			assert.True(t, tt.want.To.Equal(got.To), "to: %v", got.To)
+		})
+	}
+}
+
+func TestRange_Days(t *testing.T) {
+	assert.Equal(t, 1, Range{From: date("2024-03-01"), To: date("2024-03-01")}.Days())
+	assert.Equal(t, 366, Range{From: date("2024-01-01"), To: date("2024-12-31")}.Days())
+}
+
+func TestBucket(t *testing.T) {
+	// 2024-03-13 是周三
+	wednesday := time.Date(2024, 3, 13, 23, 59, 0, 0, time.Local)
+	assert.True(t, date("2024-03-13").Equal(Bucket(wednesday, GranularityDay)))
+	assert.True(t, date("2024-03-13").Equal(Bucket(wednesday, "")))
+	assert.True(t, date("2024-03-11").Equal(Bucket(wednesday, GranularityWeek)))
+	assert.True(t, date("2024-03-11").Equal(Bucket(date("2024-03-11"), GranularityWeek)))
+	assert.True(t, date("2024-03-11").Equal(Bucket(date("2024-03-17"), GranularityWeek)))
+}
+
+func TestValidGranularity(t *testing.T) {
+	assert.True(t, ValidGranularity(""))
+	assert.True(t, ValidGranularity(GranularityDay))
+	assert.True(t, ValidGranularity(GranularityWeek))
+	assert.False(t, ValidGranularity("month"))
+}
@@ -0,0 +1,80 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+// Package placeholder 渲染博客模板中的 {{name}} 占位符.
+//
+// 占位符名称以字母开头，由字母、数字和下划线组成，花括号内可以有空格，例如 {{ date }}.
+// 内置占位符由 Builtins 提供，调用方也可以传入自定义的占位符.
+package placeholder
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+var (
+	// placeholderRe 匹配模板中的占位符.
+	placeholderRe = regexp.MustCompile(`\{\{\s*([A-Za-z][A-Za-z0-9_]*)\s*\}\}`)
+	// nameRe 匹配合法的占位符名称.
+	nameRe = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+)
+
+// ValidName 判断 name 是否为合法的占位符名称.
+func ValidName(name string) bool {
+	return nameRe.MatchString(name)
+}
+
+// Builtins 返回 now 时刻的内置占位符:
+//   - date: 日期，例如 2024-03-14
+//   - time: 时间，例如 09:30
+//   - year、month、day: 年、月、日，月和日为两位数字
+//   - weekday: 星期几的英文名称，例如 Thursday
+//   - week: ISO 8601 周，例如 2024-W11
+//   - weekNumber: ISO 8601 周数，例如 11
+//   - weekStart、weekEnd: 所在周的周一和周日的日期
+//   - username: 当前用户的用户名
+func Builtins(now time.Time, username string) map[string]string {
+	isoYear, isoWeek := now.ISOWeek()
+	// 周一为一周的第一天
+	weekStart := now.AddDate(0, 0, -(int(now.Weekday())+6)%7)
+
+	return map[string]string{
+		"date":       now.Format(time.DateOnly),
+		"time":       now.Format("15:04"),
+		"year":       strconv.Itoa(now.Year()),
+		"month":      fmt.Sprintf("%02d", int(now.Month())),
+		"day":        fmt.Sprintf("%02d", now.Day()),
+		"weekday":    now.Weekday().String(),
+		"week":       fmt.Sprintf("%d-W%02d", isoYear, isoWeek),
+		"weekNumber": strconv.Itoa(isoWeek),
+		"weekStart":  weekStart.Format(time.DateOnly),
+		"weekEnd":    weekStart.AddDate(0, 0, 6).Format(time.DateOnly),
+		"username":   username,
+	}
+}
+
+// Render 使用 vars 替换 text 中的占位符，返回替换后的文本和 vars 中未定义的占位符名称（已排序、去重）.
+// 未定义的占位符会原样保留在返回的文本中.
+func Render(text string, vars map[string]string) (string, []string) {
+	missing := make(map[string]struct{})
+	rendered := placeholderRe.ReplaceAllStringFunc(text, func(m string) string {
+		name := placeholderRe.FindStringSubmatch(m)[1]
+		if value, ok := vars[name]; ok {
+			return value
+		}
+		missing[name] = struct{}{}
+		return m
+	})
+
+	names := make([]string, 0, len(missing))
+	for name := range missing {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return rendered, names
+}
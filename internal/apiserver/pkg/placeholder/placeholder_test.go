@@ -0,0 +1,42 @@
+package placeholder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuiltins(t *testing.T) {
+	// 2024-12-31 是周二，属于 ISO 周 2025-W01
+	vars := Builtins(time.Date(2024, 12, 31, 9, 5, 0, 0, time.Local), "colin")
+
+	assert.Equal(t, "2024-12-31", vars["date"])
+	assert.Equal(t, "09:05", vars["time"])
+	assert.Equal(t, "2024", vars["year"])
+	assert.Equal(t, "12", vars["month"])
+	assert.Equal(t, "31", vars["day"])
+	assert.Equal(t, "Tuesday", vars["weekday"])
+	assert.Equal(t, "2025-W01", vars["week"])
+	assert.Equal(t, "1", vars["weekNumber"])
+	assert.Equal(t, "2024-12-30", vars["weekStart"])
+	assert.Equal(t, "2025-01-05", vars["weekEnd"])
+	assert.Equal(t, "colin", vars["username"])
+
+	// 周日属于上一个周一开始的周
+	vars = Builtins(time.Date(2024, 3, 17, 0, 0, 0, 0, time.Local), "")
+	assert.Equal(t, "2024-03-11", vars["weekStart"])
+	assert.Equal(t, "2024-03-17", vars["weekEnd"])
+}
+
+func TestRender(t *testing.T) {
+	vars := map[string]string{"date": "2024-03-14", "week": "2024-W11"}
+
+	got, missing := Render("周报 {{week}}（{{ date }}）", vars)
+	assert.Equal(t, "周报 2024-W11（2024-03-14）", got)
+	assert.Empty(t, missing)
+
+	got, missing = Render("{{project}} {{date}} {{owner}} {{project}} {{ }} {not}", vars)
+	assert.Equal(t, "{{project}} 2024-03-14 {{owner}} {{project}} {{ }} {not}", got)
+	assert.Equal(t, []string{"owner", "project"}, missing)
+}
@@ -0,0 +1,228 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+// Package related 实现进程内的相关博客推荐索引.
+// 相关度由标题和内容的 TF-IDF 余弦相似度、是否同一作者以及发布时间的新旧程度共同决定.
+// 索引完全保存在内存中，随博客的创建、更新和删除增量维护.
+package related
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// titleWeight 为标题中词项的权重，标题中的词项比内容中的词项更能代表博客主题.
+	titleWeight = 3
+	// authorBoost 为同一作者的候选博客增加的分数.
+	authorBoost = 0.15
+	// recencyBoost 为最新发布的候选博客增加的最高分数，随发布时间按半衰期衰减.
+	recencyBoost = 0.1
+	// recencyHalfLife 为新旧程度分数的半衰期.
+	recencyHalfLife = 30 * 24 * time.Hour
+)
+
+// Document 表示被索引的博客.
+type Document struct {
+	PostID    string
+	UserID    string
+	Title     string
+	Content   string
+	CreatedAt time.Time
+}
+
+// Result 表示一个相关博客及其分数.
+type Result struct {
+	PostID string
+	Score  float64
+}
+
+// document 为索引中保存的博客，terms 为词项及其出现次数（已按标题权重加权）.
+type document struct {
+	userID    string
+	createdAt time.Time
+	terms     map[string]float64
+}
+
+// Index 为相关博客索引，可以被多个 goroutine 并发使用.
+type Index struct {
+	mu   sync.RWMutex
+	docs map[string]*document
+	// postings 为倒排索引，键为词项，值为包含该词项的博客 ID 集合.
+	postings map[string]map[string]struct{}
+	// byAuthor 保存每个作者的博客 ID 集合.
+	byAuthor map[string]map[string]struct{}
+	// now 返回当前时间，测试时可以替换.
+	now func() time.Time
+}
+
+// NewIndex 创建一个空的相关博客索引.
+func NewIndex() *Index {
+	return &Index{
+		docs:     make(map[string]*document),
+		postings: make(map[string]map[string]struct{}),
+		byAuthor: make(map[string]map[string]struct{}),
+		now:      time.Now,
+	}
+}
+
+// Len 返回索引中的博客数量.
+func (idx *Index) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.docs)
+}
+
+// Put 添加或更新索引中的博客.
+func (idx *Index) Put(doc Document) {
+	terms := make(map[string]float64)
+	for _, term := range Tokenize(doc.Title) {
+		terms[term] += titleWeight
+	}
+	for _, term := range Tokenize(doc.Content) {
+		terms[term]++
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.remove(doc.PostID)
+	idx.docs[doc.PostID] = &document{userID: doc.UserID, createdAt: doc.CreatedAt, terms: terms}
+	for term := range terms {
+		addTo(idx.postings, term, doc.PostID)
+	}
+	addTo(idx.byAuthor, doc.UserID, doc.PostID)
+}
+
+// Remove 从索引中删除博客.
+func (idx *Index) Remove(postIDs ...string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	for _, postID := range postIDs {
+		idx.remove(postID)
+	}
+}
+
+// Related 返回与指定博客最相关的至多 limit 篇博客，按分数从高到低排列. 博客不在索引中时返回 nil.
+func (idx *Index) Related(postID string, limit int) []Result {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	target, ok := idx.docs[postID]
+	if !ok || limit <= 0 {
+		return nil
+	}
+
+	// 候选博客为和目标博客至少有一个相同词项的博客，以及同一作者的博客
+	weights := idx.weights(target)
+	dots := make(map[string]float64)
+	for term, w := range weights {
+		idf := idx.idf(term)
+		for candidate := range idx.postings[term] {
+			dots[candidate] += w * tf(idx.docs[candidate].terms[term]) * idf
+		}
+	}
+	for candidate := range idx.byAuthor[target.userID] {
+		if _, ok := dots[candidate]; !ok {
+			dots[candidate] = 0
+		}
+	}
+	delete(dots, postID)
+
+	targetNorm := norm(weights)
+	now := idx.now()
+	results := make([]Result, 0, len(dots))
+	for candidate, dot := range dots {
+		doc := idx.docs[candidate]
+
+		var score float64
+		if dot > 0 && targetNorm > 0 {
+			score = dot / (targetNorm * norm(idx.weights(doc)))
+		}
+		if doc.userID == target.userID {
+			score += authorBoost
+		}
+		if age := now.Sub(doc.createdAt); age > 0 {
+			score += recencyBoost * math.Pow(0.5, float64(age)/float64(recencyHalfLife))
+		} else {
+			score += recencyBoost
+		}
+
+		results = append(results, Result{PostID: candidate, Score: score})
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].PostID < results[j].PostID
+	})
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
+
+// remove 删除博客及其倒排索引，调用方需要持有写锁.
+func (idx *Index) remove(postID string) {
+	doc, ok := idx.docs[postID]
+	if !ok {
+		return
+	}
+
+	for term := range doc.terms {
+		removeFrom(idx.postings, term, postID)
+	}
+	removeFrom(idx.byAuthor, doc.userID, postID)
+	delete(idx.docs, postID)
+}
+
+// weights 返回博客中每个词项的 TF-IDF 权重.
+func (idx *Index) weights(doc *document) map[string]float64 {
+	ret := make(map[string]float64, len(doc.terms))
+	for term, count := range doc.terms {
+		ret[term] = tf(count) * idx.idf(term)
+	}
+	return ret
+}
+
+// idf 返回词项的逆文档频率. 语料库随博客的增删变化，因此在查询时计算.
+func (idx *Index) idf(term string) float64 {
+	return math.Log(1 + float64(len(idx.docs))/float64(1+len(idx.postings[term])))
+}
+
+// tf 返回词项频率，使用对数缩放避免长文中高频词项的权重过大.
+func tf(count float64) float64 {
+	if count <= 0 {
+		return 0
+	}
+	return 1 + math.Log(count)
+}
+
+// norm 返回向量的模.
+func norm(weights map[string]float64) float64 {
+	var sum float64
+	for _, w := range weights {
+		sum += w * w
+	}
+	return math.Sqrt(sum)
+}
+
+func addTo(m map[string]map[string]struct{}, key string, postID string) {
+	if m[key] == nil {
+		m[key] = make(map[string]struct{})
+	}
+	m[key][postID] = struct{}{}
+}
+
+func removeFrom(m map[string]map[string]struct{}, key string, postID string) {
+	delete(m[key], postID)
+	if len(m[key]) == 0 {
+		delete(m, key)
+	}
+}
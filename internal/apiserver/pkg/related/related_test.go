@@ -0,0 +1,82 @@
+package related
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenize(t *testing.T) {
+	assert.Equal(t, []string{"go", "generics", "tutorial"}, Tokenize("Go Generics: a tutorial"))
+	assert.Empty(t, Tokenize(""))
+	assert.Equal(t, []string{"你好", "好世", "世界", "go"}, Tokenize("你好世界 Go"))
+	assert.Equal(t, []string{"中"}, Tokenize("中"))
+}
+
+func newTestIndex(now time.Time) *Index {
+	idx := NewIndex()
+	idx.now = func() time.Time { return now }
+	return idx
+}
+
+func ids(results []Result) []string {
+	ret := make([]string, 0, len(results))
+	for _, r := range results {
+		ret = append(ret, r.PostID)
+	}
+	return ret
+}
+
+func TestIndex_Related(t *testing.T) {
+	now := time.Date(2024, 12, 12, 0, 0, 0, 0, time.UTC)
+	idx := newTestIndex(now)
+
+	idx.Put(Document{PostID: "post-1", UserID: "user-1", Title: "Go generics", Content: "type parameters in go", CreatedAt: now})
+	idx.Put(Document{PostID: "post-2", UserID: "user-2", Title: "Generics in Go 1.18", Content: "constraints and type parameters", CreatedAt: now})
+	idx.Put(Document{PostID: "post-3", UserID: "user-2", Title: "Cooking pasta", Content: "boil water, add salt", CreatedAt: now})
+	idx.Put(Document{PostID: "post-4", UserID: "user-1", Title: "Travel notes", Content: "a week in the mountains", CreatedAt: now})
+
+	// 共享词项最多的博客排在最前面，同一作者但内容无关的博客也会作为候选
+	got := ids(idx.Related("post-1", 10))
+	assert.Equal(t, []string{"post-2", "post-4"}, got)
+
+	// 博客不存在时返回 nil
+	assert.Nil(t, idx.Related("post-404", 10))
+
+	// 数量限制
+	assert.Len(t, idx.Related("post-1", 1), 1)
+}
+
+func TestIndex_Recency(t *testing.T) {
+	now := time.Date(2024, 12, 12, 0, 0, 0, 0, time.UTC)
+	idx := newTestIndex(now)
+
+	idx.Put(Document{PostID: "post-1", UserID: "user-1", Title: "kubernetes operators", CreatedAt: now})
+	idx.Put(Document{PostID: "post-old", UserID: "user-2", Title: "kubernetes operators", CreatedAt: now.Add(-365 * 24 * time.Hour)})
+	idx.Put(Document{PostID: "post-new", UserID: "user-3", Title: "kubernetes operators", CreatedAt: now.Add(-time.Hour)})
+
+	assert.Equal(t, []string{"post-new", "post-old"}, ids(idx.Related("post-1", 10)))
+}
+
+func TestIndex_Incremental(t *testing.T) {
+	now := time.Date(2024, 12, 12, 0, 0, 0, 0, time.UTC)
+	idx := newTestIndex(now)
+
+	idx.Put(Document{PostID: "post-1", UserID: "user-1", Title: "rust ownership", CreatedAt: now})
+	idx.Put(Document{PostID: "post-2", UserID: "user-2", Title: "rust borrowing", CreatedAt: now})
+	require.Equal(t, []string{"post-2"}, ids(idx.Related("post-1", 10)))
+
+	// 更新后不再有相同词项
+	idx.Put(Document{PostID: "post-2", UserID: "user-2", Title: "gardening tips", CreatedAt: now})
+	assert.Empty(t, idx.Related("post-1", 10))
+
+	idx.Put(Document{PostID: "post-3", UserID: "user-3", Title: "rust lifetimes", CreatedAt: now})
+	assert.Equal(t, []string{"post-3"}, ids(idx.Related("post-1", 10)))
+
+	idx.Remove("post-3")
+	assert.Empty(t, idx.Related("post-1", 10))
+	assert.Equal(t, 2, idx.Len())
+	assert.Empty(t, idx.postings["rust"]["post-3"])
+}
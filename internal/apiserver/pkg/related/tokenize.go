@@ -0,0 +1,68 @@
+package related
+
+import (
+	"strings"
+	"unicode"
+)
+
+// stopwords 为常见的英文停用词，这些词项几乎出现在所有博客中，对相关度没有帮助.
+var stopwords = map[string]struct{}{
+	"a": {}, "an": {}, "and": {}, "are": {}, "as": {}, "at": {}, "be": {}, "but": {}, "by": {},
+	"for": {}, "from": {}, "has": {}, "have": {}, "in": {}, "is": {}, "it": {}, "its": {},
+	"of": {}, "on": {}, "or": {}, "that": {}, "the": {}, "this": {}, "to": {}, "was": {},
+	"were": {}, "will": {}, "with": {}, "you": {}, "your": {}, "we": {}, "our": {}, "not": {},
+}
+
+// Tokenize 将文本切分为词项.
+// 拉丁字母和数字按单词切分并转换为小写，忽略单个字符和停用词；
+// 中日韩文字没有空格分隔，按相邻两个字符（bigram）切分，单独出现的一个字符作为一个词项.
+func Tokenize(text string) []string {
+	var (
+		terms []string
+		word  strings.Builder
+		cjk   []rune
+	)
+
+	flushWord := func() {
+		if word.Len() > 1 {
+			w := word.String()
+			if _, ok := stopwords[w]; !ok {
+				terms = append(terms, w)
+			}
+		}
+		word.Reset()
+	}
+	flushCJK := func() {
+		if len(cjk) == 1 {
+			terms = append(terms, string(cjk))
+		}
+		for i := 0; i+1 < len(cjk); i++ {
+			terms = append(terms, string(cjk[i:i+2]))
+		}
+		cjk = cjk[:0]
+	}
+
+	for _, r := range text {
+		switch {
+		case isCJK(r):
+			flushWord()
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushCJK()
+			word.WriteRune(unicode.ToLower(r))
+		default:
+			flushWord()
+			flushCJK()
+		}
+	}
+	flushWord()
+	flushCJK()
+
+	return terms
+}
+
+// isCJK 判断字符是否为中日韩文字.
+func isCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hiragana, r) ||
+		unicode.Is(unicode.Katakana, r) || unicode.Is(unicode.Hangul, r)
+}
@@ -0,0 +1,64 @@
+package revocation
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+)
+
+// HashAccessToken 返回个人访问令牌的 SHA-256 摘要（十六进制），数据库中只保存令牌的摘要.
+func HashAccessToken(accessToken string) string {
+	sum := sha256.Sum256([]byte(accessToken))
+	return hex.EncodeToString(sum[:])
+}
+
+// CheckAccessToken 检查个人访问令牌是否有效，并按 touchInterval 更新令牌的最后使用时间.
+// 令牌不存在、已过期或已被撤销时返回 errno.ErrAccessTokenInvalid.
+func (l *List) CheckAccessToken(ctx context.Context, accessToken string) (*model.AccessTokenM, error) {
+	tokenHash := HashAccessToken(accessToken)
+	if tokenM, ok := l.accessTokens.Get(tokenHash); ok {
+		return tokenM, nil
+	}
+
+	tokenM, err := l.store.AccessToken().Get(ctx, where.F("tokenHash", tokenHash))
+	if err != nil {
+		if errors.Is(err, errno.ErrAccessTokenNotFound) {
+			return nil, errno.ErrAccessTokenInvalid
+		}
+		return nil, err
+	}
+
+	now := l.now()
+	if !now.Before(tokenM.ExpiresAt) {
+		return nil, errno.ErrAccessTokenInvalid
+	}
+	if tokenM.LastUsedAt == nil || now.Sub(*tokenM.LastUsedAt) >= touchInterval {
+		if err := l.store.AccessToken().Touch(ctx, tokenM.TokenID, now); err != nil {
+			return nil, err
+		}
+		tokenM.LastUsedAt = &now
+	}
+
+	// 缓存时间不超过令牌的过期时间
+	expiresAt := now.Add(negativeTTL)
+	if tokenM.ExpiresAt.Before(expiresAt) {
+		expiresAt = tokenM.ExpiresAt
+	}
+	l.accessTokens.Add(tokenHash, tokenM, expiresAt)
+
+	return tokenM, nil
+}
+
+// ForgetAccessTokens 清除个人访问令牌的缓存，调用方删除令牌后调用，使令牌立即失效.
+// 参数为令牌的摘要.
+func (l *List) ForgetAccessTokens(tokenHashes ...string) {
+	for _, tokenHash := range tokenHashes {
+		l.accessTokens.Remove(tokenHash)
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+// Package revocation 维护访问令牌的撤销列表. 撤销记录保存在数据库中，随令牌一起过期，
+// 进程内使用 LRU 缓存查询结果，避免每个请求都查询数据库.
+// 访问令牌属于某个登录会话时，会话被删除后该会话签发的令牌同样失效. 个人访问令牌没有撤销记录，删除后即失效.
+package revocation
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/wire"
+	"github.com/ra1n6ow/gpkg/store/where"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/store"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/lru"
+	"github.com/ra1n6ow/miniblog/pkg/token"
+)
+
+const (
+	// cacheSize 为缓存的令牌数量.
+	cacheSize = 10000
+	// negativeTTL 为"未撤销"查询结果的缓存时间. 多实例部署时，其他实例撤销的令牌最多在该时间后生效.
+	negativeTTL = 30 * time.Second
+	// sessionTTL 为有效会话的缓存时间. 删除会话（退出登录、修改密码等）需要在所有实例上及时生效，
+	// 因此只缓存很短的时间，主要用于合并同一会话的并发请求，其他实例删除的会话最多在该时间后生效.
+	sessionTTL = time.Second
+	// touchInterval 为更新会话最后使用时间的最小间隔，避免每个请求都写数据库.
+	touchInterval = time.Minute
+)
+
+// ProviderSet 是一个 Wire 的 Provider 集合，用于声明依赖注入的规则.
+var ProviderSet = wire.NewSet(New)
+
+// List 为访问令牌的撤销列表，可以被多个 goroutine 并发使用.
+type List struct {
+	store store.IStore
+	// cache 缓存令牌是否已被撤销，key 为令牌的唯一标识.
+	cache *lru.Cache[string, bool]
+	// sessions 缓存仍然有效的登录会话，key 为会话 ID.
+	sessions *lru.Cache[string, struct{}]
+	// accessTokens 缓存仍然有效的个人访问令牌，key 为令牌的摘要.
+	accessTokens *lru.Cache[string, *model.AccessTokenM]
+	now          func() time.Time
+}
+
+// New 创建撤销列表.
+func New(store store.IStore) *List {
+	return &List{
+		store:        store,
+		cache:        lru.New[string, bool](cacheSize),
+		sessions:     lru.New[string, struct{}](cacheSize),
+		accessTokens: lru.New[string, *model.AccessTokenM](cacheSize),
+		now:          time.Now,
+	}
+}
+
+// Revoke 撤销 tokenID 对应的访问令牌，撤销记录在令牌过期后失效. 同时清理已经过期的撤销记录.
+func (l *List) Revoke(ctx context.Context, userID string, tokenID string, expiresAt time.Time) error {
+	if err := l.store.RevokedToken().Delete(ctx, where.NewWhere().Q("expiresAt < ?", l.now())); err != nil {
+		return err
+	}
+
+	obj := &model.RevokedTokenM{TokenID: tokenID, UserID: userID, ExpiresAt: expiresAt}
+	if err := l.store.RevokedToken().Create(ctx, obj); err != nil {
+		return err
+	}
+
+	l.cache.Add(tokenID, true, expiresAt)
+	return nil
+}
+
+// ForgetSessions 清除会话的缓存，调用方删除会话后调用，使会话签发的令牌立即失效.
+func (l *List) ForgetSessions(sessionIDs ...string) {
+	for _, id := range sessionIDs {
+		l.sessions.Remove(id)
+	}
+}
+
+// CheckToken 检查访问令牌是否已被撤销. 令牌在用户的撤销时间之前签发，或者在撤销列表中时返回 errno.ErrTokenRevoked，
+// 令牌所属的会话已被删除时返回 errno.ErrSessionRevoked.
+func (l *List) CheckToken(ctx context.Context, claims *token.Claims, user *model.UserM) error {
+	if user.TokensRevokedAt != nil && !claims.IssuedAt.After(*user.TokensRevokedAt) {
+		return errno.ErrTokenRevoked
+	}
+
+	if claims.SessionID != "" {
+		if err := l.checkSession(ctx, claims.SessionID, user.UserID); err != nil {
+			return err
+		}
+	}
+
+	// 旧版本签发的令牌没有唯一标识，无法单独撤销
+	if claims.ID == "" {
+		return nil
+	}
+
+	revoked, err := l.isRevoked(ctx, claims)
+	if err != nil {
+		return err
+	}
+	if revoked {
+		return errno.ErrTokenRevoked
+	}
+	return nil
+}
+
+// checkSession 检查会话是否仍然有效，并按 touchInterval 更新会话的最后使用时间.
+func (l *List) checkSession(ctx context.Context, sessionID string, userID string) error {
+	if _, ok := l.sessions.Get(sessionID); ok {
+		return nil
+	}
+
+	sessionM, err := l.store.Session().Get(ctx, where.F("sessionID", sessionID, "userID", userID))
+	if err != nil {
+		if errors.Is(err, errno.ErrSessionNotFound) {
+			return errno.ErrSessionRevoked
+		}
+		return err
+	}
+
+	if now := l.now(); now.Sub(sessionM.LastSeenAt) >= touchInterval {
+		if err := l.store.Session().Touch(ctx, sessionID, now); err != nil {
+			return err
+		}
+	}
+
+	l.sessions.Add(sessionID, struct{}{}, l.now().Add(sessionTTL))
+	return nil
+}
+
+// isRevoked 报告令牌是否在撤销列表中，优先使用缓存中的结果.
+func (l *List) isRevoked(ctx context.Context, claims *token.Claims) (bool, error) {
+	if revoked, ok := l.cache.Get(claims.ID); ok {
+		return revoked, nil
+	}
+
+	revoked, err := l.store.RevokedToken().Exists(ctx, claims.ID)
+	if err != nil {
+		return false, err
+	}
+
+	// 已撤销的结果一直缓存到令牌过期，未撤销的结果只缓存一小段时间
+	expiresAt := claims.ExpiresAt
+	if !revoked {
+		expiresAt = l.now().Add(negativeTTL)
+		if !claims.ExpiresAt.IsZero() && claims.ExpiresAt.Before(expiresAt) {
+			expiresAt = claims.ExpiresAt
+		}
+	}
+	l.cache.Add(claims.ID, revoked, expiresAt)
+
+	return revoked, nil
+}
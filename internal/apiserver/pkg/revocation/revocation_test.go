@@ -0,0 +1,284 @@
+package revocation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/ra1n6ow/gpkg/store/where"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/store"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/pkg/token"
+)
+
+// newTestStore 创建使用内存 SQLite 数据库的 store. 同一个测试进程中的所有测试共享一个数据库，测试数据需要使用不同的 ID.
+func newTestStore(t *testing.T) store.IStore {
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&model.RevokedTokenM{}, &model.UserSessionM{}, &model.AccessTokenM{}))
+	return store.NewStore(db)
+}
+
+// newClaims 返回一个 1 小时后过期的访问令牌声明. 和解析出的令牌一样，签发时间只精确到毫秒.
+func newClaims(tokenID string, issuedAt time.Time) *token.Claims {
+	return &token.Claims{Identity: "user-1", ID: tokenID, IssuedAt: issuedAt.Truncate(time.Millisecond), ExpiresAt: issuedAt.Add(time.Hour)}
+}
+
+func TestCheckTokenRevokedAt(t *testing.T) {
+	issuedAt := time.Unix(1700000000, 0)
+	now := time.Now().Truncate(time.Millisecond)
+
+	tests := []struct {
+		name      string
+		issuedAt  time.Time
+		revokedAt *time.Time
+		want      error
+	}{
+		{"never revoked", issuedAt, nil, nil},
+		{"issued before revocation", issuedAt, ptr(issuedAt.Add(1500 * time.Millisecond)), errno.ErrTokenRevoked},
+		{"issued earlier in the revocation second", issuedAt.Add(100 * time.Millisecond), ptr(issuedAt.Add(900 * time.Millisecond)), errno.ErrTokenRevoked},
+		// 修改密码后立即登录签发的令牌不会被拒绝
+		{"issued later in the revocation second", issuedAt.Add(900 * time.Millisecond), ptr(issuedAt.Add(100 * time.Millisecond)), nil},
+		{"issued after revocation", issuedAt, ptr(issuedAt.Add(-100 * time.Millisecond)), nil},
+		{"issued in the revocation millisecond", now, ptr(now), errno.ErrTokenRevoked},
+		{"issued a millisecond after revocation", now.Add(time.Millisecond), ptr(now), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// 令牌没有唯一标识和会话时不需要查询数据库
+			claims := newClaims("", tt.issuedAt)
+			err := New(nil).CheckToken(context.Background(), claims, &model.UserM{UserID: "user-1", TokensRevokedAt: tt.revokedAt})
+			assert.Equal(t, tt.want, err)
+		})
+	}
+}
+
+func TestCheckTokenRevoked(t *testing.T) {
+	ds := newTestStore(t)
+	ctx := context.Background()
+	user := &model.UserM{UserID: "user-1"}
+
+	tests := []struct {
+		name    string
+		tokenID string
+		revoke  bool
+		want    error
+	}{
+		{"not revoked", "jti-revoked-1", false, nil},
+		{"logged out", "jti-revoked-2", true, errno.ErrTokenRevoked},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := New(ds)
+			// 令牌签发后立即退出登录，同一秒内的请求也会被拒绝
+			claims := newClaims(tt.tokenID, time.Now())
+			if tt.revoke {
+				require.NoError(t, l.Revoke(ctx, user.UserID, tt.tokenID, claims.ExpiresAt))
+			}
+			assert.Equal(t, tt.want, l.CheckToken(ctx, claims, user))
+
+			// 其他实例从数据库中查询撤销记录
+			assert.Equal(t, tt.want, New(ds).CheckToken(ctx, claims, user))
+		})
+	}
+}
+
+func TestCheckTokenNegativeCache(t *testing.T) {
+	ds := newTestStore(t)
+	ctx := context.Background()
+	user := &model.UserM{UserID: "user-1"}
+
+	tests := []struct {
+		name string
+		// cachedAgo 为其他实例缓存"未撤销"结果的时间距今多久.
+		cachedAgo time.Duration
+		want      error
+	}{
+		{"cached just now", 0, nil},
+		{"cached within the window", negativeTTL - time.Second, nil},
+		{"cached at the end of the window", negativeTTL, errno.ErrTokenRevoked},
+		{"cached before the window", 2 * negativeTTL, errno.ErrTokenRevoked},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokenID := "jti-negative-" + string(rune('a'+i))
+			claims := newClaims(tokenID, time.Now())
+
+			other := New(ds)
+			other.now = func() time.Time { return time.Now().Add(-tt.cachedAgo) }
+			require.NoError(t, other.CheckToken(ctx, claims, user))
+
+			// 本实例撤销令牌后立即生效，其他实例最多在 negativeTTL 后生效
+			l := New(ds)
+			require.NoError(t, l.Revoke(ctx, user.UserID, tokenID, claims.ExpiresAt))
+			assert.Equal(t, errno.ErrTokenRevoked, l.CheckToken(ctx, claims, user))
+			assert.Equal(t, tt.want, other.CheckToken(ctx, claims, user))
+		})
+	}
+}
+
+func TestCheckSession(t *testing.T) {
+	ds := newTestStore(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		session *model.UserSessionM
+		userID  string
+		want    error
+		touched bool
+	}{
+		{"recently used", &model.UserSessionM{SessionID: "session-1", UserID: "user-1", LastSeenAt: now.Add(-10 * time.Second)}, "user-1", nil, false},
+		{"idle", &model.UserSessionM{SessionID: "session-2", UserID: "user-1", LastSeenAt: now.Add(-2 * touchInterval)}, "user-1", nil, true},
+		{"deleted", nil, "user-1", errno.ErrSessionRevoked, false},
+		{"other user", &model.UserSessionM{SessionID: "session-4", UserID: "user-2", LastSeenAt: now}, "user-1", errno.ErrSessionRevoked, false},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessionID := "session-" + string(rune('1'+i))
+			if tt.session != nil {
+				require.NoError(t, ds.Session().Create(ctx, tt.session))
+			}
+
+			l := New(ds)
+			claims := newClaims("", now)
+			claims.SessionID = sessionID
+			user := &model.UserM{UserID: tt.userID}
+			assert.Equal(t, tt.want, l.CheckToken(ctx, claims, user))
+			if tt.session == nil || tt.want != nil {
+				return
+			}
+
+			sessionM, err := ds.Session().Get(ctx, where.F("sessionID", sessionID))
+			require.NoError(t, err)
+			if tt.touched {
+				assert.WithinDuration(t, time.Now(), sessionM.LastSeenAt, time.Second)
+			} else {
+				assert.WithinDuration(t, tt.session.LastSeenAt, sessionM.LastSeenAt, time.Second)
+			}
+
+			// 有效的会话被缓存，删除会话后需要清除缓存才能立即生效
+			require.NoError(t, ds.Session().Delete(ctx, where.F("sessionID", sessionID)))
+			assert.NoError(t, l.CheckToken(ctx, claims, user))
+			l.ForgetSessions(sessionID)
+			assert.Equal(t, errno.ErrSessionRevoked, l.CheckToken(ctx, claims, user))
+		})
+	}
+}
+
+func TestCheckSessionDeletedByOtherInstance(t *testing.T) {
+	ds := newTestStore(t)
+	ctx := context.Background()
+	user := &model.UserM{UserID: "user-1"}
+
+	tests := []struct {
+		name string
+		// cachedAgo 为其他实例缓存会话的时间距今多久.
+		cachedAgo time.Duration
+		want      error
+	}{
+		{"cached just now", 0, nil},
+		{"cached at the end of the window", sessionTTL, errno.ErrSessionRevoked},
+		{"cached before the window", negativeTTL, errno.ErrSessionRevoked},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessionID := "session-other-" + string(rune('a'+i))
+			require.NoError(t, ds.Session().Create(ctx, &model.UserSessionM{SessionID: sessionID, UserID: user.UserID, LastSeenAt: time.Now()}))
+			claims := newClaims("", time.Now())
+			claims.SessionID = sessionID
+
+			other := New(ds)
+			other.now = func() time.Time { return time.Now().Add(-tt.cachedAgo) }
+			require.NoError(t, other.CheckToken(ctx, claims, user))
+
+			// 本实例删除会话后，其他实例最多在 sessionTTL 后拒绝该会话签发的令牌
+			require.NoError(t, ds.Session().Delete(ctx, where.F("sessionID", sessionID)))
+			other.now = time.Now
+			assert.Equal(t, tt.want, other.CheckToken(ctx, claims, user))
+		})
+	}
+}
+
+func TestCheckAccessToken(t *testing.T) {
+	ds := newTestStore(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	tests := []struct {
+		name        string
+		accessToken *model.AccessTokenM
+		want        error
+		touched     bool
+	}{
+		{"never used", &model.AccessTokenM{TokenID: "pat-1", UserID: "user-1", ExpiresAt: now.Add(time.Hour)}, nil, true},
+		{"recently used", &model.AccessTokenM{TokenID: "pat-2", UserID: "user-1", ExpiresAt: now.Add(time.Hour), LastUsedAt: ptr(now.Add(-10 * time.Second))}, nil, false},
+		{"idle", &model.AccessTokenM{TokenID: "pat-3", UserID: "user-1", ExpiresAt: now.Add(time.Hour), LastUsedAt: ptr(now.Add(-2 * touchInterval))}, nil, true},
+		{"expired", &model.AccessTokenM{TokenID: "pat-4", UserID: "user-1", ExpiresAt: now.Add(-time.Second)}, errno.ErrAccessTokenInvalid, false},
+		{"unknown", nil, errno.ErrAccessTokenInvalid, false},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accessToken := "mb_pat_" + string(rune('a'+i))
+			if tt.accessToken != nil {
+				tt.accessToken.TokenHash = HashAccessToken(accessToken)
+				require.NoError(t, ds.AccessToken().Create(ctx, tt.accessToken))
+			}
+
+			l := New(ds)
+			tokenM, err := l.CheckAccessToken(ctx, accessToken)
+			assert.Equal(t, tt.want, err)
+			if tt.want != nil {
+				return
+			}
+			assert.Equal(t, tt.accessToken.TokenID, tokenM.TokenID)
+
+			stored, err := ds.AccessToken().Get(ctx, where.F("tokenID", tt.accessToken.TokenID))
+			require.NoError(t, err)
+			require.NotNil(t, stored.LastUsedAt)
+			if tt.touched {
+				assert.WithinDuration(t, time.Now(), *stored.LastUsedAt, time.Second)
+			} else {
+				assert.WithinDuration(t, *tt.accessToken.LastUsedAt, *stored.LastUsedAt, time.Second)
+			}
+
+			// 有效的令牌被缓存，删除令牌后需要清除缓存才能立即生效
+			require.NoError(t, ds.AccessToken().Delete(ctx, where.F("tokenID", tt.accessToken.TokenID)))
+			_, err = l.CheckAccessToken(ctx, accessToken)
+			assert.NoError(t, err)
+			l.ForgetAccessTokens(HashAccessToken(accessToken))
+			_, err = l.CheckAccessToken(ctx, accessToken)
+			assert.Equal(t, errno.ErrAccessTokenInvalid, err)
+		})
+	}
+}
+
+func TestCheckAccessTokenCacheBoundedByExpiry(t *testing.T) {
+	ds := newTestStore(t)
+	ctx := context.Background()
+
+	// 令牌在缓存的 negativeTTL 内过期时，缓存随令牌一起过期
+	const accessToken = "mb_pat_expiring"
+	tokenM := &model.AccessTokenM{TokenID: "pat-expiring", UserID: "user-1", TokenHash: HashAccessToken(accessToken), ExpiresAt: time.Now()}
+	require.NoError(t, ds.AccessToken().Create(ctx, tokenM))
+
+	l := New(ds)
+	l.now = func() time.Time { return tokenM.ExpiresAt.Add(-time.Second) }
+	_, err := l.CheckAccessToken(ctx, accessToken)
+	require.NoError(t, err)
+
+	l.now = time.Now
+	_, err = l.CheckAccessToken(ctx, accessToken)
+	assert.Equal(t, errno.ErrAccessTokenInvalid, err)
+}
+
+func ptr(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,51 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+// Package signature 使用 HMAC-SHA256 为 URL 等消息生成和校验签名.
+//
+// 签名密钥由服务端的密钥和用途派生，同一个服务端密钥用于不同用途时得到的签名互不通用，
+// 因此可以和 JWT 共用一个密钥，而不会让某一种签名被当作另一种签名使用.
+package signature
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+)
+
+// Signer 使用派生的密钥对消息签名.
+type Signer struct {
+	key []byte
+}
+
+// NewSigner 从 secret 和 purpose 派生签名密钥，创建 Signer.
+func NewSigner(secret string, purpose string) *Signer {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(purpose))
+	return &Signer{key: mac.Sum(nil)}
+}
+
+// Sign 对 parts 签名，返回 URL 安全的 Base64 编码（无填充）签名.
+// parts 之间用换行符分隔，调用方需要保证 parts 中不包含换行符.
+func (s *Signer) Sign(parts ...string) string {
+	return base64.RawURLEncoding.EncodeToString(s.sum(parts))
+}
+
+// Verify 使用常量时间比较校验 signature 是否为 parts 的签名.
+func (s *Signer) Verify(signature string, parts ...string) bool {
+	got, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(got, s.sum(parts))
+}
+
+// sum 计算 parts 的 HMAC-SHA256 摘要.
+func (s *Signer) sum(parts []string) []byte {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(strings.Join(parts, "\n")))
+	return mac.Sum(nil)
+}
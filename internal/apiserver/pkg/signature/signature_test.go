@@ -0,0 +1,28 @@
+package signature
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	signer := NewSigner("secret", "preview-link")
+	sig := signer.Sign("preview-abc123", "1700000000")
+
+	assert.True(t, signer.Verify(sig, "preview-abc123", "1700000000"))
+	assert.False(t, signer.Verify(sig, "preview-abc123", "1700000001"))
+	assert.False(t, signer.Verify(sig, "preview-abc124", "1700000000"))
+	assert.False(t, signer.Verify("not base64!", "preview-abc123", "1700000000"))
+
+	// 消息不能通过移动分隔位置伪造
+	assert.False(t, signer.Verify(signer.Sign("a", "bc"), "ab", "c"))
+}
+
+func TestKeyDerivation(t *testing.T) {
+	sig := NewSigner("secret", "preview-link").Sign("message")
+
+	assert.False(t, NewSigner("secret", "email").Verify(sig, "message"))
+	assert.False(t, NewSigner("other", "preview-link").Verify(sig, "message"))
+	assert.True(t, NewSigner("secret", "preview-link").Verify(sig, "message"))
+}
@@ -0,0 +1,106 @@
+package site
+
+import (
+	"encoding/xml"
+	"time"
+
+	"github.com/ra1n6ow/miniblog/internal/pkg/theme"
+)
+
+// atomFeed 表示一个 Atom feed，参考 RFC 4287.
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Link    []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+// atomLink 表示 Atom feed 中的链接.
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+// atomEntry 表示 Atom feed 中的一篇博客.
+type atomEntry struct {
+	ID        string         `xml:"id"`
+	Title     string         `xml:"title"`
+	Link      atomLink       `xml:"link"`
+	Published string         `xml:"published"`
+	Updated   string         `xml:"updated"`
+	Author    atomAuthor     `xml:"author"`
+	Category  []atomCategory `xml:"category,omitempty"`
+	Summary   string         `xml:"summary"`
+	Content   atomContent    `xml:"content"`
+}
+
+// atomCategory 表示 Atom feed 中博客的分类.
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+// featuredCategory 是精选博客在 feed 中的分类.
+var featuredCategory = atomCategory{Term: "featured"}
+
+// atomAuthor 表示 Atom feed 中博客的作者.
+type atomAuthor struct {
+	Name string `xml:"name"`
+	URI  string `xml:"uri,omitempty"`
+}
+
+// atomContent 表示 Atom feed 中博客的内容.
+type atomContent struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+// renderFeed 生成包含最新博客的 Atom feed.
+func (g *Generator) renderFeed(posts []*post) error {
+	feed := atomFeed{
+		ID:    g.url(""),
+		Title: g.cfg.Title,
+		Link: []atomLink{
+			{Href: g.url("")},
+			{Href: g.url("feed.xml"), Rel: "self"},
+		},
+	}
+
+	var updated time.Time
+	for _, p := range posts[:min(len(posts), feedSize)] {
+		if p.UpdatedAt.After(updated) {
+			updated = p.UpdatedAt
+		}
+
+		entry := atomEntry{
+			ID:        g.url(postPath(p.PostID)),
+			Title:     p.Title,
+			Link:      atomLink{Href: g.url(postPath(p.PostID))},
+			Published: p.CreatedAt.UTC().Format(time.RFC3339),
+			Updated:   p.UpdatedAt.UTC().Format(time.RFC3339),
+			Author:    atomAuthor{Name: p.Author.Name, URI: g.url(authorPath(p.Author.UserID) + "/")},
+			Summary:   p.Excerpt,
+			Content:   atomContent{Type: "text", Body: p.Content},
+		}
+		// 摘要字段加入之前创建的博客没有保存摘要
+		if entry.Summary == "" {
+			entry.Summary = theme.Excerpt(200, p.Content)
+		}
+		if p.Featured {
+			entry.Category = append(entry.Category, featuredCategory)
+		}
+		feed.Entries = append(feed.Entries, entry)
+	}
+	if updated.IsZero() {
+		updated = time.Now()
+	}
+	feed.Updated = updated.UTC().Format(time.RFC3339)
+
+	data, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return g.write("feed.xml", append([]byte(xml.Header), data...))
+}
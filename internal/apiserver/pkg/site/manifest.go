@@ -0,0 +1,86 @@
+package site
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+)
+
+// manifestFile 是记录增量生成信息的文件名，保存在输出目录中.
+const manifestFile = ".manifest.json"
+
+// manifest 记录上一次生成站点时的主题、站点配置和博客页面信息，用于增量生成.
+type manifest struct {
+	// Theme 是生成站点时所用主题的校验和.
+	Theme string `json:"theme"`
+	// BaseURL 是生成站点时的访问地址，博客页面中的链接依赖该地址.
+	BaseURL string `json:"baseURL"`
+	// Title 是生成站点时的站点标题，博客页面的布局中会展示该标题.
+	Title string `json:"title"`
+	// Posts 记录每篇博客对应的页面，key 为 postID.
+	Posts map[string]manifestEntry `json:"posts"`
+}
+
+// manifestEntry 记录一篇博客页面的信息.
+type manifestEntry struct {
+	// Path 是博客页面相对输出目录的路径.
+	Path string `json:"path"`
+	// Checksum 是渲染该页面时所用数据的校验和.
+	Checksum string `json:"checksum"`
+}
+
+// newManifest 创建一个空的 *manifest 实例.
+func newManifest(theme string, baseURL string, title string) *manifest {
+	return &manifest{Theme: theme, BaseURL: baseURL, Title: title, Posts: make(map[string]manifestEntry)}
+}
+
+// sameSite 判断两次生成站点时所用的主题和站点配置是否相同，不同时需要重新渲染所有博客页面.
+func (m *manifest) sameSite(other *manifest) bool {
+	return m.Theme == other.Theme && m.BaseURL == other.BaseURL && m.Title == other.Title
+}
+
+// loadManifest 读取输出目录中的 manifest，读取失败时返回空的 manifest，即全量生成.
+func (g *Generator) loadManifest() *manifest {
+	data, err := os.ReadFile(filepath.Join(g.cfg.OutputDir, manifestFile))
+	if err != nil {
+		return newManifest("", "", "")
+	}
+
+	m := newManifest("", "", "")
+	if err := json.Unmarshal(data, m); err != nil {
+		log.Warnw("Failed to parse site manifest, fallback to full rebuild", "err", err)
+		return newManifest("", "", "")
+	}
+	return m
+}
+
+// saveManifest 将 manifest 保存到输出目录中.
+func (g *Generator) saveManifest(m *manifest) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return g.write(manifestFile, data)
+}
+
+// postChecksum 计算渲染博客页面所用数据的校验和.
+func postChecksum(p *post) string {
+	h := sha256.New()
+	for _, s := range []string{
+		p.PostID,
+		p.Title,
+		p.Content,
+		p.Author.Name,
+		strconv.FormatInt(p.CreatedAt.Unix(), 10),
+		strconv.FormatInt(p.UpdatedAt.Unix(), 10),
+	} {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,422 @@
+package site
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html/template"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+	"github.com/ra1n6ow/miniblog/internal/pkg/theme"
+)
+
+const (
+	// listBatchSize 定义每次从 PostStore 中读取的博客数量.
+	listBatchSize = 100
+	// feedSize 定义 feed 中包含的最新博客数量.
+	feedSize = 20
+)
+
+// PostLister 定义站点生成器读取博客所需的方法，store.PostStore 实现了该接口.
+type PostLister interface {
+	List(ctx context.Context, opts *where.Options) (int64, []*model.PostM, error)
+}
+
+// UserGetter 定义站点生成器读取作者信息所需的方法，store.UserStore 实现了该接口.
+type UserGetter interface {
+	Get(ctx context.Context, opts *where.Options) (*model.UserM, error)
+}
+
+// FeatureLister 定义站点生成器读取精选博客所需的方法，store.FeatureStore 实现了该接口.
+type FeatureLister interface {
+	List(ctx context.Context, opts *where.Options) (int64, []*model.PostFeatureM, error)
+}
+
+// Config 定义静态站点的生成配置.
+type Config struct {
+	// OutputDir 是静态站点的输出目录.
+	OutputDir string
+	// ThemeDir 是自定义主题目录，其中的模板会覆盖内置的同名模板.
+	ThemeDir string
+	// BaseURL 是站点的访问地址，用于生成页面链接和 feed 中的绝对地址.
+	BaseURL string
+	// Title 是站点标题.
+	Title string
+	// PageSize 是首页和作者页每页展示的博客数量.
+	PageSize int
+	// Full 为 true 时忽略增量信息，重新渲染所有博客页面.
+	Full bool
+}
+
+// Result 记录一次站点生成的统计信息.
+type Result struct {
+	// Posts 是站点中的博客总数.
+	Posts int
+	// Rendered 是本次重新渲染的博客页面数量.
+	Rendered int
+	// Removed 是本次删除的博客页面数量.
+	Removed int
+}
+
+// Generator 根据 PostStore 中的博客生成静态站点.
+type Generator struct {
+	cfg      *Config
+	posts    PostLister
+	users    UserGetter
+	features FeatureLister
+	theme    *siteTheme
+	// templates 缓存已经解析过的页面模板.
+	templates map[string]*template.Template
+}
+
+// author 表示一个作者及其博客.
+type author struct {
+	UserID string
+	Name   string
+	Posts  []*post
+}
+
+// post 是模板中使用的博客数据.
+type post struct {
+	*model.PostM
+	Author *author
+	// Featured 表示博客是否被管理员设为精选.
+	Featured bool
+}
+
+// pagination 是模板中使用的分页数据.
+type pagination struct {
+	Page       int
+	TotalPages int
+	PrevURL    string
+	NextURL    string
+}
+
+// New 创建一个 *Generator 实例.
+func New(cfg *Config, posts PostLister, users UserGetter, features FeatureLister) (*Generator, error) {
+	th, err := loadTheme(cfg.ThemeDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Generator{cfg: cfg, posts: posts, users: users, features: features, theme: th, templates: make(map[string]*template.Template)}, nil
+}
+
+// Generate 生成静态站点. 首页、作者页和 feed 每次都会重新生成，
+// 博客页面只有在内容、主题或者站点的访问地址和标题发生变化时才会重新渲染.
+func (g *Generator) Generate(ctx context.Context) (*Result, error) {
+	posts, authors, err := g.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	featured, err := g.loadFeatured(ctx, posts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(g.cfg.OutputDir, 0o755); err != nil {
+		return nil, err
+	}
+
+	old := g.loadManifest()
+	current := newManifest(g.theme.checksum, g.cfg.BaseURL, g.cfg.Title)
+	rebuildAll := g.cfg.Full || !old.sameSite(current)
+
+	result := &Result{Posts: len(posts)}
+	for _, p := range posts {
+		entry := manifestEntry{Path: postPath(p.PostID), Checksum: postChecksum(p)}
+		current.Posts[p.PostID] = entry
+
+		if prev, ok := old.Posts[p.PostID]; ok && !rebuildAll && prev == entry {
+			continue
+		}
+		if err := g.render(entry.Path, "post", map[string]any{"Post": p}); err != nil {
+			return nil, err
+		}
+		result.Rendered++
+	}
+
+	// 删除已经不存在的博客页面
+	for postID, entry := range old.Posts {
+		if _, ok := current.Posts[postID]; ok {
+			continue
+		}
+		if err := os.Remove(filepath.Join(g.cfg.OutputDir, filepath.FromSlash(entry.Path))); err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		result.Removed++
+	}
+
+	// 首页中精选博客排在最前面，feed 仍然按时间倒序排列
+	if err := g.renderList("", "index", append(featured, withoutFeatured(posts)...), nil); err != nil {
+		return nil, err
+	}
+	for _, a := range authors {
+		if err := g.renderList(authorPath(a.UserID), "author", a.Posts, a); err != nil {
+			return nil, err
+		}
+	}
+	if err := g.removeStaleAuthors(authors); err != nil {
+		return nil, err
+	}
+	if err := g.renderFeed(posts); err != nil {
+		return nil, err
+	}
+	if err := g.writeAssets(); err != nil {
+		return nil, err
+	}
+	if err := g.saveManifest(current); err != nil {
+		return nil, err
+	}
+
+	log.Infow("Static site generated", "output", g.cfg.OutputDir, "posts", result.Posts, "rendered", result.Rendered, "removed", result.Removed)
+	return result, nil
+}
+
+// load 从 store 中读取所有博客，并按作者分组.
+func (g *Generator) load(ctx context.Context) ([]*post, []*author, error) {
+	var postList []*model.PostM
+	for offset := 0; ; offset += listBatchSize {
+		_, batch, err := g.posts.List(ctx, where.O(offset).L(listBatchSize))
+		if err != nil {
+			return nil, nil, err
+		}
+		postList = append(postList, batch...)
+		if len(batch) < listBatchSize {
+			break
+		}
+	}
+
+	authors := make(map[string]*author)
+	posts := make([]*post, 0, len(postList))
+	for _, item := range postList {
+		a, ok := authors[item.UserID]
+		if !ok {
+			a = &author{UserID: item.UserID, Name: item.UserID}
+			if userM, err := g.users.Get(ctx, where.F("userID", item.UserID)); err == nil {
+				a.Name = displayName(userM)
+			}
+			authors[item.UserID] = a
+		}
+
+		p := &post{PostM: item, Author: a}
+		a.Posts = append(a.Posts, p)
+		posts = append(posts, p)
+	}
+
+	// 按创建时间倒序排列，保证多次生成的结果一致
+	sortPosts(posts)
+	authorList := make([]*author, 0, len(authors))
+	for _, a := range authors {
+		sortPosts(a.Posts)
+		authorList = append(authorList, a)
+	}
+	sort.Slice(authorList, func(i, j int) bool { return authorList[i].UserID < authorList[j].UserID })
+
+	return posts, authorList, nil
+}
+
+// loadFeatured 读取精选博客并标记 posts 中对应的博客，返回按精选顺序排列的精选博客.
+func (g *Generator) loadFeatured(ctx context.Context, posts []*post) ([]*post, error) {
+	_, featureList, err := g.features.List(ctx, where.NewWhere())
+	if err != nil {
+		return nil, err
+	}
+
+	postMap := make(map[string]*post, len(posts))
+	for _, p := range posts {
+		postMap[p.PostID] = p
+	}
+
+	featured := make([]*post, 0, len(featureList))
+	for _, feature := range featureList {
+		if p, ok := postMap[feature.PostID]; ok {
+			p.Featured = true
+			featured = append(featured, p)
+		}
+	}
+	return featured, nil
+}
+
+// renderList 分页渲染博客列表页面，第一页写入 dir/index.html，其余页写入 dir/page/N/index.html.
+func (g *Generator) renderList(dir string, name string, posts []*post, a *author) error {
+	pageSize := g.cfg.PageSize
+	totalPages := (len(posts) + pageSize - 1) / pageSize
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	for page := 1; page <= totalPages; page++ {
+		start := (page - 1) * pageSize
+		end := min(start+pageSize, len(posts))
+
+		pager := &pagination{Page: page, TotalPages: totalPages}
+		if page > 1 {
+			pager.PrevURL = g.url(listPath(dir, page-1))
+		}
+		if page < totalPages {
+			pager.NextURL = g.url(listPath(dir, page+1))
+		}
+
+		data := map[string]any{"Posts": posts[start:end], "Pagination": pager, "Author": a}
+		if err := g.render(listPath(dir, page), name, data); err != nil {
+			return err
+		}
+	}
+
+	// 删除上次生成时多出来的分页
+	for page := totalPages + 1; ; page++ {
+		pageDir := filepath.Join(g.cfg.OutputDir, filepath.FromSlash(path.Join(dir, "page", fmt.Sprint(page))))
+		if _, err := os.Stat(pageDir); err != nil {
+			break
+		}
+		if err := os.RemoveAll(pageDir); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeStaleAuthors 删除已经没有博客的作者页面.
+func (g *Generator) removeStaleAuthors(authors []*author) error {
+	exists := make(map[string]struct{}, len(authors))
+	for _, a := range authors {
+		exists[a.UserID] = struct{}{}
+	}
+
+	dir := filepath.Join(g.cfg.OutputDir, "authors")
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	for _, entry := range entries {
+		if _, ok := exists[entry.Name()]; ok || !entry.IsDir() {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(dir, entry.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// render 使用名为 name 的模板渲染页面，并写入到输出目录下的 relPath 文件中.
+func (g *Generator) render(relPath string, name string, data map[string]any) error {
+	tmpl, ok := g.templates[name]
+	if !ok {
+		var err error
+		if tmpl, err = g.theme.page(name, g.funcs()); err != nil {
+			return err
+		}
+		g.templates[name] = tmpl
+	}
+
+	data["Site"] = g.cfg
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, "layout", data); err != nil {
+		return fmt.Errorf("failed to render %s: %w", relPath, err)
+	}
+
+	return g.write(relPath, buf.Bytes())
+}
+
+// write 将数据写入到输出目录下的 relPath 文件中.
+func (g *Generator) write(relPath string, data []byte) error {
+	filename := filepath.Join(g.cfg.OutputDir, filepath.FromSlash(relPath))
+	if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(filename, data, 0o644)
+}
+
+// writeAssets 将主题中的静态资源写入输出目录.
+func (g *Generator) writeAssets() error {
+	for _, name := range assetFiles {
+		data, err := g.theme.read(name)
+		if err != nil {
+			return err
+		}
+		if err := g.write(path.Join("static", name), data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// funcs 返回模板中可以使用的函数.
+func (g *Generator) funcs() template.FuncMap {
+	return template.FuncMap{
+		"url":        g.url,
+		"postURL":    func(postID string) string { return g.url(postPath(postID)) },
+		"authorURL":  func(userID string) string { return g.url(authorPath(userID) + "/") },
+		"paragraphs": theme.Paragraphs,
+		"excerpt":    theme.Excerpt,
+		"date":       func(t time.Time) string { return t.Format("2006-01-02") },
+	}
+}
+
+// url 根据 BaseURL 生成页面地址.
+func (g *Generator) url(relPath string) string {
+	relPath = strings.TrimSuffix(relPath, "index.html")
+	return strings.TrimSuffix(g.cfg.BaseURL, "/") + "/" + strings.TrimPrefix(relPath, "/")
+}
+
+// displayName 返回作者的展示名称.
+func displayName(userM *model.UserM) string {
+	if userM.Nickname != "" {
+		return userM.Nickname
+	}
+	return userM.Username
+}
+
+// sortPosts 将博客按创建时间倒序排列.
+func sortPosts(posts []*post) {
+	sort.SliceStable(posts, func(i, j int) bool {
+		if posts[i].CreatedAt.Equal(posts[j].CreatedAt) {
+			return posts[i].ID > posts[j].ID
+		}
+		return posts[i].CreatedAt.After(posts[j].CreatedAt)
+	})
+}
+
+// withoutFeatured 返回 posts 中除精选博客以外的博客.
+func withoutFeatured(posts []*post) []*post {
+	ret := make([]*post, 0, len(posts))
+	for _, p := range posts {
+		if !p.Featured {
+			ret = append(ret, p)
+		}
+	}
+	return ret
+}
+
+// postPath 返回博客页面的相对路径.
+func postPath(postID string) string {
+	return path.Join("posts", postID+".html")
+}
+
+// authorPath 返回作者页面所在的相对目录.
+func authorPath(userID string) string {
+	return path.Join("authors", userID)
+}
+
+// listPath 返回列表页面第 page 页的相对路径.
+func listPath(dir string, page int) string {
+	if page <= 1 {
+		return path.Join(dir, "index.html")
+	}
+	return path.Join(dir, "page", fmt.Sprint(page), "index.html")
+}
@@ -0,0 +1,180 @@
+package site
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+)
+
+// fakePostLister 是用于测试的 PostLister 实现，忽略分页条件一次性返回所有博客.
+type fakePostLister struct {
+	posts []*model.PostM
+}
+
+func (f *fakePostLister) List(ctx context.Context, opts *where.Options) (int64, []*model.PostM, error) {
+	return int64(len(f.posts)), f.posts, nil
+}
+
+// fakeFeatureLister 是用于测试的 FeatureLister 实现.
+type fakeFeatureLister struct {
+	features []*model.PostFeatureM
+}
+
+func (f *fakeFeatureLister) List(ctx context.Context, opts *where.Options) (int64, []*model.PostFeatureM, error) {
+	return int64(len(f.features)), f.features, nil
+}
+
+// fakeUserGetter 是用于测试的 UserGetter 实现.
+type fakeUserGetter struct{}
+
+func (fakeUserGetter) Get(ctx context.Context, opts *where.Options) (*model.UserM, error) {
+	return nil, errno.ErrUserNotFound
+}
+
+func newTestPost(id int64, postID string, title string) *model.PostM {
+	now := time.Date(2024, 12, 12, 0, 0, 0, 0, time.UTC).Add(time.Duration(id) * time.Hour)
+	return &model.PostM{ID: id, UserID: "user-000001", PostID: postID, Title: title, Content: "hello\n\nworld", CreatedAt: now, UpdatedAt: now}
+}
+
+func TestGenerator_Incremental(t *testing.T) {
+	dir := t.TempDir()
+	lister := &fakePostLister{posts: []*model.PostM{
+		newTestPost(1, "post-a", "first"),
+		newTestPost(2, "post-b", "second"),
+		newTestPost(3, "post-c", "third"),
+	}}
+	cfg := &Config{OutputDir: dir, BaseURL: "https://blog.example.com/", Title: "test", PageSize: 2}
+
+	g, err := New(cfg, lister, fakeUserGetter{}, &fakeFeatureLister{})
+	require.NoError(t, err)
+
+	// 第一次生成会渲染所有博客
+	result, err := g.Generate(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, &Result{Posts: 3, Rendered: 3}, result)
+	for _, name := range []string{"index.html", "page/2/index.html", "posts/post-a.html", "authors/user-000001/index.html", "feed.xml", "static/style.css"} {
+		assert.FileExists(t, filepath.Join(dir, name))
+	}
+
+	// 没有变化时不会重新渲染博客页面
+	result, err = g.Generate(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Rendered)
+
+	// 只重新渲染修改过的博客，并删除已经不存在的博客页面
+	lister.posts[0].Title = "first (updated)"
+	lister.posts[0].UpdatedAt = lister.posts[0].UpdatedAt.Add(time.Minute)
+	lister.posts = lister.posts[:2]
+	result, err = g.Generate(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, &Result{Posts: 2, Rendered: 1, Removed: 1}, result)
+	assert.NoFileExists(t, filepath.Join(dir, "posts/post-c.html"))
+	assert.NoDirExists(t, filepath.Join(dir, "page/2"))
+
+	data, err := os.ReadFile(filepath.Join(dir, "posts/post-a.html"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "first (updated)")
+
+	// 全量生成会重新渲染所有博客
+	cfg.Full = true
+	result, err = g.Generate(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Rendered)
+}
+
+func TestGenerator_IncrementalAfterConfigChange(t *testing.T) {
+	dir := t.TempDir()
+	lister := &fakePostLister{posts: []*model.PostM{
+		newTestPost(1, "post-a", "first"),
+		newTestPost(2, "post-b", "second"),
+	}}
+	cfg := &Config{OutputDir: dir, BaseURL: "https://blog.example.com/", Title: "test", PageSize: 10}
+
+	g, err := New(cfg, lister, fakeUserGetter{}, &fakeFeatureLister{})
+	require.NoError(t, err)
+	_, err = g.Generate(context.Background())
+	require.NoError(t, err)
+
+	// 修改访问地址后，增量生成也会重新渲染所有博客页面
+	cfg.BaseURL = "https://new.example.com/"
+	result, err := g.Generate(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Rendered)
+	data, err := os.ReadFile(filepath.Join(dir, "posts/post-a.html"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "https://new.example.com/static/style.css")
+	assert.NotContains(t, string(data), "https://blog.example.com/")
+
+	// 修改站点标题同样需要重新渲染
+	cfg.Title = "renamed blog"
+	result, err = g.Generate(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Rendered)
+	data, err = os.ReadFile(filepath.Join(dir, "posts/post-b.html"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "renamed blog")
+
+	// 配置不再变化时恢复增量生成
+	result, err = g.Generate(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Rendered)
+}
+
+func TestGenerator_ThemeOverride(t *testing.T) {
+	themeDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(themeDir, "post.html"), []byte(`{{ define "content" }}custom {{ .Post.Title }}{{ end }}`), 0o644))
+
+	dir := t.TempDir()
+	cfg := &Config{OutputDir: dir, ThemeDir: themeDir, BaseURL: "/", Title: "test", PageSize: 10}
+	g, err := New(cfg, &fakePostLister{posts: []*model.PostM{newTestPost(1, "post-a", "first")}}, fakeUserGetter{}, &fakeFeatureLister{})
+	require.NoError(t, err)
+
+	_, err = g.Generate(context.Background())
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(filepath.Join(dir, "posts/post-a.html"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "custom first")
+	// 未覆盖的模板继续使用内置主题
+	assert.Contains(t, string(data), `href="/static/style.css"`)
+}
+
+func TestGenerator_Featured(t *testing.T) {
+	dir := t.TempDir()
+	lister := &fakePostLister{posts: []*model.PostM{
+		newTestPost(1, "post-a", "first"),
+		newTestPost(2, "post-b", "second"),
+		newTestPost(3, "post-c", "third"),
+	}}
+	features := &fakeFeatureLister{features: []*model.PostFeatureM{{PostID: "post-a"}}}
+	cfg := &Config{OutputDir: dir, BaseURL: "/", Title: "test", PageSize: 10}
+
+	g, err := New(cfg, lister, fakeUserGetter{}, features)
+	require.NoError(t, err)
+	_, err = g.Generate(context.Background())
+	require.NoError(t, err)
+
+	// 首页中精选博客排在最前面
+	data, err := os.ReadFile(filepath.Join(dir, "index.html"))
+	require.NoError(t, err)
+	index := string(data)
+	assert.Less(t, strings.Index(index, "first"), strings.Index(index, "third"))
+	assert.Less(t, strings.Index(index, "third"), strings.Index(index, "second"))
+
+	// feed 按时间倒序排列，精选博客带有 featured 分类
+	data, err = os.ReadFile(filepath.Join(dir, "feed.xml"))
+	require.NoError(t, err)
+	feed := string(data)
+	assert.Less(t, strings.Index(feed, "third"), strings.Index(feed, "first"))
+	assert.Equal(t, 1, strings.Count(feed, `<category term="featured"></category>`))
+}
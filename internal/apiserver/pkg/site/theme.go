@@ -0,0 +1,53 @@
+package site
+
+import (
+	"embed"
+	"html/template"
+	"io/fs"
+
+	"github.com/ra1n6ow/miniblog/internal/pkg/theme"
+)
+
+// defaultTheme 是内置的默认主题.
+//
+//go:embed templates
+var defaultTheme embed.FS
+
+var (
+	// templateFiles 是主题中包含的模板文件. layout.html 为公共布局，其余为各类页面.
+	templateFiles = []string{"layout.html", "index.html", "author.html", "post.html"}
+	// assetFiles 是主题中包含的静态资源，会被原样复制到输出目录的 static 目录下.
+	assetFiles = []string{"style.css"}
+)
+
+// siteTheme 表示站点主题，并记录主题文件的校验和.
+type siteTheme struct {
+	*theme.Theme
+	checksum string
+}
+
+// loadTheme 加载主题，并计算主题文件的校验和.
+func loadTheme(themeDir string) (*siteTheme, error) {
+	embedded, _ := fs.Sub(defaultTheme, "templates")
+	th, err := theme.New(embedded, themeDir)
+	if err != nil {
+		return nil, err
+	}
+
+	checksum, err := th.Checksum(append(templateFiles, assetFiles...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &siteTheme{Theme: th, checksum: checksum}, nil
+}
+
+// read 读取主题中的文件.
+func (th *siteTheme) read(name string) ([]byte, error) {
+	return th.ReadFile(name)
+}
+
+// page 返回用于渲染指定页面的模板，该模板包含公共布局.
+func (th *siteTheme) page(name string, funcs template.FuncMap) (*template.Template, error) {
+	return th.Parse(funcs, "layout.html", name+".html")
+}
@@ -0,0 +1,80 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+// Package usercache 缓存认证中间件使用的用户信息，避免每个请求都查询数据库.
+// 修改或者删除用户后需要调用 Forget 清除缓存，本实例立即生效. 多实例部署时，其他实例上的缓存不会被清除，
+// 用户名、令牌撤销时间等修改最多在 ttl（1 分钟）后生效，在此期间其他实例仍然可能接受已撤销的访问令牌.
+package usercache
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/wire"
+	"github.com/ra1n6ow/gpkg/store/where"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/store"
+	"github.com/ra1n6ow/miniblog/internal/pkg/lru"
+)
+
+const (
+	// cacheSize 为缓存的用户数量.
+	cacheSize = 10000
+	// ttl 为用户信息的缓存时间.
+	ttl = time.Minute
+)
+
+// ProviderSet 是一个 Wire 的 Provider 集合，用于声明依赖注入的规则.
+var ProviderSet = wire.NewSet(New)
+
+// Cache 为用户信息的缓存，可以被多个 goroutine 并发使用.
+type Cache struct {
+	store store.IStore
+	// users 缓存用户信息，key 为用户 ID.
+	users *lru.Cache[string, *model.UserM]
+	// generation 在每次清除缓存时递增，查询期间缓存被清除时不缓存查询结果，避免缓存修改前的用户信息.
+	generation atomic.Uint64
+	now        func() time.Time
+}
+
+// New 创建用户信息缓存.
+func New(store store.IStore) *Cache {
+	return &Cache{
+		store: store,
+		users: lru.New[string, *model.UserM](cacheSize),
+		now:   time.Now,
+	}
+}
+
+// GetUser 根据用户 ID 获取用户信息，优先从缓存中获取.
+// 返回的是缓存条目的副本，调用方可以修改.
+func (c *Cache) GetUser(ctx context.Context, userID string) (*model.UserM, error) {
+	if userM, ok := c.users.Get(userID); ok {
+		copied := *userM
+		return &copied, nil
+	}
+
+	generation := c.generation.Load()
+	userM, err := c.store.User().Get(ctx, where.F("userID", userID))
+	if err != nil {
+		return nil, err
+	}
+	if c.generation.Load() == generation {
+		copied := *userM
+		c.users.Add(userID, &copied, c.now().Add(ttl))
+	}
+
+	return userM, nil
+}
+
+// Forget 清除用户的缓存，调用方修改或者删除用户后调用.
+func (c *Cache) Forget(userIDs ...string) {
+	c.generation.Add(1)
+	for _, id := range userIDs {
+		c.users.Remove(id)
+	}
+}
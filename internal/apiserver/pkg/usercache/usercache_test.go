@@ -0,0 +1,148 @@
+package usercache
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/store"
+)
+
+// fakeStore 是用于测试的 store.IStore 实现，只实现了 User 方法.
+type fakeStore struct {
+	store.IStore
+	users *fakeUserStore
+}
+
+func (f *fakeStore) User() store.UserStore {
+	return f.users
+}
+
+// fakeUserStore 是用于测试的 store.UserStore 实现，记录查询次数.
+// blocked 不为 nil 时，查询读取用户信息后关闭 blocked，并等待 release 被关闭后才返回.
+type fakeUserStore struct {
+	store.UserStore
+
+	mu      sync.Mutex
+	user    model.UserM
+	gets    int
+	blocked chan struct{}
+	release chan struct{}
+}
+
+func (f *fakeUserStore) Get(ctx context.Context, opts *where.Options) (*model.UserM, error) {
+	f.mu.Lock()
+	f.gets++
+	user := f.user
+	blocked, release := f.blocked, f.release
+	f.blocked, f.release = nil, nil
+	f.mu.Unlock()
+
+	if blocked != nil {
+		close(blocked)
+		<-release
+	}
+	return &user, nil
+}
+
+// rename 修改用户名，模拟用户信息被修改.
+func (f *fakeUserStore) rename(username string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.user.Username = username
+}
+
+// count 返回查询次数.
+func (f *fakeUserStore) count() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.gets
+}
+
+func newTestCache() (*Cache, *fakeUserStore) {
+	users := &fakeUserStore{user: model.UserM{UserID: "user-1", Username: "alice"}}
+	return New(&fakeStore{users: users}), users
+}
+
+func TestGetUser(t *testing.T) {
+	c, users := newTestCache()
+	ctx := context.Background()
+
+	userM, err := c.GetUser(ctx, "user-1")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", userM.Username)
+
+	// 有效期内从缓存中获取，返回的是副本，调用方的修改不会影响缓存
+	userM.Username = "mallory"
+	userM, err = c.GetUser(ctx, "user-1")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", userM.Username)
+	assert.Equal(t, 1, users.count())
+
+	// 修改用户后清除缓存，本实例立即生效
+	users.rename("bob")
+	c.Forget("user-1")
+	userM, err = c.GetUser(ctx, "user-1")
+	require.NoError(t, err)
+	assert.Equal(t, "bob", userM.Username)
+	assert.Equal(t, 2, users.count())
+}
+
+func TestGetUserExpiry(t *testing.T) {
+	c, users := newTestCache()
+	ctx := context.Background()
+
+	// 缓存条目在 ttl 后过期，没有清除缓存时，其他实例的修改最多在 ttl 后生效
+	c.now = func() time.Time { return time.Now().Add(-ttl) }
+	_, err := c.GetUser(ctx, "user-1")
+	require.NoError(t, err)
+	users.rename("bob")
+	userM, err := c.GetUser(ctx, "user-1")
+	require.NoError(t, err)
+	assert.Equal(t, "bob", userM.Username)
+	assert.Equal(t, 2, users.count())
+
+	// 缓存条目即将过期时仍然从缓存中获取
+	c.now = func() time.Time { return time.Now().Add(time.Second - ttl) }
+	c.Forget("user-1")
+	_, err = c.GetUser(ctx, "user-1")
+	require.NoError(t, err)
+	users.rename("carol")
+	userM, err = c.GetUser(ctx, "user-1")
+	require.NoError(t, err)
+	assert.Equal(t, "bob", userM.Username)
+	assert.Equal(t, 3, users.count())
+}
+
+func TestForgetDuringLoad(t *testing.T) {
+	c, users := newTestCache()
+	ctx := context.Background()
+	blocked, release := make(chan struct{}), make(chan struct{})
+	users.blocked, users.release = blocked, release
+
+	done := make(chan *model.UserM)
+	go func() {
+		userM, err := c.GetUser(ctx, "user-1")
+		assert.NoError(t, err)
+		done <- userM
+	}()
+
+	// 查询读取到旧的用户信息之后，用户被修改并清除缓存
+	<-blocked
+	users.rename("bob")
+	c.Forget("user-1")
+	close(release)
+	assert.Equal(t, "alice", (<-done).Username)
+
+	// 查询到的旧用户信息没有被缓存
+	userM, err := c.GetUser(ctx, "user-1")
+	require.NoError(t, err)
+	assert.Equal(t, "bob", userM.Username)
+	assert.Equal(t, 2, users.count())
+}
@@ -0,0 +1,78 @@
+package validation
+
+import (
+	"context"
+	"unicode/utf8"
+
+	genericvalidation "github.com/ra1n6ow/gpkg/validation"
+
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/scope"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// maxAccessTokenDays 为个人访问令牌的最长有效天数.
+const maxAccessTokenDays = 365
+
+// ValidateAccessTokenRules 校验字段的有效性.
+func (v *Validator) ValidateAccessTokenRules() genericvalidation.Rules {
+	return genericvalidation.Rules{
+		"TokenID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("tokenID cannot be empty")
+			}
+			return nil
+		},
+		"Name": func(value any) error {
+			if n := utf8.RuneCountInString(value.(string)); n < 1 || n > 64 {
+				return errno.ErrInvalidArgument.WithMessage("name must be between 1 and 64 characters")
+			}
+			return nil
+		},
+		"Scopes": func(value any) error {
+			scopes := value.([]string)
+			if len(scopes) == 0 {
+				return errno.ErrInvalidArgument.WithMessage("scopes cannot be empty")
+			}
+			for _, s := range scopes {
+				if !scope.Valid(s) {
+					return errno.ErrInvalidArgument.WithMessage("invalid scope %q, must be one of %v", s, scope.All())
+				}
+			}
+			return nil
+		},
+		"ExpiresInDays": func(value any) error {
+			if days := value.(int32); days < 1 || days > maxAccessTokenDays {
+				return errno.ErrInvalidArgument.WithMessage("expiresInDays must be between 1 and %d", maxAccessTokenDays)
+			}
+			return nil
+		},
+		"Offset": func(value any) error {
+			if value.(int64) < 0 {
+				return errno.ErrInvalidArgument.WithMessage("offset must be greater than or equal to 0")
+			}
+			return nil
+		},
+		"Limit": func(value any) error {
+			if value.(int64) < 0 {
+				return errno.ErrInvalidArgument.WithMessage("limit must be greater than or equal to 0")
+			}
+			return nil
+		},
+	}
+}
+
+// ValidateCreateAccessTokenRequest 校验 CreateAccessTokenRequest 结构体的有效性.
+func (v *Validator) ValidateCreateAccessTokenRequest(ctx context.Context, rq *apiv1.CreateAccessTokenRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateAccessTokenRules())
+}
+
+// ValidateListAccessTokensRequest 校验 ListAccessTokensRequest 结构体的有效性.
+func (v *Validator) ValidateListAccessTokensRequest(ctx context.Context, rq *apiv1.ListAccessTokensRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateAccessTokenRules())
+}
+
+// ValidateRevokeAccessTokenRequest 校验 RevokeAccessTokenRequest 结构体的有效性.
+func (v *Validator) ValidateRevokeAccessTokenRequest(ctx context.Context, rq *apiv1.RevokeAccessTokenRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateAccessTokenRules())
+}
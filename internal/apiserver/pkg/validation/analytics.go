@@ -0,0 +1,47 @@
+package validation
+
+import (
+	"context"
+	"time"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/period"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// ValidateListPostActivityRequest 校验 ListPostActivityRequest 结构体的有效性.
+func (v *Validator) ValidateListPostActivityRequest(ctx context.Context, rq *apiv1.ListPostActivityRequest) error {
+	if rq.UserID != nil && rq.GetUserID() == "" {
+		return errno.ErrInvalidArgument.WithMessage("userID cannot be empty")
+	}
+	return validateAnalyticsRange(rq.GetStartDate(), rq.GetEndDate(), rq.GetGranularity())
+}
+
+// ValidateListTopAuthorsRequest 校验 ListTopAuthorsRequest 结构体的有效性.
+func (v *Validator) ValidateListTopAuthorsRequest(ctx context.Context, rq *apiv1.ListTopAuthorsRequest) error {
+	if rq.GetLimit() < 0 || rq.GetLimit() > 100 {
+		return errno.ErrInvalidArgument.WithMessage("limit must be between 0 and 100")
+	}
+	return validateAnalyticsRange(rq.GetStartDate(), rq.GetEndDate(), "")
+}
+
+// ValidateListSiteStatsRequest 校验 ListSiteStatsRequest 结构体的有效性.
+func (v *Validator) ValidateListSiteStatsRequest(ctx context.Context, rq *apiv1.ListSiteStatsRequest) error {
+	return validateAnalyticsRange(rq.GetStartDate(), rq.GetEndDate(), rq.GetGranularity())
+}
+
+// ValidateGetMyPostStatsRequest 校验 GetMyPostStatsRequest 结构体的有效性.
+func (v *Validator) ValidateGetMyPostStatsRequest(ctx context.Context, rq *apiv1.GetMyPostStatsRequest) error {
+	return validateAnalyticsRange(rq.GetStartDate(), rq.GetEndDate(), rq.GetGranularity())
+}
+
+// validateAnalyticsRange 校验统计接口的日期范围和统计周期.
+func validateAnalyticsRange(start string, end string, granularity string) error {
+	if _, err := period.ParseRange(start, end, time.Now()); err != nil {
+		return errno.ErrInvalidArgument.WithMessage("%s", err.Error())
+	}
+	if !period.ValidGranularity(granularity) {
+		return errno.ErrInvalidArgument.WithMessage("granularity must be one of %s, %s", period.GranularityDay, period.GranularityWeek)
+	}
+	return nil
+}
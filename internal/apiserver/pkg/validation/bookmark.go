@@ -0,0 +1,79 @@
+package validation
+
+import (
+	"context"
+	"unicode/utf8"
+
+	genericvalidation "github.com/ra1n6ow/gpkg/validation"
+
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// ValidateBookmarkRules 校验字段的有效性.
+func (v *Validator) ValidateBookmarkRules() genericvalidation.Rules {
+	return genericvalidation.Rules{
+		"PostID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("postID cannot be empty")
+			}
+			return nil
+		},
+		"ListName": func(value any) error {
+			if utf8.RuneCountInString(value.(string)) > 64 {
+				return errno.ErrInvalidArgument.WithMessage("listName must be less than 64 characters")
+			}
+			return nil
+		},
+		"PostIDs": func(value any) error {
+			seen := make(map[string]struct{})
+			for _, postID := range value.([]string) {
+				if postID == "" {
+					return errno.ErrInvalidArgument.WithMessage("postIDs cannot contain empty postID")
+				}
+				if _, ok := seen[postID]; ok {
+					return errno.ErrInvalidArgument.WithMessage("postIDs cannot contain duplicate postID %s", postID)
+				}
+				seen[postID] = struct{}{}
+			}
+			return nil
+		},
+		"Offset": func(value any) error {
+			if value.(int64) < 0 {
+				return errno.ErrInvalidArgument.WithMessage("offset must be greater than or equal to 0")
+			}
+			return nil
+		},
+		"Limit": func(value any) error {
+			if value.(int64) <= 0 {
+				return errno.ErrInvalidArgument.WithMessage("limit must be greater than 0")
+			}
+			return nil
+		},
+	}
+}
+
+// ValidateAddBookmarkRequest 校验 AddBookmarkRequest 结构体的有效性.
+func (v *Validator) ValidateAddBookmarkRequest(ctx context.Context, rq *apiv1.AddBookmarkRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateBookmarkRules())
+}
+
+// ValidateRemoveBookmarkRequest 校验 RemoveBookmarkRequest 结构体的有效性.
+func (v *Validator) ValidateRemoveBookmarkRequest(ctx context.Context, rq *apiv1.RemoveBookmarkRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateBookmarkRules())
+}
+
+// ValidateListBookmarkRequest 校验 ListBookmarkRequest 结构体的有效性.
+func (v *Validator) ValidateListBookmarkRequest(ctx context.Context, rq *apiv1.ListBookmarkRequest) error {
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidateBookmarkRules(), "Offset", "Limit")
+}
+
+// ValidateReorderBookmarksRequest 校验 ReorderBookmarksRequest 结构体的有效性.
+func (v *Validator) ValidateReorderBookmarksRequest(ctx context.Context, rq *apiv1.ReorderBookmarksRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateBookmarkRules())
+}
+
+// ValidateListReadingListRequest 校验 ListReadingListRequest 结构体的有效性.
+func (v *Validator) ValidateListReadingListRequest(ctx context.Context, rq *apiv1.ListReadingListRequest) error {
+	return nil
+}
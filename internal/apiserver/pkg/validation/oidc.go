@@ -0,0 +1,44 @@
+package validation
+
+import (
+	"context"
+
+	genericvalidation "github.com/ra1n6ow/gpkg/validation"
+
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// ValidateOIDCRules 校验字段的有效性.
+func (v *Validator) ValidateOIDCRules() genericvalidation.Rules {
+	return genericvalidation.Rules{
+		"Provider": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("provider cannot be empty")
+			}
+			return nil
+		},
+		"Code": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("code cannot be empty")
+			}
+			return nil
+		},
+		"State": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("state cannot be empty")
+			}
+			return nil
+		},
+	}
+}
+
+// ValidateStartOIDCLoginRequest 校验 StartOIDCLoginRequest 结构体的有效性.
+func (v *Validator) ValidateStartOIDCLoginRequest(ctx context.Context, rq *apiv1.StartOIDCLoginRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateOIDCRules())
+}
+
+// ValidateCompleteOIDCLoginRequest 校验 CompleteOIDCLoginRequest 结构体的有效性.
+func (v *Validator) ValidateCompleteOIDCLoginRequest(ctx context.Context, rq *apiv1.CompleteOIDCLoginRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateOIDCRules())
+}
@@ -0,0 +1,137 @@
+package validation
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strings"
+
+	genericvalidation "github.com/ra1n6ow/gpkg/validation"
+
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// maxPolicyFieldLength 为策略每个字段的最大长度，与 casbin_rule 表的字段长度一致.
+const maxPolicyFieldLength = 100
+
+var (
+	roleRegex   = regexp.MustCompile(`^role::[a-z0-9][a-z0-9_-]*$`) // 角色名称，例如 role::admin
+	userIDRegex = regexp.MustCompile(`^user-[a-z0-9]+$`)            // 用户 ID，例如 user-000000
+	objectRegex = regexp.MustCompile(`^(\*|/[A-Za-z0-9_./*:-]*)$`)  // HTTP 路径或者 gRPC 方法全名，支持 * 通配符
+)
+
+// policyActions 为策略允许的操作，gRPC 方法统一使用 CALL.
+var policyActions = []string{"GET", "POST", "PUT", "PATCH", "DELETE", "CALL", "*"}
+
+// policyEffects 为策略允许的效果.
+var policyEffects = []string{"allow", "deny"}
+
+// isValidRole 判断角色名称是否合法.
+func isValidRole(role string) bool {
+	return len(role) <= maxPolicyFieldLength && roleRegex.MatchString(role)
+}
+
+// validatePolicy 校验一条授权策略的格式. 策略的主体可以是角色，也可以是单个用户.
+func validatePolicy(policy *apiv1.Policy) error {
+	if policy == nil {
+		return errno.ErrInvalidArgument.WithMessage("policy cannot be empty")
+	}
+	if subject := policy.GetSubject(); !isValidRole(subject) && (len(subject) > maxPolicyFieldLength || !userIDRegex.MatchString(subject)) {
+		return errno.ErrInvalidArgument.WithMessage("invalid subject %q, must be a role such as role::user or a user ID", subject)
+	}
+	if object := policy.GetObject(); len(object) > maxPolicyFieldLength || !objectRegex.MatchString(object) {
+		return errno.ErrInvalidArgument.WithMessage("invalid object %q, must be * or start with /", object)
+	}
+	if !slices.Contains(policyActions, policy.GetAction()) {
+		return errno.ErrInvalidArgument.WithMessage("invalid action %q, must be one of %s", policy.GetAction(), strings.Join(policyActions, ", "))
+	}
+	if !slices.Contains(policyEffects, policy.GetEffect()) {
+		return errno.ErrInvalidArgument.WithMessage("invalid effect %q, must be one of %s", policy.GetEffect(), strings.Join(policyEffects, ", "))
+	}
+	return nil
+}
+
+// ValidatePolicyRules 校验字段的有效性.
+func (v *Validator) ValidatePolicyRules() genericvalidation.Rules {
+	return genericvalidation.Rules{
+		"UserID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("userID cannot be empty")
+			}
+			return nil
+		},
+		"Role": func(value any) error {
+			if role := value.(string); !isValidRole(role) {
+				return errno.ErrInvalidArgument.WithMessage("invalid role %q, must look like role::name", role)
+			}
+			return nil
+		},
+		"Policy": func(value any) error {
+			return validatePolicy(value.(*apiv1.Policy))
+		},
+		"Policies": func(value any) error {
+			policies := value.([]*apiv1.Policy)
+			if len(policies) == 0 {
+				return errno.ErrInvalidArgument.WithMessage("policies cannot be empty")
+			}
+			seen := make(map[string]struct{}, len(policies))
+			for _, policy := range policies {
+				if err := validatePolicy(policy); err != nil {
+					return err
+				}
+				key := strings.Join([]string{policy.GetSubject(), policy.GetObject(), policy.GetAction(), policy.GetEffect()}, ",")
+				if _, ok := seen[key]; ok {
+					return errno.ErrInvalidArgument.WithMessage("policies cannot contain duplicate policy %s", key)
+				}
+				seen[key] = struct{}{}
+			}
+			return nil
+		},
+	}
+}
+
+// ValidateListPoliciesRequest 校验 ListPoliciesRequest 结构体的有效性.
+func (v *Validator) ValidateListPoliciesRequest(ctx context.Context, rq *apiv1.ListPoliciesRequest) error {
+	return nil
+}
+
+// ValidateCreatePolicyRequest 校验 CreatePolicyRequest 结构体的有效性.
+func (v *Validator) ValidateCreatePolicyRequest(ctx context.Context, rq *apiv1.CreatePolicyRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePolicyRules())
+}
+
+// ValidateDeletePolicyRequest 校验 DeletePolicyRequest 结构体的有效性.
+func (v *Validator) ValidateDeletePolicyRequest(ctx context.Context, rq *apiv1.DeletePolicyRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePolicyRules())
+}
+
+// ValidateReplacePoliciesRequest 校验 ReplacePoliciesRequest 结构体的有效性.
+func (v *Validator) ValidateReplacePoliciesRequest(ctx context.Context, rq *apiv1.ReplacePoliciesRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePolicyRules())
+}
+
+// ValidateListRoleAssignmentsRequest 校验 ListRoleAssignmentsRequest 结构体的有效性.
+func (v *Validator) ValidateListRoleAssignmentsRequest(ctx context.Context, rq *apiv1.ListRoleAssignmentsRequest) error {
+	return nil
+}
+
+// ValidateAssignRoleRequest 校验 AssignRoleRequest 结构体的有效性.
+func (v *Validator) ValidateAssignRoleRequest(ctx context.Context, rq *apiv1.AssignRoleRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePolicyRules())
+}
+
+// ValidateUnassignRoleRequest 校验 UnassignRoleRequest 结构体的有效性.
+func (v *Validator) ValidateUnassignRoleRequest(ctx context.Context, rq *apiv1.UnassignRoleRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePolicyRules())
+}
+
+// ValidateListUserRolesRequest 校验 ListUserRolesRequest 结构体的有效性.
+func (v *Validator) ValidateListUserRolesRequest(ctx context.Context, rq *apiv1.ListUserRolesRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePolicyRules())
+}
+
+// ValidateListRoleUsersRequest 校验 ListRoleUsersRequest 结构体的有效性.
+func (v *Validator) ValidateListRoleUsersRequest(ctx context.Context, rq *apiv1.ListRoleUsersRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePolicyRules())
+}
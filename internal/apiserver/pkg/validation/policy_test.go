@@ -0,0 +1,36 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+func TestValidatePolicy(t *testing.T) {
+	valid := []*apiv1.Policy{
+		{Subject: "role::admin", Object: "*", Action: "*", Effect: "allow"},
+		{Subject: "role::user", Object: "/v1/users/*/unlock", Action: "POST", Effect: "deny"},
+		{Subject: "role::user", Object: "/v1.MiniBlog/UnlockUser", Action: "CALL", Effect: "deny"},
+		{Subject: "user-000000", Object: "/v1/policies", Action: "GET", Effect: "allow"},
+	}
+	for _, policy := range valid {
+		assert.NoError(t, validatePolicy(policy), policy.String())
+	}
+
+	invalid := []*apiv1.Policy{
+		nil,
+		{Subject: "", Object: "*", Action: "*", Effect: "allow"},
+		{Subject: "admin", Object: "*", Action: "*", Effect: "allow"},
+		{Subject: "role::Admin", Object: "*", Action: "*", Effect: "allow"},
+		{Subject: "role::user", Object: "v1/posts", Action: "GET", Effect: "deny"},
+		{Subject: "role::user", Object: "/v1/posts ", Action: "GET", Effect: "deny"},
+		{Subject: "role::user", Object: "/v1/posts", Action: "get", Effect: "deny"},
+		{Subject: "role::user", Object: "/v1/posts", Action: "GET", Effect: "block"},
+		{Subject: "role::user", Object: "/v1/posts", Action: "GET", Effect: ""},
+	}
+	for _, policy := range invalid {
+		assert.Error(t, validatePolicy(policy), policy.String())
+	}
+}
@@ -7,10 +7,14 @@ import (
 	"github.com/go-ozzo/ozzo-validation/v4/is"
 	genericvalidation "github.com/ra1n6ow/gpkg/validation"
 
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/locale"
 	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
 	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
 )
 
+// maxLocalePreferenceLength 为获取博客时 locale 字段（语言列表）的最大长度.
+const maxLocalePreferenceLength = 256
+
 // Validate 校验字段的有效性.
 func (v *Validator) ValidatePostRules() genericvalidation.Rules {
 	// 定义各字段的校验逻辑，通过一个 map 实现模块化和简化
@@ -38,11 +42,21 @@ func (v *Validator) ValidatePostRules() genericvalidation.Rules {
 
 // ValidateCreatePostRequest 校验 CreatePostRequest 结构体的有效性.
 func (v *Validator) ValidateCreatePostRequest(ctx context.Context, rq *apiv1.CreatePostRequest) error {
+	if rq.Locale != nil {
+		if err := validateLocale(rq.GetLocale()); err != nil {
+			return err
+		}
+	}
 	return genericvalidation.ValidateAllFields(rq, v.ValidatePostRules())
 }
 
 // ValidateUpdatePostRequest 校验更新用户请求.
 func (v *Validator) ValidateUpdatePostRequest(ctx context.Context, rq *apiv1.UpdatePostRequest) error {
+	if rq.Locale != nil {
+		if err := validateLocale(rq.GetLocale()); err != nil {
+			return err
+		}
+	}
 	return genericvalidation.ValidateAllFields(rq, v.ValidatePostRules())
 }
 
@@ -52,7 +66,11 @@ func (v *Validator) ValidateDeletePostRequest(ctx context.Context, rq *apiv1.Del
 }
 
 // ValidateGetPostRequest 校验 GetPostRequest 结构体的有效性.
+// locale 可以是 Accept-Language 格式的语言列表，无法解析时返回默认语言的版本，所以这里只限制长度.
 func (v *Validator) ValidateGetPostRequest(ctx context.Context, rq *apiv1.GetPostRequest) error {
+	if len(rq.GetLocale()) > maxLocalePreferenceLength {
+		return errno.ErrInvalidArgument.WithMessage("locale must be at most %d characters long", maxLocalePreferenceLength)
+	}
 	return genericvalidation.ValidateAllFields(rq, v.ValidatePostRules())
 }
 
@@ -61,5 +79,87 @@ func (v *Validator) ValidateListPostRequest(ctx context.Context, rq *apiv1.ListP
 	if err := validation.Validate(rq.GetTitle(), validation.Length(5, 100), is.URL); err != nil {
 		return errno.ErrInvalidArgument.WithMessage("%s", err.Error())
 	}
+	if rq.Locale != nil {
+		if err := validateLocale(rq.GetLocale()); err != nil {
+			return err
+		}
+	}
 	return genericvalidation.ValidateSelectedFields(rq, v.ValidatePostRules(), "Offset", "Limit")
 }
+
+// ValidateListMentionsRequest 校验 ListMentionsRequest 结构体的有效性.
+func (v *Validator) ValidateListMentionsRequest(ctx context.Context, rq *apiv1.ListMentionsRequest) error {
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidatePostRules(), "Offset", "Limit")
+}
+
+// ValidateListRelatedPostsRequest 校验 ListRelatedPostsRequest 结构体的有效性.
+func (v *Validator) ValidateListRelatedPostsRequest(ctx context.Context, rq *apiv1.ListRelatedPostsRequest) error {
+	if rq.GetLimit() < 0 || rq.GetLimit() > 50 {
+		return errno.ErrInvalidArgument.WithMessage("limit must be between 0 and 50")
+	}
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidatePostRules(), "PostID")
+}
+
+// ValidatePinPostRequest 校验 PinPostRequest 结构体的有效性.
+func (v *Validator) ValidatePinPostRequest(ctx context.Context, rq *apiv1.PinPostRequest) error {
+	if rq.Position != nil && rq.GetPosition() < 0 {
+		return errno.ErrInvalidArgument.WithMessage("position must be greater than or equal to 0")
+	}
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidatePostRules(), "PostID")
+}
+
+// ValidateUnpinPostRequest 校验 UnpinPostRequest 结构体的有效性.
+func (v *Validator) ValidateUnpinPostRequest(ctx context.Context, rq *apiv1.UnpinPostRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePostRules())
+}
+
+// ValidateFeaturePostRequest 校验 FeaturePostRequest 结构体的有效性.
+func (v *Validator) ValidateFeaturePostRequest(ctx context.Context, rq *apiv1.FeaturePostRequest) error {
+	if rq.Position != nil && rq.GetPosition() < 0 {
+		return errno.ErrInvalidArgument.WithMessage("position must be greater than or equal to 0")
+	}
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidatePostRules(), "PostID")
+}
+
+// ValidateUnfeaturePostRequest 校验 UnfeaturePostRequest 结构体的有效性.
+func (v *Validator) ValidateUnfeaturePostRequest(ctx context.Context, rq *apiv1.UnfeaturePostRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePostRules())
+}
+
+// ValidateCreatePostTranslationRequest 校验 CreatePostTranslationRequest 结构体的有效性.
+func (v *Validator) ValidateCreatePostTranslationRequest(ctx context.Context, rq *apiv1.CreatePostTranslationRequest) error {
+	if err := validateLocale(rq.GetLocale()); err != nil {
+		return err
+	}
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidatePostRules(), "PostID", "Title", "Content")
+}
+
+// ValidateUpdatePostTranslationRequest 校验 UpdatePostTranslationRequest 结构体的有效性.
+func (v *Validator) ValidateUpdatePostTranslationRequest(ctx context.Context, rq *apiv1.UpdatePostTranslationRequest) error {
+	if err := validateLocale(rq.GetLocale()); err != nil {
+		return err
+	}
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidatePostRules(), "PostID", "Title", "Content")
+}
+
+// ValidateDeletePostTranslationRequest 校验 DeletePostTranslationRequest 结构体的有效性.
+func (v *Validator) ValidateDeletePostTranslationRequest(ctx context.Context, rq *apiv1.DeletePostTranslationRequest) error {
+	if err := validateLocale(rq.GetLocale()); err != nil {
+		return err
+	}
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidatePostRules(), "PostID")
+}
+
+// validateLocale 校验语言标签的有效性.
+func validateLocale(tag string) error {
+	if tag == "" {
+		return errno.ErrInvalidArgument.WithMessage("locale cannot be empty")
+	}
+	if len(tag) > locale.MaxLength {
+		return errno.ErrInvalidArgument.WithMessage("locale must be at most %d characters long", locale.MaxLength)
+	}
+	if _, err := locale.Canonicalize(tag); err != nil {
+		return errno.ErrInvalidArgument.WithMessage("invalid locale %q", tag)
+	}
+	return nil
+}
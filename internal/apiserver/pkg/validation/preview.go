@@ -0,0 +1,66 @@
+package validation
+
+import (
+	"context"
+
+	genericvalidation "github.com/ra1n6ow/gpkg/validation"
+
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// ValidatePreviewRules 校验字段的有效性.
+func (v *Validator) ValidatePreviewRules() genericvalidation.Rules {
+	return genericvalidation.Rules{
+		"PostID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("postID cannot be empty")
+			}
+			return nil
+		},
+		"LinkID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("linkID cannot be empty")
+			}
+			return nil
+		},
+		"Ttl": func(value any) error {
+			if value.(int64) <= 0 {
+				return errno.ErrInvalidArgument.WithMessage("ttl must be greater than 0")
+			}
+			return nil
+		},
+		"Expires": func(value any) error {
+			if value.(int64) <= 0 {
+				return errno.ErrInvalidArgument.WithMessage("expires must be greater than 0")
+			}
+			return nil
+		},
+		"Signature": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("signature cannot be empty")
+			}
+			return nil
+		},
+	}
+}
+
+// ValidateCreatePreviewLinkRequest 校验 CreatePreviewLinkRequest 结构体的有效性.
+func (v *Validator) ValidateCreatePreviewLinkRequest(ctx context.Context, rq *apiv1.CreatePreviewLinkRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePreviewRules())
+}
+
+// ValidateListPreviewLinksRequest 校验 ListPreviewLinksRequest 结构体的有效性.
+func (v *Validator) ValidateListPreviewLinksRequest(ctx context.Context, rq *apiv1.ListPreviewLinksRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePreviewRules())
+}
+
+// ValidateRevokePreviewLinkRequest 校验 RevokePreviewLinkRequest 结构体的有效性.
+func (v *Validator) ValidateRevokePreviewLinkRequest(ctx context.Context, rq *apiv1.RevokePreviewLinkRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePreviewRules())
+}
+
+// ValidateGetPreviewRequest 校验 GetPreviewRequest 结构体的有效性.
+func (v *Validator) ValidateGetPreviewRequest(ctx context.Context, rq *apiv1.GetPreviewRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePreviewRules())
+}
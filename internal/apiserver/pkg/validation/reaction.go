@@ -0,0 +1,60 @@
+package validation
+
+import (
+	"context"
+
+	genericvalidation "github.com/ra1n6ow/gpkg/validation"
+
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// ValidateReactionRules 校验字段的有效性.
+func (v *Validator) ValidateReactionRules() genericvalidation.Rules {
+	return genericvalidation.Rules{
+		"PostID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("postID cannot be empty")
+			}
+			return nil
+		},
+		"Reaction": func(value any) error {
+			if _, ok := v.reactions[value.(string)]; !ok {
+				return errno.ErrInvalidArgument.WithMessage("unsupported reaction: %q", value.(string))
+			}
+			return nil
+		},
+		"Offset": func(value any) error {
+			if value.(int64) < 0 {
+				return errno.ErrInvalidArgument.WithMessage("offset must be greater than or equal to 0")
+			}
+			return nil
+		},
+		"Limit": func(value any) error {
+			if value.(int64) <= 0 {
+				return errno.ErrInvalidArgument.WithMessage("limit must be greater than 0")
+			}
+			return nil
+		},
+	}
+}
+
+// ValidateAddReactionRequest 校验 AddReactionRequest 结构体的有效性.
+func (v *Validator) ValidateAddReactionRequest(ctx context.Context, rq *apiv1.AddReactionRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateReactionRules())
+}
+
+// ValidateRemoveReactionRequest 校验 RemoveReactionRequest 结构体的有效性.
+func (v *Validator) ValidateRemoveReactionRequest(ctx context.Context, rq *apiv1.RemoveReactionRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateReactionRules())
+}
+
+// ValidateListReactorsRequest 校验 ListReactorsRequest 结构体的有效性.
+func (v *Validator) ValidateListReactorsRequest(ctx context.Context, rq *apiv1.ListReactorsRequest) error {
+	if rq.Reaction != nil {
+		if err := v.ValidateReactionRules()["Reaction"](rq.GetReaction()); err != nil {
+			return err
+		}
+	}
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidateReactionRules(), "PostID", "Offset", "Limit")
+}
@@ -0,0 +1,32 @@
+package validation
+
+import (
+	"context"
+
+	genericvalidation "github.com/ra1n6ow/gpkg/validation"
+
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// ValidateSessionRules 校验字段的有效性.
+func (v *Validator) ValidateSessionRules() genericvalidation.Rules {
+	return genericvalidation.Rules{
+		"SessionID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("sessionID cannot be empty")
+			}
+			return nil
+		},
+	}
+}
+
+// ValidateListSessionsRequest 校验 ListSessionsRequest 结构体的有效性.
+func (v *Validator) ValidateListSessionsRequest(ctx context.Context, rq *apiv1.ListSessionsRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateSessionRules())
+}
+
+// ValidateRevokeSessionRequest 校验 RevokeSessionRequest 结构体的有效性.
+func (v *Validator) ValidateRevokeSessionRequest(ctx context.Context, rq *apiv1.RevokeSessionRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateSessionRules())
+}
@@ -0,0 +1,106 @@
+package validation
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	genericvalidation "github.com/ra1n6ow/gpkg/validation"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/placeholder"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// maxTemplateVariables 为从模板创建博客时最多可以传入的自定义占位符数量.
+const maxTemplateVariables = 50
+
+// ValidateTemplateRules 校验字段的有效性.
+func (v *Validator) ValidateTemplateRules() genericvalidation.Rules {
+	return genericvalidation.Rules{
+		"TemplateID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("templateID cannot be empty")
+			}
+			return nil
+		},
+		"Name": func(value any) error {
+			if n := utf8.RuneCountInString(value.(string)); n < 1 || n > 64 {
+				return errno.ErrInvalidArgument.WithMessage("name must be between 1 and 64 characters")
+			}
+			return nil
+		},
+		"Title": func(value any) error {
+			if n := utf8.RuneCountInString(value.(string)); n < 1 || n > 256 {
+				return errno.ErrInvalidArgument.WithMessage("title must be between 1 and 256 characters")
+			}
+			return nil
+		},
+		"Content": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("content cannot be empty")
+			}
+			return nil
+		},
+		"Offset": func(value any) error {
+			if value.(int64) < 0 {
+				return errno.ErrInvalidArgument.WithMessage("offset must be greater than or equal to 0")
+			}
+			return nil
+		},
+		"Limit": func(value any) error {
+			if value.(int64) < 0 {
+				return errno.ErrInvalidArgument.WithMessage("limit must be greater than or equal to 0")
+			}
+			return nil
+		},
+	}
+}
+
+// ValidateCreatePostTemplateRequest 校验 CreatePostTemplateRequest 结构体的有效性.
+func (v *Validator) ValidateCreatePostTemplateRequest(ctx context.Context, rq *apiv1.CreatePostTemplateRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateTemplateRules())
+}
+
+// ValidateUpdatePostTemplateRequest 校验 UpdatePostTemplateRequest 结构体的有效性.
+func (v *Validator) ValidateUpdatePostTemplateRequest(ctx context.Context, rq *apiv1.UpdatePostTemplateRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateTemplateRules())
+}
+
+// ValidateDeletePostTemplateRequest 校验 DeletePostTemplateRequest 结构体的有效性.
+func (v *Validator) ValidateDeletePostTemplateRequest(ctx context.Context, rq *apiv1.DeletePostTemplateRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateTemplateRules())
+}
+
+// ValidateGetPostTemplateRequest 校验 GetPostTemplateRequest 结构体的有效性.
+func (v *Validator) ValidateGetPostTemplateRequest(ctx context.Context, rq *apiv1.GetPostTemplateRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateTemplateRules())
+}
+
+// ValidateListPostTemplateRequest 校验 ListPostTemplateRequest 结构体的有效性.
+func (v *Validator) ValidateListPostTemplateRequest(ctx context.Context, rq *apiv1.ListPostTemplateRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateTemplateRules())
+}
+
+// ValidateCreatePostFromTemplateRequest 校验 CreatePostFromTemplateRequest 结构体的有效性.
+func (v *Validator) ValidateCreatePostFromTemplateRequest(ctx context.Context, rq *apiv1.CreatePostFromTemplateRequest) error {
+	if rq.Date != nil {
+		if _, err := time.Parse(time.DateOnly, rq.GetDate()); err != nil {
+			return errno.ErrInvalidArgument.WithMessage("date must be in YYYY-MM-DD format")
+		}
+	}
+	if rq.Locale != nil {
+		if err := validateLocale(rq.GetLocale()); err != nil {
+			return err
+		}
+	}
+	if len(rq.GetVariables()) > maxTemplateVariables {
+		return errno.ErrInvalidArgument.WithMessage("at most %d variables are allowed", maxTemplateVariables)
+	}
+	for name := range rq.GetVariables() {
+		if !placeholder.ValidName(name) {
+			return errno.ErrInvalidArgument.WithMessage("invalid variable name %q: must start with a letter and contain only letters, digits and underscores", name)
+		}
+	}
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidateTemplateRules(), "TemplateID")
+}
@@ -101,4 +101,80 @@ func (v *Validator) ValidateGetUserRequest(ctx context.Context, rq *apiv1.GetUse
 // ValidateListUserRequest 校验 ListUserRequest 结构体的有效性.
 func (v *Validator) ValidateListUserRequest(ctx context.Context, rq *apiv1.ListUserRequest) error {
 	return genericvalidation.ValidateAllFields(rq, v.ValidateUserRules())
-}
\ No newline at end of file
+}
+// ValidateSendVerificationEmailRequest 校验 SendVerificationEmailRequest 结构体的有效性.
+func (v *Validator) ValidateSendVerificationEmailRequest(ctx context.Context, rq *apiv1.SendVerificationEmailRequest) error {
+	if rq.GetUserID() != contextx.UserID(ctx) {
+		return errno.ErrPermissionDenied.WithMessage("The logged-in user `%s` does not match request user `%s`", contextx.UserID(ctx), rq.GetUserID())
+	}
+	return nil
+}
+
+// ValidateVerifyEmailRequest 校验 VerifyEmailRequest 结构体的有效性.
+func (v *Validator) ValidateVerifyEmailRequest(ctx context.Context, rq *apiv1.VerifyEmailRequest) error {
+	if rq.GetToken() == "" {
+		return errno.ErrInvalidArgument.WithMessage("token cannot be empty")
+	}
+	return nil
+}
+
+// ValidateRequestPasswordResetRequest 校验 RequestPasswordResetRequest 结构体的有效性.
+func (v *Validator) ValidateRequestPasswordResetRequest(ctx context.Context, rq *apiv1.RequestPasswordResetRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateUserRules())
+}
+
+// ValidateResetPasswordRequest 校验 ResetPasswordRequest 结构体的有效性.
+func (v *Validator) ValidateResetPasswordRequest(ctx context.Context, rq *apiv1.ResetPasswordRequest) error {
+	if rq.GetToken() == "" {
+		return errno.ErrInvalidArgument.WithMessage("token cannot be empty")
+	}
+	return genericvalidation.ValidateAllFields(rq, v.ValidateUserRules())
+}
+
+// ValidateUnlockUserRequest 校验 UnlockUserRequest 结构体的有效性.
+func (v *Validator) ValidateUnlockUserRequest(ctx context.Context, rq *apiv1.UnlockUserRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateUserRules())
+}
+
+// ValidateEnrollTwoFactorRequest 校验 EnrollTwoFactorRequest 结构体的有效性.
+func (v *Validator) ValidateEnrollTwoFactorRequest(ctx context.Context, rq *apiv1.EnrollTwoFactorRequest) error {
+	if rq.GetUserID() != contextx.UserID(ctx) {
+		return errno.ErrPermissionDenied.WithMessage("The logged-in user `%s` does not match request user `%s`", contextx.UserID(ctx), rq.GetUserID())
+	}
+	return nil
+}
+
+// ValidateConfirmTwoFactorRequest 校验 ConfirmTwoFactorRequest 结构体的有效性.
+func (v *Validator) ValidateConfirmTwoFactorRequest(ctx context.Context, rq *apiv1.ConfirmTwoFactorRequest) error {
+	if rq.GetUserID() != contextx.UserID(ctx) {
+		return errno.ErrPermissionDenied.WithMessage("The logged-in user `%s` does not match request user `%s`", contextx.UserID(ctx), rq.GetUserID())
+	}
+	if rq.GetCode() == "" {
+		return errno.ErrInvalidArgument.WithMessage("code cannot be empty")
+	}
+	return nil
+}
+
+// ValidateVerifyTwoFactorRequest 校验 VerifyTwoFactorRequest 结构体的有效性.
+func (v *Validator) ValidateVerifyTwoFactorRequest(ctx context.Context, rq *apiv1.VerifyTwoFactorRequest) error {
+	if rq.GetChallengeToken() == "" {
+		return errno.ErrInvalidArgument.WithMessage("challengeToken cannot be empty")
+	}
+	if rq.GetCode() == "" {
+		return errno.ErrInvalidArgument.WithMessage("code cannot be empty")
+	}
+	return nil
+}
+
+// ValidateResetTwoFactorRequest 校验 ResetTwoFactorRequest 结构体的有效性.
+func (v *Validator) ValidateResetTwoFactorRequest(ctx context.Context, rq *apiv1.ResetTwoFactorRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateUserRules())
+}
+
+// ValidateRefreshTokenRequest 校验 RefreshTokenRequest 结构体的有效性.
+func (v *Validator) ValidateRefreshTokenRequest(ctx context.Context, rq *apiv1.RefreshTokenRequest) error {
+	if rq.GetRefreshToken() == "" {
+		return errno.ErrInvalidArgument.WithMessage("refreshToken cannot be empty")
+	}
+	return nil
+}
@@ -18,8 +18,13 @@ type Validator struct {
 	// 这里只是一个举例，如果验证时，有其他依赖的客户端/服务/资源等，
 	// 都可以一并注入进来
 	store store.IStore
+	// reactions 为允许使用的反应类型.
+	reactions map[string]struct{}
 }
 
+// ReactionSet 定义允许使用的反应类型（表情）集合.
+type ReactionSet []string
+
 // 使用预编译的全局正则表达式，避免重复创建和编译.
 var (
 	lengthRegex = regexp.MustCompile(`^.{3,20}$`)                                        // 长度在 3 到 20 个字符之间
@@ -31,8 +36,12 @@ var (
 )
 
 // New 创建一个新的 Validator 实例.
-func New(store store.IStore) *Validator {
-	return &Validator{store: store}
+func New(store store.IStore, reactions ReactionSet) *Validator {
+	v := &Validator{store: store, reactions: make(map[string]struct{}, len(reactions))}
+	for _, reaction := range reactions {
+		v.reactions[reaction] = struct{}{}
+	}
+	return v
 }
 
 // isValidUsername 校验用户名是否合法.
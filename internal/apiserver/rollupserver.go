@@ -0,0 +1,78 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+package apiserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/biz"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+	"github.com/ra1n6ow/miniblog/internal/pkg/server"
+)
+
+// rollupServer 在服务器运行期间，定期将博客和用户数据汇总到统计表中.
+type rollupServer struct {
+	srv      server.Server
+	biz      biz.IBiz
+	interval time.Duration
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// 确保 *rollupServer 实现了 server.Server 接口.
+var _ server.Server = (*rollupServer)(nil)
+
+// NewRollupServer 创建一个在 srv 运行期间执行统计数据汇总任务的服务器.
+func (c *ServerConfig) NewRollupServer(srv server.Server) server.Server {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &rollupServer{
+		srv:      srv,
+		biz:      c.biz,
+		interval: c.cfg.AnalyticsInterval,
+		ctx:      ctx,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+}
+
+// RunOrDie 启动统计数据汇总任务和被包装的服务器.
+func (s *rollupServer) RunOrDie() {
+	go s.loop()
+	s.srv.RunOrDie()
+}
+
+// GracefulStop 先停止统计数据汇总任务，再优雅停止被包装的服务器.
+func (s *rollupServer) GracefulStop(ctx context.Context) {
+	s.cancel()
+	select {
+	case <-s.done:
+	case <-ctx.Done():
+	}
+	s.srv.GracefulStop(ctx)
+}
+
+// loop 启动后立即汇总一次，之后每隔 interval 汇总一次，直到任务被停止.
+func (s *rollupServer) loop() {
+	defer close(s.done)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.biz.AnalyticsV1().Rollup(s.ctx); err != nil && s.ctx.Err() == nil {
+			log.Errorw("Failed to roll up analytics", "err", err)
+		}
+
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+package apiserver
+
+import (
+	"github.com/ra1n6ow/miniblog/internal/pkg/scope"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+// 个人访问令牌只能访问下面列出的接口，权限范围在 casbin 授权之外额外生效.
+// 修改密码、两步验证、登录会话、个人访问令牌和授权策略管理等账号安全相关的接口不允许使用个人访问令牌访问.
+// 修改用户信息的接口同样不允许，否则泄露的令牌可以修改邮箱后通过重置密码接管账号.
+// 新增接口时需要同时更新 HTTP 路由和 gRPC 方法两个列表.
+
+// httpScopes 定义使用个人访问令牌访问 HTTP 路由需要的权限范围，key 为请求方法和路由.
+var httpScopes = map[string]string{
+	"GET /v1/users/:userID":               scope.UsersRead,
+	"GET /v1/users":                       scope.UsersRead,
+	"DELETE /v1/users/:userID":            scope.UsersWrite,
+	"POST /v1/users/:userID/unlock":       scope.UsersWrite,
+	"DELETE /v1/users/:userID/two-factor": scope.UsersWrite,
+
+	"POST /v1/posts":                                 scope.PostsWrite,
+	"PUT /v1/posts/:postID":                          scope.PostsWrite,
+	"DELETE /v1/posts":                               scope.PostsWrite,
+	"GET /v1/posts/:postID":                          scope.PostsRead,
+	"GET /v1/posts":                                  scope.PostsRead,
+	"POST /v1/posts/:postID/reactions":               scope.PostsWrite,
+	"DELETE /v1/posts/:postID/reactions":             scope.PostsWrite,
+	"GET /v1/posts/:postID/reactors":                 scope.PostsRead,
+	"GET /v1/posts/:postID/related":                  scope.PostsRead,
+	"POST /v1/posts/:postID/pin":                     scope.PostsWrite,
+	"DELETE /v1/posts/:postID/pin":                   scope.PostsWrite,
+	"POST /v1/posts/:postID/feature":                 scope.PostsWrite,
+	"DELETE /v1/posts/:postID/feature":               scope.PostsWrite,
+	"POST /v1/posts/:postID/translations":            scope.PostsWrite,
+	"PUT /v1/posts/:postID/translations/:locale":     scope.PostsWrite,
+	"DELETE /v1/posts/:postID/translations/:locale":  scope.PostsWrite,
+	"POST /v1/posts/:postID/preview-links":           scope.PostsWrite,
+	"GET /v1/posts/:postID/preview-links":            scope.PostsRead,
+	"DELETE /v1/posts/:postID/preview-links/:linkID": scope.PostsWrite,
+	"POST /v1/bookmarks":                             scope.PostsWrite,
+	"DELETE /v1/bookmarks/:postID":                   scope.PostsWrite,
+	"GET /v1/bookmarks":                              scope.PostsRead,
+	"PUT /v1/bookmarks/order":                        scope.PostsWrite,
+	"GET /v1/reading-lists":                          scope.PostsRead,
+	"GET /v1/mentions":                               scope.PostsRead,
+	"POST /v1/post-templates":                        scope.PostsWrite,
+	"PUT /v1/post-templates/:templateID":             scope.PostsWrite,
+	"DELETE /v1/post-templates/:templateID":          scope.PostsWrite,
+	"GET /v1/post-templates/:templateID":             scope.PostsRead,
+	"GET /v1/post-templates":                         scope.PostsRead,
+	"POST /v1/post-templates/:templateID/posts":      scope.PostsWrite,
+	"GET /v1/analytics/posts":                        scope.PostsRead,
+	"GET /v1/analytics/top-authors":                  scope.PostsRead,
+	"GET /v1/analytics/site":                         scope.PostsRead,
+	"GET /v1/analytics/me":                           scope.PostsRead,
+}
+
+// grpcScopes 定义使用个人访问令牌调用 gRPC 方法需要的权限范围，key 为 gRPC 方法全名.
+var grpcScopes = map[string]string{
+	apiv1.MiniBlog_GetUser_FullMethodName:        scope.UsersRead,
+	apiv1.MiniBlog_ListUser_FullMethodName:       scope.UsersRead,
+	apiv1.MiniBlog_DeleteUser_FullMethodName:     scope.UsersWrite,
+	apiv1.MiniBlog_UnlockUser_FullMethodName:     scope.UsersWrite,
+	apiv1.MiniBlog_ResetTwoFactor_FullMethodName: scope.UsersWrite,
+
+	apiv1.MiniBlog_CreatePost_FullMethodName:             scope.PostsWrite,
+	apiv1.MiniBlog_UpdatePost_FullMethodName:             scope.PostsWrite,
+	apiv1.MiniBlog_DeletePost_FullMethodName:             scope.PostsWrite,
+	apiv1.MiniBlog_GetPost_FullMethodName:                scope.PostsRead,
+	apiv1.MiniBlog_ListPost_FullMethodName:               scope.PostsRead,
+	apiv1.MiniBlog_AddReaction_FullMethodName:            scope.PostsWrite,
+	apiv1.MiniBlog_RemoveReaction_FullMethodName:         scope.PostsWrite,
+	apiv1.MiniBlog_ListReactors_FullMethodName:           scope.PostsRead,
+	apiv1.MiniBlog_ListRelatedPosts_FullMethodName:       scope.PostsRead,
+	apiv1.MiniBlog_PinPost_FullMethodName:                scope.PostsWrite,
+	apiv1.MiniBlog_UnpinPost_FullMethodName:              scope.PostsWrite,
+	apiv1.MiniBlog_FeaturePost_FullMethodName:            scope.PostsWrite,
+	apiv1.MiniBlog_UnfeaturePost_FullMethodName:          scope.PostsWrite,
+	apiv1.MiniBlog_CreatePostTranslation_FullMethodName:  scope.PostsWrite,
+	apiv1.MiniBlog_UpdatePostTranslation_FullMethodName:  scope.PostsWrite,
+	apiv1.MiniBlog_DeletePostTranslation_FullMethodName:  scope.PostsWrite,
+	apiv1.MiniBlog_CreatePreviewLink_FullMethodName:      scope.PostsWrite,
+	apiv1.MiniBlog_ListPreviewLinks_FullMethodName:       scope.PostsRead,
+	apiv1.MiniBlog_RevokePreviewLink_FullMethodName:      scope.PostsWrite,
+	apiv1.MiniBlog_AddBookmark_FullMethodName:            scope.PostsWrite,
+	apiv1.MiniBlog_RemoveBookmark_FullMethodName:         scope.PostsWrite,
+	apiv1.MiniBlog_ListBookmark_FullMethodName:           scope.PostsRead,
+	apiv1.MiniBlog_ReorderBookmarks_FullMethodName:       scope.PostsWrite,
+	apiv1.MiniBlog_ListReadingList_FullMethodName:        scope.PostsRead,
+	apiv1.MiniBlog_ListMentions_FullMethodName:           scope.PostsRead,
+	apiv1.MiniBlog_CreatePostTemplate_FullMethodName:     scope.PostsWrite,
+	apiv1.MiniBlog_UpdatePostTemplate_FullMethodName:     scope.PostsWrite,
+	apiv1.MiniBlog_DeletePostTemplate_FullMethodName:     scope.PostsWrite,
+	apiv1.MiniBlog_GetPostTemplate_FullMethodName:        scope.PostsRead,
+	apiv1.MiniBlog_ListPostTemplate_FullMethodName:       scope.PostsRead,
+	apiv1.MiniBlog_CreatePostFromTemplate_FullMethodName: scope.PostsWrite,
+	apiv1.MiniBlog_ListPostActivity_FullMethodName:       scope.PostsRead,
+	apiv1.MiniBlog_ListTopAuthors_FullMethodName:         scope.PostsRead,
+	apiv1.MiniBlog_ListSiteStats_FullMethodName:          scope.PostsRead,
+	apiv1.MiniBlog_GetMyPostStats_FullMethodName:         scope.PostsRead,
+}
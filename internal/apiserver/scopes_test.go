@@ -0,0 +1,62 @@
+package apiserver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/ra1n6ow/miniblog/internal/pkg/contextx"
+	mw "github.com/ra1n6ow/miniblog/internal/pkg/middleware/gin"
+	grpcmw "github.com/ra1n6ow/miniblog/internal/pkg/middleware/grpc"
+	"github.com/ra1n6ow/miniblog/internal/pkg/scope"
+	apiv1 "github.com/ra1n6ow/miniblog/pkg/api/apiserver/v1"
+)
+
+func TestScopeMiddlewareRejectsUpdateUser(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name    string
+		method  string
+		path    string
+		route   string
+		scopes  []string
+		allowed bool
+	}{
+		// 个人访问令牌不能修改邮箱，否则可以通过重置密码接管账号
+		{"update user with users:write", http.MethodPut, "/v1/users/user-1", "/v1/users/:userID", []string{scope.UsersWrite}, false},
+		{"update user with all scopes", http.MethodPut, "/v1/users/user-1", "/v1/users/:userID", scope.All(), false},
+		{"delete user with users:write", http.MethodDelete, "/v1/users/user-1", "/v1/users/:userID", []string{scope.UsersWrite}, true},
+		{"get user with users:read", http.MethodGet, "/v1/users/user-1", "/v1/users/:userID", []string{scope.UsersRead}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := gin.New()
+			engine.Use(func(c *gin.Context) {
+				c.Request = c.Request.WithContext(contextx.WithScopes(c.Request.Context(), tt.scopes))
+			}, mw.ScopeMiddleware(httpScopes))
+			handled := false
+			engine.Handle(tt.method, tt.route, func(c *gin.Context) { handled = true })
+
+			engine.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(tt.method, tt.path, nil))
+			assert.Equal(t, tt.allowed, handled)
+		})
+	}
+}
+
+func TestScopeInterceptorRejectsUpdateUser(t *testing.T) {
+	interceptor := grpcmw.ScopeInterceptor(grpcScopes)
+	ctx := contextx.WithScopes(context.Background(), scope.All())
+	handler := func(ctx context.Context, req any) (any, error) { return nil, nil }
+
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: apiv1.MiniBlog_UpdateUser_FullMethodName}, handler)
+	assert.Error(t, err)
+
+	_, err = interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: apiv1.MiniBlog_DeleteUser_FullMethodName}, handler)
+	assert.NoError(t, err)
+}
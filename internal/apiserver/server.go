@@ -17,12 +17,21 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/ra1n6ow/miniblog/internal/apiserver/biz"
-	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/biz/v1/post"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/biz/v1/preview"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/biz/v1/user"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/handler/web"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/related"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/revocation"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/usercache"
 	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/validation"
 	"github.com/ra1n6ow/miniblog/internal/apiserver/store"
+	"github.com/ra1n6ow/miniblog/internal/pkg/clientip"
 	"github.com/ra1n6ow/miniblog/internal/pkg/contextx"
 	"github.com/ra1n6ow/miniblog/internal/pkg/known"
 	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+	"github.com/ra1n6ow/miniblog/internal/pkg/mailer"
 	mw "github.com/ra1n6ow/miniblog/internal/pkg/middleware/gin"
 	"github.com/ra1n6ow/miniblog/internal/pkg/server"
 	"github.com/ra1n6ow/miniblog/pkg/auth"
@@ -41,6 +50,9 @@ const (
 	GinServerMode = "gin"
 )
 
+// jwksPath 为发布签名公钥（JWKS）的路径.
+const jwksPath = "/.well-known/jwks.json"
+
 // Config 配置结构体，用于存储应用相关的配置.
 type Config struct {
 	ServerMode   string
@@ -50,6 +62,30 @@ type Config struct {
 	GRPCOptions  *genericoptions.GRPCOptions
 	HTTPOptions  *genericoptions.HTTPOptions
 	MySQLOptions *genericoptions.MySQLOptions
+	// JWTKeys 定义签发 token 的非对称密钥，为 nil 时使用 JWTKey 签发 HS256 token.
+	JWTKeys *token.KeySet
+	// ClientIP 根据可信代理解析请求的客户端 IP.
+	ClientIP *clientip.Resolver
+	// EnableWeb 定义是否启用内置的 HTML 前端.
+	EnableWeb bool
+	// WebThemeDir 定义 HTML 前端的自定义主题目录.
+	WebThemeDir string
+	// Reactions 定义允许对博客使用的反应类型（表情）.
+	Reactions validation.ReactionSet
+	// PostOptions 定义博客业务的可配置项，包括置顶数量上限和重复博客检测策略.
+	PostOptions *post.Options
+	// AnalyticsInterval 定义后台任务汇总统计数据的时间间隔.
+	AnalyticsInterval time.Duration
+	// PreviewOptions 定义预览链接业务的可配置项，包括签名密钥和有效期.
+	PreviewOptions *preview.Options
+	// PreviewRateLimit 定义每个客户端 IP 每分钟最多可以访问预览链接的次数.
+	PreviewRateLimit int
+	// UserOptions 定义用户业务的可配置项，包括邮箱验证相关的配置.
+	UserOptions *user.Options
+	// PasswordResetRateLimit 定义每个客户端 IP 每分钟最多可以调用重置密码接口的次数.
+	PasswordResetRateLimit int
+	// MailerOptions 定义发送邮件的方式.
+	MailerOptions *mailer.Options
 }
 
 // UnionServer 定义一个联合服务器. 根据 ServerMode 决定要启动的服务器类型.
@@ -71,7 +107,10 @@ type ServerConfig struct {
 	biz       biz.IBiz
 	val       *validation.Validator
 	retriever mw.UserRetriever
+	checker   mw.TokenChecker
 	authz     *auth.Authz
+	// web 为内置 HTML 前端的处理器，未启用时为 nil.
+	web *web.Handler
 }
 
 // NewUnionServer 根据配置创建联合服务器.
@@ -91,7 +130,7 @@ func (cfg *Config) NewUnionServer() (*UnionServer, error) {
 	*/
 
 	// 初始化 token 包的签名密钥、认证 Key 及 Token 默认过期时间
-	token.Init(cfg.JWTKey, known.XUserID, cfg.Expiration)
+	token.Init(cfg.JWTKey, known.XUserID, cfg.Expiration, token.WithKeySet(cfg.JWTKeys))
 
 	log.Infow("Initializing federation server", "server-mode", cfg.ServerMode)
 
@@ -103,7 +142,7 @@ func (cfg *Config) NewUnionServer() (*UnionServer, error) {
 		var srv server.Server
 		switch cfg.ServerMode {
 		case GinServerMode:
-			srv, err = serverConfig.NewGinServer(), nil
+			srv, err = serverConfig.NewGinServer()
 		default:
 			srv, err = serverConfig.NewGRPCServerOr()
 		}
@@ -159,11 +198,29 @@ func (cfg *Config) NewServerConfig() (*ServerConfig, error) {
 		return nil, err
 	}
 
+	// 初始化相关博客索引
+	index, err := ProvideRelatedIndex(store)
+	if err != nil {
+		return nil, err
+	}
+
+	// 初始化邮件发送器
+	mailer, err := ProvideMailer(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// 初始化访问令牌撤销列表
+	revocations := revocation.New(store)
+	// 初始化用户信息缓存
+	users := usercache.New(store)
+
 	return &ServerConfig{
 		cfg:       cfg,
-		biz:       biz.NewBiz(store, authz),
-		val:       validation.New(store),
-		retriever: &UserRetriever{store: store},
+		biz:       biz.NewBiz(store, authz, index, cfg.PostOptions, cfg.PreviewOptions, cfg.UserOptions, mailer, revocations, users),
+		val:       validation.New(store, cfg.Reactions),
+		retriever: users,
+		checker:   revocations,
 		authz:     authz,
 	}, nil
 }
@@ -173,14 +230,33 @@ func (cfg *Config) NewDB() (*gorm.DB, error) {
 	return cfg.MySQLOptions.NewDB()
 }
 
-// UserRetriever 定义一个用户数据获取器. 用来获取用户信息.
-type UserRetriever struct {
-	store store.IStore
+// ProvideWebHandler 根据配置提供内置 HTML 前端的处理器，未启用时返回 nil.
+func ProvideWebHandler(cfg *Config, biz biz.IBiz, val *validation.Validator, retriever mw.UserRetriever, checker mw.TokenChecker, authz *auth.Authz) (*web.Handler, error) {
+	if !cfg.EnableWeb {
+		return nil, nil
+	}
+	return web.NewHandler(biz, val, retriever, checker, authz, cfg.ClientIP, cfg.WebThemeDir)
+}
+
+// ProvideRelatedIndex 从数据库中加载所有博客，构建相关博客索引. 之后索引由 PostBiz 增量维护.
+func ProvideRelatedIndex(store store.IStore) (*related.Index, error) {
+	_, posts, err := store.Post().List(context.Background(), where.NewWhere())
+	if err != nil {
+		return nil, err
+	}
+
+	index := related.NewIndex()
+	for _, post := range posts {
+		index.Put(conversion.PostModelToDocument(post))
+	}
+	log.Infow("Related posts index built", "posts", index.Len())
+
+	return index, nil
 }
 
-// GetUser 根据用户 ID 获取用户信息.
-func (r *UserRetriever) GetUser(ctx context.Context, userID string) (*model.UserM, error) {
-	return r.store.User().Get(ctx, where.F("userID", userID))
+// ProvideMailer 根据配置提供邮件发送器.
+func ProvideMailer(cfg *Config) (mailer.Mailer, error) {
+	return cfg.MailerOptions.NewMailer()
 }
 
 // ProvideDB 根据配置提供一个数据库实例。
@@ -193,10 +269,20 @@ func NewWebServer(serverMode string, serverConfig *ServerConfig) (server.Server,
 	// 实际企业开发中，可以根据需要只选择一种服务器模式.
 	// 这里为了方便给你展示，通过 cfg.ServerMode 同时支持了 Gin 和 GRPC 2 种服务器模式.
 	// 默认为 gRPC 服务器模式.
+	var srv server.Server
 	switch serverMode {
 	case GinServerMode:
-		return serverConfig.NewGinServer(), nil
+		var err error
+		if srv, err = serverConfig.NewGinServer(); err != nil {
+			return nil, err
+		}
 	default:
-		return serverConfig.NewGRPCServerOr()
+		var err error
+		if srv, err = serverConfig.NewGRPCServerOr(); err != nil {
+			return nil, err
+		}
 	}
+
+	// 统计数据汇总任务与服务器一起启动和关闭，3 种服务模式下行为一致
+	return serverConfig.NewRollupServer(srv), nil
 }
@@ -0,0 +1,100 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+)
+
+// AccessTokenStore 定义了 access_token 模块在 store 层所实现的方法.
+type AccessTokenStore interface {
+	Create(ctx context.Context, obj *model.AccessTokenM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.AccessTokenM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.AccessTokenM, error)
+
+	AccessTokenExpansion
+}
+
+// AccessTokenExpansion 定义了个人访问令牌操作的附加方法.
+type AccessTokenExpansion interface {
+	// Touch 更新令牌的最后使用时间.
+	Touch(ctx context.Context, tokenID string, lastUsedAt time.Time) error
+}
+
+// accessTokenStore 是 AccessTokenStore 接口的实现.
+type accessTokenStore struct {
+	store *datastore
+}
+
+// 确保 accessTokenStore 实现了 AccessTokenStore 接口.
+var _ AccessTokenStore = (*accessTokenStore)(nil)
+
+// newAccessTokenStore 创建 accessTokenStore 的实例.
+func newAccessTokenStore(store *datastore) *accessTokenStore {
+	return &accessTokenStore{store}
+}
+
+// Create 插入一条个人访问令牌记录.
+func (s *accessTokenStore) Create(ctx context.Context, obj *model.AccessTokenM) error {
+	if err := s.store.DB(ctx).Create(obj).Error; err != nil {
+		log.Errorw("Failed to insert access token into database", "err", err, "userID", obj.UserID)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Delete 根据条件删除个人访问令牌记录.
+func (s *accessTokenStore) Delete(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Delete(new(model.AccessTokenM)).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Errorw("Failed to delete access tokens from database", "err", err, "conditions", opts)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Get 根据条件查询个人访问令牌记录.
+func (s *accessTokenStore) Get(ctx context.Context, opts *where.Options) (*model.AccessTokenM, error) {
+	var obj model.AccessTokenM
+	if err := s.store.DB(ctx, opts).First(&obj).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ErrAccessTokenNotFound
+		}
+		log.Errorw("Failed to retrieve access token from database", "err", err, "conditions", opts)
+		return nil, errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+
+	return &obj, nil
+}
+
+// List 返回个人访问令牌列表和总数，最新创建的令牌排在前面.
+// nolint: nonamedreturns
+func (s *accessTokenStore) List(ctx context.Context, opts *where.Options) (count int64, ret []*model.AccessTokenM, err error) {
+	err = s.store.DB(ctx, opts).Order("id desc").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		log.Errorw("Failed to list access tokens from database", "err", err, "conditions", opts)
+		err = errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	return
+}
+
+// Touch 更新令牌的最后使用时间.
+func (s *accessTokenStore) Touch(ctx context.Context, tokenID string, lastUsedAt time.Time) error {
+	err := s.store.DB(ctx).Model(new(model.AccessTokenM)).Where("tokenID = ?", tokenID).Update("lastUsedAt", lastUsedAt).Error
+	if err != nil {
+		log.Errorw("Failed to update access token last used time", "err", err, "tokenID", tokenID)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,199 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+)
+
+// AnalyticsStore 定义了 analytics 模块在 store 层所实现的方法.
+// 统计数据由后台任务从 post 和 user 表汇总到 post_daily_stat 和 site_daily_stat 表中，
+// 查询统计数据时只读取汇总表，不需要扫描 post 表.
+type AnalyticsStore interface {
+	ListPostStats(ctx context.Context, opts *where.Options) (int64, []*model.PostDailyStatM, error)
+	ListSiteStats(ctx context.Context, opts *where.Options) (int64, []*model.SiteDailyStatM, error)
+
+	AnalyticsExpansion
+}
+
+// AuthorPostCount 表示用户在一段时间内创建的博客数量.
+type AuthorPostCount struct {
+	UserID    string `gorm:"column:userID"`
+	PostCount int64  `gorm:"column:postCount"`
+}
+
+// AnalyticsExpansion 定义了统计操作的附加方法.
+type AnalyticsExpansion interface {
+	// TopAuthors 返回 [from, to] 日期范围内创建博客最多的用户，按博客数量倒序排列.
+	TopAuthors(ctx context.Context, from time.Time, to time.Time, limit int) ([]*AuthorPostCount, error)
+	// LatestSiteStat 返回最近一天的全站统计，还没有汇总过时返回 nil.
+	LatestSiteStat(ctx context.Context) (*model.SiteDailyStatM, error)
+	// FirstUserCreatedAt 返回最早的用户创建时间，没有用户时返回零值.
+	FirstUserCreatedAt(ctx context.Context) (time.Time, error)
+	// CountPostsByDay 从 post 表中统计 since 及之后每个用户每天创建的博客数量.
+	CountPostsByDay(ctx context.Context, since time.Time) ([]*model.PostDailyStatM, error)
+	// CountUsersByDay 从 user 表中统计 since 及之后每天创建的用户数量，结果以日期（2006-01-02）为键.
+	CountUsersByDay(ctx context.Context, since time.Time) (map[string]int64, error)
+	// ReplacePostStats 删除 since 及之后的博客每日统计，并写入新的统计.
+	ReplacePostStats(ctx context.Context, since time.Time, stats []*model.PostDailyStatM) error
+	// ReplaceSiteStats 删除 since 及之后的全站每日统计，并写入新的统计.
+	ReplaceSiteStats(ctx context.Context, since time.Time, stats []*model.SiteDailyStatM) error
+}
+
+// analyticsStore 是 AnalyticsStore 接口的实现.
+type analyticsStore struct {
+	store *datastore
+}
+
+// 确保 analyticsStore 实现了 AnalyticsStore 接口.
+var _ AnalyticsStore = (*analyticsStore)(nil)
+
+// newAnalyticsStore 创建 analyticsStore 的实例.
+func newAnalyticsStore(store *datastore) *analyticsStore {
+	return &analyticsStore{store}
+}
+
+// ListPostStats 返回博客每日统计列表和总数，按日期和用户排序.
+// nolint: nonamedreturns
+func (s *analyticsStore) ListPostStats(ctx context.Context, opts *where.Options) (count int64, ret []*model.PostDailyStatM, err error) {
+	err = s.store.DB(ctx, opts).Order("day, userID").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		log.Errorw("Failed to list post stats from database", "err", err, "conditions", opts)
+		err = errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	return
+}
+
+// ListSiteStats 返回全站每日统计列表和总数，按日期排序.
+// nolint: nonamedreturns
+func (s *analyticsStore) ListSiteStats(ctx context.Context, opts *where.Options) (count int64, ret []*model.SiteDailyStatM, err error) {
+	err = s.store.DB(ctx, opts).Order("day").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		log.Errorw("Failed to list site stats from database", "err", err, "conditions", opts)
+		err = errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	return
+}
+
+// TopAuthors 返回 [from, to] 日期范围内创建博客最多的用户.
+func (s *analyticsStore) TopAuthors(ctx context.Context, from time.Time, to time.Time, limit int) ([]*AuthorPostCount, error) {
+	var ret []*AuthorPostCount
+	err := s.store.DB(ctx).Model(new(model.PostDailyStatM)).
+		Select("userID, SUM(postCount) AS postCount").
+		Where("day BETWEEN ? AND ?", from, to).
+		Group("userID").Order("postCount DESC, userID").Limit(limit).Scan(&ret).Error
+	if err != nil {
+		log.Errorw("Failed to list top authors from database", "err", err, "from", from, "to", to)
+		return nil, errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+
+	return ret, nil
+}
+
+// LatestSiteStat 返回最近一天的全站统计.
+func (s *analyticsStore) LatestSiteStat(ctx context.Context) (*model.SiteDailyStatM, error) {
+	var ret []*model.SiteDailyStatM
+	if err := s.store.DB(ctx).Order("day DESC").Limit(1).Find(&ret).Error; err != nil {
+		log.Errorw("Failed to get latest site stat from database", "err", err)
+		return nil, errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	if len(ret) == 0 {
+		return nil, nil
+	}
+
+	return ret[0], nil
+}
+
+// FirstUserCreatedAt 返回最早的用户创建时间.
+func (s *analyticsStore) FirstUserCreatedAt(ctx context.Context) (time.Time, error) {
+	var users []*model.UserM
+	if err := s.store.DB(ctx).Select("createdAt").Order("createdAt").Limit(1).Find(&users).Error; err != nil {
+		log.Errorw("Failed to get first user from database", "err", err)
+		return time.Time{}, errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	if len(users) == 0 {
+		return time.Time{}, nil
+	}
+
+	return users[0].CreatedAt, nil
+}
+
+// CountPostsByDay 从 post 表中统计 since 及之后每个用户每天创建的博客数量.
+func (s *analyticsStore) CountPostsByDay(ctx context.Context, since time.Time) ([]*model.PostDailyStatM, error) {
+	var ret []*model.PostDailyStatM
+	err := s.store.DB(ctx).Model(new(model.PostM)).
+		Select("DATE(createdAt) AS day, userID, COUNT(*) AS postCount").
+		Where("createdAt >= ?", since).
+		Group("DATE(createdAt), userID").Scan(&ret).Error
+	if err != nil {
+		log.Errorw("Failed to count posts by day from database", "err", err, "since", since)
+		return nil, errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+
+	return ret, nil
+}
+
+// CountUsersByDay 从 user 表中统计 since 及之后每天创建的用户数量.
+func (s *analyticsStore) CountUsersByDay(ctx context.Context, since time.Time) (map[string]int64, error) {
+	var rows []struct {
+		Day   time.Time `gorm:"column:day"`
+		Count int64     `gorm:"column:count"`
+	}
+	err := s.store.DB(ctx).Model(new(model.UserM)).
+		Select("DATE(createdAt) AS day, COUNT(*) AS count").
+		Where("createdAt >= ?", since).
+		Group("DATE(createdAt)").Scan(&rows).Error
+	if err != nil {
+		log.Errorw("Failed to count users by day from database", "err", err, "since", since)
+		return nil, errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+
+	ret := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		ret[row.Day.Format(time.DateOnly)] = row.Count
+	}
+	return ret, nil
+}
+
+// ReplacePostStats 删除 since 及之后的博客每日统计，并写入新的统计.
+func (s *analyticsStore) ReplacePostStats(ctx context.Context, since time.Time, stats []*model.PostDailyStatM) error {
+	db := s.store.DB(ctx)
+	if err := db.Where("day >= ?", since).Delete(new(model.PostDailyStatM)).Error; err != nil {
+		log.Errorw("Failed to delete post stats from database", "err", err, "since", since)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+	if len(stats) == 0 {
+		return nil
+	}
+
+	if err := db.CreateInBatches(stats, 500).Error; err != nil {
+		log.Errorw("Failed to insert post stats into database", "err", err, "since", since)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// ReplaceSiteStats 删除 since 及之后的全站每日统计，并写入新的统计.
+func (s *analyticsStore) ReplaceSiteStats(ctx context.Context, since time.Time, stats []*model.SiteDailyStatM) error {
+	db := s.store.DB(ctx)
+	if err := db.Where("day >= ?", since).Delete(new(model.SiteDailyStatM)).Error; err != nil {
+		log.Errorw("Failed to delete site stats from database", "err", err, "since", since)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+	if len(stats) == 0 {
+		return nil
+	}
+
+	if err := db.CreateInBatches(stats, 500).Error; err != nil {
+		log.Errorw("Failed to insert site stats into database", "err", err, "since", since)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+)
+
+// BookmarkStore 定义了 bookmark 模块在 store 层所实现的方法.
+type BookmarkStore interface {
+	Create(ctx context.Context, obj *model.BookmarkM) error
+	Update(ctx context.Context, obj *model.BookmarkM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.BookmarkM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.BookmarkM, error)
+
+	BookmarkExpansion
+}
+
+// ReadingListCount 表示阅读列表及其中的书签数量.
+type ReadingListCount struct {
+	ListName string `gorm:"column:listName"`
+	Count    int64  `gorm:"column:count"`
+}
+
+// BookmarkExpansion 定义了书签操作的附加方法.
+type BookmarkExpansion interface {
+	// MaxPosition 返回用户某个阅读列表中书签的最大位置，列表为空时返回 -1.
+	MaxPosition(ctx context.Context, userID string, listName string) (int64, error)
+	// ReadingLists 返回用户的所有阅读列表及其中的书签数量.
+	ReadingLists(ctx context.Context, userID string) ([]*ReadingListCount, error)
+	// Bookmarked 返回指定博客中已被用户收藏的博客 ID 集合.
+	Bookmarked(ctx context.Context, userID string, postIDs ...string) (map[string]bool, error)
+	// DeleteByPost 删除指定博客的所有书签.
+	DeleteByPost(ctx context.Context, postIDs ...string) error
+}
+
+// bookmarkStore 是 BookmarkStore 接口的实现.
+type bookmarkStore struct {
+	store *datastore
+}
+
+// 确保 bookmarkStore 实现了 BookmarkStore 接口.
+var _ BookmarkStore = (*bookmarkStore)(nil)
+
+// newBookmarkStore 创建 bookmarkStore 的实例.
+func newBookmarkStore(store *datastore) *bookmarkStore {
+	return &bookmarkStore{store}
+}
+
+// Create 插入一条书签记录.
+func (s *bookmarkStore) Create(ctx context.Context, obj *model.BookmarkM) error {
+	if err := s.store.DB(ctx).Create(obj).Error; err != nil {
+		log.Errorw("Failed to insert bookmark into database", "err", err, "bookmark", obj)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Update 更新书签数据库记录.
+func (s *bookmarkStore) Update(ctx context.Context, obj *model.BookmarkM) error {
+	if err := s.store.DB(ctx).Save(obj).Error; err != nil {
+		log.Errorw("Failed to update bookmark in database", "err", err, "bookmark", obj)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Delete 根据条件删除书签记录.
+func (s *bookmarkStore) Delete(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Delete(new(model.BookmarkM)).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Errorw("Failed to delete bookmark from database", "err", err, "conditions", opts)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Get 根据条件查询书签记录.
+func (s *bookmarkStore) Get(ctx context.Context, opts *where.Options) (*model.BookmarkM, error) {
+	var obj model.BookmarkM
+	if err := s.store.DB(ctx, opts).First(&obj).Error; err != nil {
+		log.Errorw("Failed to retrieve bookmark from database", "err", err, "conditions", opts)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ErrBookmarkNotFound
+		}
+		return nil, errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+
+	return &obj, nil
+}
+
+// List 返回书签列表和总数. 书签按阅读列表中的位置排序.
+// nolint: nonamedreturns
+func (s *bookmarkStore) List(ctx context.Context, opts *where.Options) (count int64, ret []*model.BookmarkM, err error) {
+	err = s.store.DB(ctx, opts).Order("listName, position, id").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		log.Errorw("Failed to list bookmarks from database", "err", err, "conditions", opts)
+		err = errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	return
+}
+
+// MaxPosition 返回用户某个阅读列表中书签的最大位置.
+func (s *bookmarkStore) MaxPosition(ctx context.Context, userID string, listName string) (int64, error) {
+	var pos int64
+	err := s.store.DB(ctx).Model(new(model.BookmarkM)).
+		Where("userID = ? AND listName = ?", userID, listName).
+		Select("COALESCE(MAX(position), -1)").Scan(&pos).Error
+	if err != nil {
+		log.Errorw("Failed to get max bookmark position from database", "err", err, "userID", userID, "listName", listName)
+		return 0, errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+
+	return pos, nil
+}
+
+// ReadingLists 返回用户的所有阅读列表及其中的书签数量.
+func (s *bookmarkStore) ReadingLists(ctx context.Context, userID string) ([]*ReadingListCount, error) {
+	var ret []*ReadingListCount
+	err := s.store.DB(ctx).Model(new(model.BookmarkM)).
+		Select("listName, COUNT(*) AS count").
+		Where("userID = ?", userID).
+		Group("listName").Order("listName").Scan(&ret).Error
+	if err != nil {
+		log.Errorw("Failed to list reading lists from database", "err", err, "userID", userID)
+		return nil, errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+
+	return ret, nil
+}
+
+// Bookmarked 返回指定博客中已被用户收藏的博客 ID 集合.
+func (s *bookmarkStore) Bookmarked(ctx context.Context, userID string, postIDs ...string) (map[string]bool, error) {
+	ret := make(map[string]bool, len(postIDs))
+	if userID == "" || len(postIDs) == 0 {
+		return ret, nil
+	}
+
+	var bookmarked []string
+	err := s.store.DB(ctx).Model(new(model.BookmarkM)).
+		Where("userID = ? AND postID IN ?", userID, postIDs).
+		Pluck("postID", &bookmarked).Error
+	if err != nil {
+		log.Errorw("Failed to list bookmarked posts from database", "err", err, "userID", userID)
+		return nil, errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+
+	for _, postID := range bookmarked {
+		ret[postID] = true
+	}
+	return ret, nil
+}
+
+// DeleteByPost 删除指定博客的所有书签.
+func (s *bookmarkStore) DeleteByPost(ctx context.Context, postIDs ...string) error {
+	if len(postIDs) == 0 {
+		return nil
+	}
+
+	if err := s.store.DB(ctx).Where("postID IN ?", postIDs).Delete(new(model.BookmarkM)).Error; err != nil {
+		log.Errorw("Failed to delete bookmarks from database", "err", err, "postIDs", postIDs)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package store
+
+import (
+	"context"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+)
+
+// FeatureStore 定义了 feature 模块在 store 层所实现的方法. 精选博客由管理员设置，在全站范围内生效.
+type FeatureStore interface {
+	Create(ctx context.Context, obj *model.PostFeatureM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	List(ctx context.Context, opts *where.Options) (int64, []*model.PostFeatureM, error)
+
+	FeatureExpansion
+}
+
+// FeatureExpansion 定义了精选操作的附加方法.
+type FeatureExpansion interface {
+	// SetPositions 按 postIDs 的顺序重新设置精选博客的位置.
+	SetPositions(ctx context.Context, postIDs []string) error
+	// DeleteByPost 删除指定博客的精选记录.
+	DeleteByPost(ctx context.Context, postIDs ...string) error
+}
+
+// featureStore 是 FeatureStore 接口的实现.
+type featureStore struct {
+	store *datastore
+}
+
+// 确保 featureStore 实现了 FeatureStore 接口.
+var _ FeatureStore = (*featureStore)(nil)
+
+// newFeatureStore 创建 featureStore 的实例.
+func newFeatureStore(store *datastore) *featureStore {
+	return &featureStore{store}
+}
+
+// Create 插入一条精选记录.
+func (s *featureStore) Create(ctx context.Context, obj *model.PostFeatureM) error {
+	if err := s.store.DB(ctx).Create(obj).Error; err != nil {
+		log.Errorw("Failed to insert feature into database", "err", err, "feature", obj)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Delete 根据条件删除精选记录.
+func (s *featureStore) Delete(ctx context.Context, opts *where.Options) error {
+	if err := s.store.DB(ctx, opts).Delete(new(model.PostFeatureM)).Error; err != nil {
+		log.Errorw("Failed to delete feature from database", "err", err, "conditions", opts)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// List 返回精选列表和总数. 精选记录按位置排序.
+// nolint: nonamedreturns
+func (s *featureStore) List(ctx context.Context, opts *where.Options) (count int64, ret []*model.PostFeatureM, err error) {
+	err = s.store.DB(ctx, opts).Order("position, id").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		log.Errorw("Failed to list features from database", "err", err, "conditions", opts)
+		err = errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	return
+}
+
+// SetPositions 按 postIDs 的顺序重新设置精选博客的位置.
+func (s *featureStore) SetPositions(ctx context.Context, postIDs []string) error {
+	db := s.store.DB(ctx)
+	for i, postID := range postIDs {
+		if err := db.Model(new(model.PostFeatureM)).Where("postID = ?", postID).Update("position", i).Error; err != nil {
+			log.Errorw("Failed to update feature position in database", "err", err, "postID", postID)
+			return errno.ErrDBWrite.WithMessage("%s", err.Error())
+		}
+	}
+
+	return nil
+}
+
+// DeleteByPost 删除指定博客的精选记录.
+func (s *featureStore) DeleteByPost(ctx context.Context, postIDs ...string) error {
+	if len(postIDs) == 0 {
+		return nil
+	}
+
+	return s.Delete(ctx, where.F("postID", postIDs))
+}
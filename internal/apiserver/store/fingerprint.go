@@ -0,0 +1,80 @@
+package store
+
+import (
+	"context"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+	"gorm.io/gorm/clause"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+)
+
+// FingerprintStore 定义了 fingerprint 模块在 store 层所实现的方法.
+// 每篇博客对应 post_fingerprint 表中的一行，保存博客内容的指纹，用于检测重复博客.
+type FingerprintStore interface {
+	List(ctx context.Context, opts *where.Options) (int64, []*model.PostFingerprintM, error)
+
+	FingerprintExpansion
+}
+
+// FingerprintExpansion 定义了指纹操作的附加方法.
+type FingerprintExpansion interface {
+	// Save 保存博客的指纹，博客已有指纹时更新摘要和签名.
+	Save(ctx context.Context, obj *model.PostFingerprintM) error
+	// DeleteByPost 删除指定博客的指纹.
+	DeleteByPost(ctx context.Context, postIDs ...string) error
+}
+
+// fingerprintStore 是 FingerprintStore 接口的实现.
+type fingerprintStore struct {
+	store *datastore
+}
+
+// 确保 fingerprintStore 实现了 FingerprintStore 接口.
+var _ FingerprintStore = (*fingerprintStore)(nil)
+
+// newFingerprintStore 创建 fingerprintStore 的实例.
+func newFingerprintStore(store *datastore) *fingerprintStore {
+	return &fingerprintStore{store}
+}
+
+// List 返回指纹列表和总数，最近创建的博客排在前面.
+// nolint: nonamedreturns
+func (s *fingerprintStore) List(ctx context.Context, opts *where.Options) (count int64, ret []*model.PostFingerprintM, err error) {
+	err = s.store.DB(ctx, opts).Order("createdAt desc, id desc").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		log.Errorw("Failed to list fingerprints from database", "err", err, "conditions", opts)
+		err = errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	return
+}
+
+// Save 保存博客的指纹. 依赖 postID 唯一索引，更新博客时只修改摘要和签名，保留博客的创建时间.
+func (s *fingerprintStore) Save(ctx context.Context, obj *model.PostFingerprintM) error {
+	err := s.store.DB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "postID"}},
+		DoUpdates: clause.AssignmentColumns([]string{"contentHash", "simHash"}),
+	}).Create(obj).Error
+	if err != nil {
+		log.Errorw("Failed to save fingerprint into database", "err", err, "fingerprint", obj)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// DeleteByPost 删除指定博客的指纹.
+func (s *fingerprintStore) DeleteByPost(ctx context.Context, postIDs ...string) error {
+	if len(postIDs) == 0 {
+		return nil
+	}
+
+	if err := s.store.DB(ctx).Where("postID IN ?", postIDs).Delete(new(model.PostFingerprintM)).Error; err != nil {
+		log.Errorw("Failed to delete fingerprints from database", "err", err, "postIDs", postIDs)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+)
+
+// IdentityStore 定义了 identity 模块在 store 层所实现的方法.
+type IdentityStore interface {
+	Create(ctx context.Context, obj *model.UserIdentityM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.UserIdentityM, error)
+
+	IdentityExpansion
+}
+
+// IdentityExpansion 定义了外部身份操作的附加方法.
+type IdentityExpansion interface {
+	// Touch 更新外部身份的最后登录时间和电子邮箱地址.
+	Touch(ctx context.Context, id int64, email string, lastLoginAt time.Time) error
+}
+
+// identityStore 是 IdentityStore 接口的实现.
+type identityStore struct {
+	store *datastore
+}
+
+// 确保 identityStore 实现了 IdentityStore 接口.
+var _ IdentityStore = (*identityStore)(nil)
+
+// newIdentityStore 创建 identityStore 的实例.
+func newIdentityStore(store *datastore) *identityStore {
+	return &identityStore{store}
+}
+
+// Create 插入一条外部身份记录.
+func (s *identityStore) Create(ctx context.Context, obj *model.UserIdentityM) error {
+	if err := s.store.DB(ctx).Create(obj).Error; err != nil {
+		log.Errorw("Failed to insert identity into database", "err", err, "userID", obj.UserID, "provider", obj.Provider)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Delete 根据条件删除外部身份记录.
+func (s *identityStore) Delete(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Delete(new(model.UserIdentityM)).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Errorw("Failed to delete identities from database", "err", err, "conditions", opts)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Get 根据条件查询外部身份记录.
+func (s *identityStore) Get(ctx context.Context, opts *where.Options) (*model.UserIdentityM, error) {
+	var obj model.UserIdentityM
+	if err := s.store.DB(ctx, opts).First(&obj).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ErrIdentityNotFound
+		}
+		log.Errorw("Failed to retrieve identity from database", "err", err, "conditions", opts)
+		return nil, errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+
+	return &obj, nil
+}
+
+// Touch 更新外部身份的最后登录时间和电子邮箱地址.
+func (s *identityStore) Touch(ctx context.Context, id int64, email string, lastLoginAt time.Time) error {
+	err := s.store.DB(ctx).Model(new(model.UserIdentityM)).Where("id = ?", id).
+		Updates(map[string]any{"email": email, "lastLoginAt": lastLoginAt}).Error
+	if err != nil {
+		log.Errorw("Failed to update identity last login time", "err", err, "id", id)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
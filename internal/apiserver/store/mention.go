@@ -0,0 +1,117 @@
+package store
+
+import (
+	"context"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+)
+
+// MentionStore 定义了 mention 模块在 store 层所实现的方法.
+// 每条记录对应博客内容中的一次 @username 提及，同一博客中多次提及同一用户会保存多条记录.
+type MentionStore interface {
+	Delete(ctx context.Context, opts *where.Options) error
+	List(ctx context.Context, opts *where.Options) (int64, []*model.PostMentionM, error)
+
+	MentionExpansion
+}
+
+// MentionExpansion 定义了提及操作的附加方法.
+type MentionExpansion interface {
+	// Replace 使用 mentions 替换博客已有的全部提及记录.
+	Replace(ctx context.Context, postID string, mentions []*model.PostMentionM) error
+	// PostIDs 返回提及了指定用户的博客 ID 及博客总数，按最近一次提及倒序排列.
+	PostIDs(ctx context.Context, userID string, offset int, limit int) (int64, []string, error)
+	// DeleteByPost 删除指定博客的所有提及记录.
+	DeleteByPost(ctx context.Context, postIDs ...string) error
+}
+
+// mentionStore 是 MentionStore 接口的实现.
+type mentionStore struct {
+	store *datastore
+}
+
+// 确保 mentionStore 实现了 MentionStore 接口.
+var _ MentionStore = (*mentionStore)(nil)
+
+// newMentionStore 创建 mentionStore 的实例.
+func newMentionStore(store *datastore) *mentionStore {
+	return &mentionStore{store}
+}
+
+// Delete 根据条件删除提及记录.
+func (s *mentionStore) Delete(ctx context.Context, opts *where.Options) error {
+	if err := s.store.DB(ctx, opts).Delete(new(model.PostMentionM)).Error; err != nil {
+		log.Errorw("Failed to delete mention from database", "err", err, "conditions", opts)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// List 返回提及列表和总数. 提及按所在博客和在内容中的位置排序.
+// nolint: nonamedreturns
+func (s *mentionStore) List(ctx context.Context, opts *where.Options) (count int64, ret []*model.PostMentionM, err error) {
+	err = s.store.DB(ctx, opts).Order("postID, startOffset").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		log.Errorw("Failed to list mentions from database", "err", err, "conditions", opts)
+		err = errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	return
+}
+
+// Replace 使用 mentions 替换博客已有的全部提及记录. 调用方需要在事务中调用，保证删除和插入的原子性.
+func (s *mentionStore) Replace(ctx context.Context, postID string, mentions []*model.PostMentionM) error {
+	db := s.store.DB(ctx)
+	if err := db.Where("postID = ?", postID).Delete(new(model.PostMentionM)).Error; err != nil {
+		log.Errorw("Failed to delete mentions from database", "err", err, "postID", postID)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+	if len(mentions) == 0 {
+		return nil
+	}
+
+	for _, m := range mentions {
+		m.PostID = postID
+	}
+	if err := db.Create(&mentions).Error; err != nil {
+		log.Errorw("Failed to insert mentions into database", "err", err, "postID", postID)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// PostIDs 返回提及了指定用户的博客 ID 及博客总数.
+func (s *mentionStore) PostIDs(ctx context.Context, userID string, offset int, limit int) (int64, []string, error) {
+	db := s.store.DB(ctx).Model(new(model.PostMentionM)).Where("userID = ?", userID)
+
+	var count int64
+	if err := db.Distinct("postID").Count(&count).Error; err != nil {
+		log.Errorw("Failed to count mentioned posts from database", "err", err, "userID", userID)
+		return 0, nil, errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+
+	var postIDs []string
+	err := s.store.DB(ctx).Model(new(model.PostMentionM)).Where("userID = ?", userID).
+		Group("postID").Order("MAX(id) DESC").Offset(offset).Limit(limit).
+		Pluck("postID", &postIDs).Error
+	if err != nil {
+		log.Errorw("Failed to list mentioned posts from database", "err", err, "userID", userID)
+		return 0, nil, errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+
+	return count, postIDs, nil
+}
+
+// DeleteByPost 删除指定博客的所有提及记录.
+func (s *mentionStore) DeleteByPost(ctx context.Context, postIDs ...string) error {
+	if len(postIDs) == 0 {
+		return nil
+	}
+
+	return s.Delete(ctx, where.F("postID", postIDs))
+}
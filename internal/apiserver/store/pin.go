@@ -0,0 +1,96 @@
+package store
+
+import (
+	"context"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+)
+
+// PinStore 定义了 pin 模块在 store 层所实现的方法. 置顶博客保存在 post_pin 表中，
+// 不修改 post 表，避免置顶操作更新博客的最后修改时间.
+type PinStore interface {
+	Create(ctx context.Context, obj *model.PostPinM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	List(ctx context.Context, opts *where.Options) (int64, []*model.PostPinM, error)
+
+	PinExpansion
+}
+
+// PinExpansion 定义了置顶操作的附加方法.
+type PinExpansion interface {
+	// SetPositions 按 postIDs 的顺序重新设置用户置顶博客的位置.
+	SetPositions(ctx context.Context, userID string, postIDs []string) error
+	// DeleteByPost 删除指定博客的置顶记录.
+	DeleteByPost(ctx context.Context, postIDs ...string) error
+}
+
+// pinStore 是 PinStore 接口的实现.
+type pinStore struct {
+	store *datastore
+}
+
+// 确保 pinStore 实现了 PinStore 接口.
+var _ PinStore = (*pinStore)(nil)
+
+// newPinStore 创建 pinStore 的实例.
+func newPinStore(store *datastore) *pinStore {
+	return &pinStore{store}
+}
+
+// Create 插入一条置顶记录.
+func (s *pinStore) Create(ctx context.Context, obj *model.PostPinM) error {
+	if err := s.store.DB(ctx).Create(obj).Error; err != nil {
+		log.Errorw("Failed to insert pin into database", "err", err, "pin", obj)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Delete 根据条件删除置顶记录.
+func (s *pinStore) Delete(ctx context.Context, opts *where.Options) error {
+	if err := s.store.DB(ctx, opts).Delete(new(model.PostPinM)).Error; err != nil {
+		log.Errorw("Failed to delete pin from database", "err", err, "conditions", opts)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// List 返回置顶列表和总数. 置顶记录按位置排序.
+// nolint: nonamedreturns
+func (s *pinStore) List(ctx context.Context, opts *where.Options) (count int64, ret []*model.PostPinM, err error) {
+	err = s.store.DB(ctx, opts).Order("position, id").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		log.Errorw("Failed to list pins from database", "err", err, "conditions", opts)
+		err = errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	return
+}
+
+// SetPositions 按 postIDs 的顺序重新设置用户置顶博客的位置.
+func (s *pinStore) SetPositions(ctx context.Context, userID string, postIDs []string) error {
+	db := s.store.DB(ctx)
+	for i, postID := range postIDs {
+		err := db.Model(new(model.PostPinM)).Where("userID = ? AND postID = ?", userID, postID).Update("position", i).Error
+		if err != nil {
+			log.Errorw("Failed to update pin position in database", "err", err, "userID", userID, "postID", postID)
+			return errno.ErrDBWrite.WithMessage("%s", err.Error())
+		}
+	}
+
+	return nil
+}
+
+// DeleteByPost 删除指定博客的置顶记录.
+func (s *pinStore) DeleteByPost(ctx context.Context, postIDs ...string) error {
+	if len(postIDs) == 0 {
+		return nil
+	}
+
+	return s.Delete(ctx, where.F("postID", postIDs))
+}
@@ -0,0 +1,103 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+)
+
+// PreviewStore 定义了 preview 模块在 store 层所实现的方法.
+// 删除预览链接记录即撤销该链接，签名正确的链接在记录被删除后也不能再使用.
+type PreviewStore interface {
+	Create(ctx context.Context, obj *model.PreviewLinkM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.PreviewLinkM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.PreviewLinkM, error)
+
+	PreviewExpansion
+}
+
+// PreviewExpansion 定义了预览链接操作的附加方法.
+type PreviewExpansion interface {
+	// DeleteByPost 删除指定博客的所有预览链接.
+	DeleteByPost(ctx context.Context, postIDs ...string) error
+}
+
+// previewStore 是 PreviewStore 接口的实现.
+type previewStore struct {
+	store *datastore
+}
+
+// 确保 previewStore 实现了 PreviewStore 接口.
+var _ PreviewStore = (*previewStore)(nil)
+
+// newPreviewStore 创建 previewStore 的实例.
+func newPreviewStore(store *datastore) *previewStore {
+	return &previewStore{store}
+}
+
+// Create 插入一条预览链接记录.
+func (s *previewStore) Create(ctx context.Context, obj *model.PreviewLinkM) error {
+	if err := s.store.DB(ctx).Create(obj).Error; err != nil {
+		log.Errorw("Failed to insert preview link into database", "err", err, "link", obj)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Delete 根据条件删除预览链接记录.
+func (s *previewStore) Delete(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Delete(new(model.PreviewLinkM)).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Errorw("Failed to delete preview link from database", "err", err, "conditions", opts)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Get 根据条件查询预览链接记录.
+func (s *previewStore) Get(ctx context.Context, opts *where.Options) (*model.PreviewLinkM, error) {
+	var obj model.PreviewLinkM
+	if err := s.store.DB(ctx, opts).First(&obj).Error; err != nil {
+		log.Errorw("Failed to retrieve preview link from database", "err", err, "conditions", opts)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ErrPreviewLinkNotFound
+		}
+		return nil, errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+
+	return &obj, nil
+}
+
+// List 返回预览链接列表和总数，按过期时间排序.
+// nolint: nonamedreturns
+func (s *previewStore) List(ctx context.Context, opts *where.Options) (count int64, ret []*model.PreviewLinkM, err error) {
+	err = s.store.DB(ctx, opts).Order("expiresAt").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		log.Errorw("Failed to list preview links from database", "err", err, "conditions", opts)
+		err = errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	return
+}
+
+// DeleteByPost 删除指定博客的所有预览链接.
+func (s *previewStore) DeleteByPost(ctx context.Context, postIDs ...string) error {
+	if len(postIDs) == 0 {
+		return nil
+	}
+
+	if err := s.store.DB(ctx).Where("postID IN ?", postIDs).Delete(new(model.PreviewLinkM)).Error; err != nil {
+		log.Errorw("Failed to delete preview links from database", "err", err, "postIDs", postIDs)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
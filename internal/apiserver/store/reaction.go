@@ -0,0 +1,147 @@
+package store
+
+import (
+	"context"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+)
+
+// ReactionStore 定义了 reaction 模块在 store 层所实现的方法.
+// 每条反应记录对应 post_reaction 表中的一行，各类反应的数量单独保存在 post_reaction_count 表中，
+// 避免每次查询博客时都需要执行 COUNT(*).
+type ReactionStore interface {
+	// Create 插入一条反应记录，记录已存在时不做任何修改. 返回值表示是否插入了新记录.
+	Create(ctx context.Context, obj *model.PostReactionM) (bool, error)
+	// Delete 根据条件删除反应记录. 返回值表示是否删除了记录.
+	Delete(ctx context.Context, opts *where.Options) (bool, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.PostReactionM, error)
+
+	ReactionExpansion
+}
+
+// ReactionExpansion 定义了反应操作的附加方法.
+type ReactionExpansion interface {
+	// IncrCount 原子地修改博客某类反应的数量，delta 可以为负数.
+	IncrCount(ctx context.Context, postID string, reaction string, delta int64) error
+	// Counts 返回指定博客的各类反应数量，结果以 postID 和反应类型为键.
+	Counts(ctx context.Context, postIDs ...string) (map[string]map[string]int64, error)
+	// DeleteByPost 删除指定博客的所有反应记录和反应数量.
+	DeleteByPost(ctx context.Context, postIDs ...string) error
+}
+
+// reactionStore 是 ReactionStore 接口的实现.
+type reactionStore struct {
+	store *datastore
+}
+
+// 确保 reactionStore 实现了 ReactionStore 接口.
+var _ ReactionStore = (*reactionStore)(nil)
+
+// newReactionStore 创建 reactionStore 的实例.
+func newReactionStore(store *datastore) *reactionStore {
+	return &reactionStore{store}
+}
+
+// Create 插入一条反应记录. 依赖 (postID, userID, reaction) 唯一索引保证同一用户的同一反应只会记录一次.
+func (s *reactionStore) Create(ctx context.Context, obj *model.PostReactionM) (bool, error) {
+	result := s.store.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(obj)
+	if result.Error != nil {
+		log.Errorw("Failed to insert reaction into database", "err", result.Error, "reaction", obj)
+		return false, errno.ErrDBWrite.WithMessage("%s", result.Error.Error())
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// Delete 根据条件删除反应记录.
+func (s *reactionStore) Delete(ctx context.Context, opts *where.Options) (bool, error) {
+	result := s.store.DB(ctx, opts).Delete(new(model.PostReactionM))
+	if result.Error != nil {
+		log.Errorw("Failed to delete reaction from database", "err", result.Error, "conditions", opts)
+		return false, errno.ErrDBWrite.WithMessage("%s", result.Error.Error())
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// List 返回反应列表和总数.
+// nolint: nonamedreturns
+func (s *reactionStore) List(ctx context.Context, opts *where.Options) (count int64, ret []*model.PostReactionM, err error) {
+	err = s.store.DB(ctx, opts).Order("id desc").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		log.Errorw("Failed to list reactions from database", "err", err, "conditions", opts)
+		err = errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	return
+}
+
+// IncrCount 原子地修改博客某类反应的数量. 计数记录不存在时会自动创建，数量不会小于 0.
+func (s *reactionStore) IncrCount(ctx context.Context, postID string, reaction string, delta int64) error {
+	db := s.store.DB(ctx)
+
+	var err error
+	if delta > 0 {
+		err = db.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "postID"}, {Name: "reaction"}},
+			DoUpdates: clause.Assignments(map[string]any{"count": gorm.Expr("`count` + ?", delta)}),
+		}).Create(&model.PostReactionCountM{PostID: postID, Reaction: reaction, Count: delta}).Error
+	} else {
+		err = db.Model(new(model.PostReactionCountM)).
+			Where("postID = ? AND reaction = ? AND `count` >= ?", postID, reaction, -delta).
+			Update("count", gorm.Expr("`count` + ?", delta)).Error
+	}
+	if err != nil {
+		log.Errorw("Failed to update reaction count in database", "err", err, "postID", postID, "reaction", reaction)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Counts 返回指定博客的各类反应数量.
+func (s *reactionStore) Counts(ctx context.Context, postIDs ...string) (map[string]map[string]int64, error) {
+	ret := make(map[string]map[string]int64, len(postIDs))
+	if len(postIDs) == 0 {
+		return ret, nil
+	}
+
+	var rows []*model.PostReactionCountM
+	if err := s.store.DB(ctx).Where("postID IN ? AND `count` > 0", postIDs).Find(&rows).Error; err != nil {
+		log.Errorw("Failed to list reaction counts from database", "err", err, "postIDs", postIDs)
+		return nil, errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+
+	for _, row := range rows {
+		if ret[row.PostID] == nil {
+			ret[row.PostID] = make(map[string]int64)
+		}
+		ret[row.PostID][row.Reaction] = row.Count
+	}
+
+	return ret, nil
+}
+
+// DeleteByPost 删除指定博客的所有反应记录和反应数量.
+func (s *reactionStore) DeleteByPost(ctx context.Context, postIDs ...string) error {
+	if len(postIDs) == 0 {
+		return nil
+	}
+
+	db := s.store.DB(ctx)
+	if err := db.Where("postID IN ?", postIDs).Delete(new(model.PostReactionM)).Error; err != nil {
+		log.Errorw("Failed to delete reactions from database", "err", err, "postIDs", postIDs)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+	if err := db.Where("postID IN ?", postIDs).Delete(new(model.PostReactionCountM)).Error; err != nil {
+		log.Errorw("Failed to delete reaction counts from database", "err", err, "postIDs", postIDs)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
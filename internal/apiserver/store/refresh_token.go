@@ -0,0 +1,90 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+)
+
+// RefreshTokenStore 定义了 refresh token 模块在 store 层所实现的方法.
+// 数据库中只保存刷新令牌的摘要.
+type RefreshTokenStore interface {
+	Create(ctx context.Context, obj *model.RefreshTokenM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.RefreshTokenM, error)
+
+	RefreshTokenExpansion
+}
+
+// RefreshTokenExpansion 定义了刷新令牌操作的附加方法.
+type RefreshTokenExpansion interface {
+	// MarkUsed 将尚未使用的刷新令牌标记为已使用. 令牌已经被使用时返回 false，
+	// 保证并发轮换同一个刷新令牌时只有一方成功.
+	MarkUsed(ctx context.Context, id int64) (bool, error)
+}
+
+// refreshTokenStore 是 RefreshTokenStore 接口的实现.
+type refreshTokenStore struct {
+	store *datastore
+}
+
+// 确保 refreshTokenStore 实现了 RefreshTokenStore 接口.
+var _ RefreshTokenStore = (*refreshTokenStore)(nil)
+
+// newRefreshTokenStore 创建 refreshTokenStore 的实例.
+func newRefreshTokenStore(store *datastore) *refreshTokenStore {
+	return &refreshTokenStore{store}
+}
+
+// Create 插入一条刷新令牌记录.
+func (s *refreshTokenStore) Create(ctx context.Context, obj *model.RefreshTokenM) error {
+	if err := s.store.DB(ctx).Create(obj).Error; err != nil {
+		log.Errorw("Failed to insert refresh token into database", "err", err, "userID", obj.UserID)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Delete 根据条件删除刷新令牌记录.
+func (s *refreshTokenStore) Delete(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Delete(new(model.RefreshTokenM)).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Errorw("Failed to delete refresh tokens from database", "err", err, "conditions", opts)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Get 根据条件查询刷新令牌记录，不存在时返回 errno.ErrRefreshTokenInvalid.
+func (s *refreshTokenStore) Get(ctx context.Context, opts *where.Options) (*model.RefreshTokenM, error) {
+	var obj model.RefreshTokenM
+	if err := s.store.DB(ctx, opts).First(&obj).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ErrRefreshTokenInvalid
+		}
+		log.Errorw("Failed to retrieve refresh token from database", "err", err, "conditions", opts)
+		return nil, errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+
+	return &obj, nil
+}
+
+// MarkUsed 将刷新令牌标记为已使用，只有更新成功的一方返回 true.
+func (s *refreshTokenStore) MarkUsed(ctx context.Context, id int64) (bool, error) {
+	result := s.store.DB(ctx).Model(new(model.RefreshTokenM)).Where("id = ? AND usedAt IS NULL", id).Update("usedAt", time.Now())
+	if result.Error != nil {
+		log.Errorw("Failed to mark refresh token as used", "err", result.Error, "id", id)
+		return false, errno.ErrDBWrite.WithMessage("%s", result.Error.Error())
+	}
+
+	return result.RowsAffected > 0, nil
+}
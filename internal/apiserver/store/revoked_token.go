@@ -0,0 +1,73 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+)
+
+// RevokedTokenStore 定义了访问令牌撤销列表在 store 层所实现的方法.
+type RevokedTokenStore interface {
+	Create(ctx context.Context, obj *model.RevokedTokenM) error
+	Delete(ctx context.Context, opts *where.Options) error
+
+	RevokedTokenExpansion
+}
+
+// RevokedTokenExpansion 定义了访问令牌撤销列表的附加方法.
+type RevokedTokenExpansion interface {
+	// Exists 报告 tokenID 对应的访问令牌是否已被撤销.
+	Exists(ctx context.Context, tokenID string) (bool, error)
+}
+
+// revokedTokenStore 是 RevokedTokenStore 接口的实现.
+type revokedTokenStore struct {
+	store *datastore
+}
+
+// 确保 revokedTokenStore 实现了 RevokedTokenStore 接口.
+var _ RevokedTokenStore = (*revokedTokenStore)(nil)
+
+// newRevokedTokenStore 创建 revokedTokenStore 的实例.
+func newRevokedTokenStore(store *datastore) *revokedTokenStore {
+	return &revokedTokenStore{store}
+}
+
+// Create 插入一条撤销记录. 重复撤销同一个令牌时忽略.
+func (s *revokedTokenStore) Create(ctx context.Context, obj *model.RevokedTokenM) error {
+	if err := s.store.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(obj).Error; err != nil {
+		log.Errorw("Failed to insert revoked token into database", "err", err, "tokenID", obj.TokenID)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Delete 根据条件删除撤销记录.
+func (s *revokedTokenStore) Delete(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Delete(new(model.RevokedTokenM)).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Errorw("Failed to delete revoked tokens from database", "err", err, "conditions", opts)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Exists 查询 tokenID 对应的撤销记录是否存在.
+func (s *revokedTokenStore) Exists(ctx context.Context, tokenID string) (bool, error) {
+	var count int64
+	if err := s.store.DB(ctx).Model(new(model.RevokedTokenM)).Where("tokenID = ?", tokenID).Count(&count).Error; err != nil {
+		log.Errorw("Failed to retrieve revoked token from database", "err", err, "tokenID", tokenID)
+		return false, errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,100 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+)
+
+// SessionStore 定义了 session 模块在 store 层所实现的方法.
+type SessionStore interface {
+	Create(ctx context.Context, obj *model.UserSessionM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.UserSessionM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.UserSessionM, error)
+
+	SessionExpansion
+}
+
+// SessionExpansion 定义了登录会话操作的附加方法.
+type SessionExpansion interface {
+	// Touch 更新会话的最后使用时间.
+	Touch(ctx context.Context, sessionID string, lastSeenAt time.Time) error
+}
+
+// sessionStore 是 SessionStore 接口的实现.
+type sessionStore struct {
+	store *datastore
+}
+
+// 确保 sessionStore 实现了 SessionStore 接口.
+var _ SessionStore = (*sessionStore)(nil)
+
+// newSessionStore 创建 sessionStore 的实例.
+func newSessionStore(store *datastore) *sessionStore {
+	return &sessionStore{store}
+}
+
+// Create 插入一条会话记录.
+func (s *sessionStore) Create(ctx context.Context, obj *model.UserSessionM) error {
+	if err := s.store.DB(ctx).Create(obj).Error; err != nil {
+		log.Errorw("Failed to insert session into database", "err", err, "userID", obj.UserID)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Delete 根据条件删除会话记录.
+func (s *sessionStore) Delete(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Delete(new(model.UserSessionM)).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Errorw("Failed to delete sessions from database", "err", err, "conditions", opts)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Get 根据条件查询会话记录.
+func (s *sessionStore) Get(ctx context.Context, opts *where.Options) (*model.UserSessionM, error) {
+	var obj model.UserSessionM
+	if err := s.store.DB(ctx, opts).First(&obj).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ErrSessionNotFound
+		}
+		log.Errorw("Failed to retrieve session from database", "err", err, "conditions", opts)
+		return nil, errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+
+	return &obj, nil
+}
+
+// List 返回会话列表和总数，最近使用的会话排在前面.
+// nolint: nonamedreturns
+func (s *sessionStore) List(ctx context.Context, opts *where.Options) (count int64, ret []*model.UserSessionM, err error) {
+	err = s.store.DB(ctx, opts).Order("lastSeenAt desc").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		log.Errorw("Failed to list sessions from database", "err", err, "conditions", opts)
+		err = errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	return
+}
+
+// Touch 更新会话的最后使用时间.
+func (s *sessionStore) Touch(ctx context.Context, sessionID string, lastSeenAt time.Time) error {
+	err := s.store.DB(ctx).Model(new(model.UserSessionM)).Where("sessionID = ?", sessionID).Update("lastSeenAt", lastSeenAt).Error
+	if err != nil {
+		log.Errorw("Failed to update session last seen time", "err", err, "sessionID", sessionID)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
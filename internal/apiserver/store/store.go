@@ -31,6 +31,22 @@ type IStore interface {
 
 	User() UserStore
 	Post() PostStore
+	Reaction() ReactionStore
+	Bookmark() BookmarkStore
+	Mention() MentionStore
+	Pin() PinStore
+	Feature() FeatureStore
+	Fingerprint() FingerprintStore
+	Analytics() AnalyticsStore
+	Translation() TranslationStore
+	Template() TemplateStore
+	Preview() PreviewStore
+	UserToken() UserTokenStore
+	RefreshToken() RefreshTokenStore
+	RevokedToken() RevokedTokenStore
+	Session() SessionStore
+	AccessToken() AccessTokenStore
+	Identity() IdentityStore
 }
 
 // transactionKey 用于在 context.Context 中存储事务上下文的键.
@@ -93,3 +109,83 @@ func (store *datastore) User() UserStore {
 func (store *datastore) Post() PostStore {
 	return newPostStore(store)
 }
+
+// Reaction 返回一个实现了 ReactionStore 接口的实例.
+func (store *datastore) Reaction() ReactionStore {
+	return newReactionStore(store)
+}
+
+// Bookmark 返回一个实现了 BookmarkStore 接口的实例.
+func (store *datastore) Bookmark() BookmarkStore {
+	return newBookmarkStore(store)
+}
+
+// Mention 返回一个实现了 MentionStore 接口的实例.
+func (store *datastore) Mention() MentionStore {
+	return newMentionStore(store)
+}
+
+// Pin 返回一个实现了 PinStore 接口的实例.
+func (store *datastore) Pin() PinStore {
+	return newPinStore(store)
+}
+
+// Feature 返回一个实现了 FeatureStore 接口的实例.
+func (store *datastore) Feature() FeatureStore {
+	return newFeatureStore(store)
+}
+
+// Fingerprint 返回一个实现了 FingerprintStore 接口的实例.
+func (store *datastore) Fingerprint() FingerprintStore {
+	return newFingerprintStore(store)
+}
+
+// Analytics 返回一个实现了 AnalyticsStore 接口的实例.
+func (store *datastore) Analytics() AnalyticsStore {
+	return newAnalyticsStore(store)
+}
+
+// Translation 返回一个实现了 TranslationStore 接口的实例.
+func (store *datastore) Translation() TranslationStore {
+	return newTranslationStore(store)
+}
+
+// Template 返回一个实现了 TemplateStore 接口的实例.
+func (store *datastore) Template() TemplateStore {
+	return newTemplateStore(store)
+}
+
+// Preview 返回一个实现了 PreviewStore 接口的实例.
+func (store *datastore) Preview() PreviewStore {
+	return newPreviewStore(store)
+}
+
+// UserToken 返回一个实现了 UserTokenStore 接口的实例.
+func (store *datastore) UserToken() UserTokenStore {
+	return newUserTokenStore(store)
+}
+
+// RefreshToken 返回一个实现了 RefreshTokenStore 接口的实例.
+func (store *datastore) RefreshToken() RefreshTokenStore {
+	return newRefreshTokenStore(store)
+}
+
+// RevokedToken 返回一个实现了 RevokedTokenStore 接口的实例.
+func (store *datastore) RevokedToken() RevokedTokenStore {
+	return newRevokedTokenStore(store)
+}
+
+// Session 返回一个实现了 SessionStore 接口的实例.
+func (store *datastore) Session() SessionStore {
+	return newSessionStore(store)
+}
+
+// AccessToken 返回一个实现了 AccessTokenStore 接口的实例.
+func (store *datastore) AccessToken() AccessTokenStore {
+	return newAccessTokenStore(store)
+}
+
+// Identity 返回一个实现了 IdentityStore 接口的实例.
+func (store *datastore) Identity() IdentityStore {
+	return newIdentityStore(store)
+}
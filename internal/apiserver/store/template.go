@@ -0,0 +1,96 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+)
+
+// TemplateStore 定义了 template 模块在 store 层所实现的方法.
+type TemplateStore interface {
+	Create(ctx context.Context, obj *model.PostTemplateM) error
+	Update(ctx context.Context, obj *model.PostTemplateM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.PostTemplateM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.PostTemplateM, error)
+
+	TemplateExpansion
+}
+
+// TemplateExpansion 定义了博客模板操作的附加方法.
+type TemplateExpansion interface{}
+
+// templateStore 是 TemplateStore 接口的实现.
+type templateStore struct {
+	store *datastore
+}
+
+// 确保 templateStore 实现了 TemplateStore 接口.
+var _ TemplateStore = (*templateStore)(nil)
+
+// newTemplateStore 创建 templateStore 的实例.
+func newTemplateStore(store *datastore) *templateStore {
+	return &templateStore{store}
+}
+
+// Create 插入一条博客模板记录.
+func (s *templateStore) Create(ctx context.Context, obj *model.PostTemplateM) error {
+	if err := s.store.DB(ctx).Create(obj).Error; err != nil {
+		log.Errorw("Failed to insert post template into database", "err", err, "template", obj)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Update 更新博客模板数据库记录.
+func (s *templateStore) Update(ctx context.Context, obj *model.PostTemplateM) error {
+	if err := s.store.DB(ctx).Save(obj).Error; err != nil {
+		log.Errorw("Failed to update post template in database", "err", err, "template", obj)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Delete 根据条件删除博客模板记录.
+func (s *templateStore) Delete(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Delete(new(model.PostTemplateM)).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Errorw("Failed to delete post template from database", "err", err, "conditions", opts)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Get 根据条件查询博客模板记录.
+func (s *templateStore) Get(ctx context.Context, opts *where.Options) (*model.PostTemplateM, error) {
+	var obj model.PostTemplateM
+	if err := s.store.DB(ctx, opts).First(&obj).Error; err != nil {
+		log.Errorw("Failed to retrieve post template from database", "err", err, "conditions", opts)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ErrTemplateNotFound
+		}
+		return nil, errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+
+	return &obj, nil
+}
+
+// List 返回博客模板列表和总数，按名称排序.
+// nolint: nonamedreturns
+func (s *templateStore) List(ctx context.Context, opts *where.Options) (count int64, ret []*model.PostTemplateM, err error) {
+	err = s.store.DB(ctx, opts).Order("name").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		log.Errorw("Failed to list post templates from database", "err", err, "conditions", opts)
+		err = errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	return
+}
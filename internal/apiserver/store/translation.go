@@ -0,0 +1,114 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+)
+
+// TranslationStore 定义了 translation 模块在 store 层所实现的方法.
+// 博客默认语言的标题和内容保存在 post 表中，其他语言的译文保存在 post_translation 表中.
+type TranslationStore interface {
+	Create(ctx context.Context, obj *model.PostTranslationM) error
+	Update(ctx context.Context, obj *model.PostTranslationM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.PostTranslationM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.PostTranslationM, error)
+
+	TranslationExpansion
+}
+
+// TranslationExpansion 定义了译文操作的附加方法.
+type TranslationExpansion interface {
+	// DeleteByPost 删除指定博客的所有译文.
+	DeleteByPost(ctx context.Context, postIDs ...string) error
+}
+
+// translationStore 是 TranslationStore 接口的实现.
+type translationStore struct {
+	store *datastore
+}
+
+// 确保 translationStore 实现了 TranslationStore 接口.
+var _ TranslationStore = (*translationStore)(nil)
+
+// newTranslationStore 创建 translationStore 的实例.
+func newTranslationStore(store *datastore) *translationStore {
+	return &translationStore{store}
+}
+
+// Create 插入一条译文记录.
+func (s *translationStore) Create(ctx context.Context, obj *model.PostTranslationM) error {
+	if err := s.store.DB(ctx).Create(obj).Error; err != nil {
+		log.Errorw("Failed to insert post translation into database", "err", err, "translation", obj)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Update 更新译文数据库记录.
+func (s *translationStore) Update(ctx context.Context, obj *model.PostTranslationM) error {
+	if err := s.store.DB(ctx).Save(obj).Error; err != nil {
+		log.Errorw("Failed to update post translation in database", "err", err, "translation", obj)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Delete 根据条件删除译文记录.
+func (s *translationStore) Delete(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Delete(new(model.PostTranslationM)).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Errorw("Failed to delete post translation from database", "err", err, "conditions", opts)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Get 根据条件查询译文记录.
+func (s *translationStore) Get(ctx context.Context, opts *where.Options) (*model.PostTranslationM, error) {
+	var obj model.PostTranslationM
+	if err := s.store.DB(ctx, opts).First(&obj).Error; err != nil {
+		log.Errorw("Failed to retrieve post translation from database", "err", err, "conditions", opts)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ErrTranslationNotFound
+		}
+		return nil, errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+
+	return &obj, nil
+}
+
+// List 返回译文列表和总数，按语言标签排序.
+// nolint: nonamedreturns
+func (s *translationStore) List(ctx context.Context, opts *where.Options) (count int64, ret []*model.PostTranslationM, err error) {
+	err = s.store.DB(ctx, opts).Order("postID, locale").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		log.Errorw("Failed to list post translations from database", "err", err, "conditions", opts)
+		err = errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	return
+}
+
+// DeleteByPost 删除指定博客的所有译文.
+func (s *translationStore) DeleteByPost(ctx context.Context, postIDs ...string) error {
+	if len(postIDs) == 0 {
+		return nil
+	}
+
+	if err := s.store.DB(ctx).Where("postID IN ?", postIDs).Delete(new(model.PostTranslationM)).Error; err != nil {
+		log.Errorw("Failed to delete post translations from database", "err", err, "postIDs", postIDs)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
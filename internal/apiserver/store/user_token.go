@@ -0,0 +1,101 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ra1n6ow/gpkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+)
+
+// UserTokenStore 定义了 user token 模块在 store 层所实现的方法.
+// 一次性令牌用于邮箱验证、重置密码、两步验证等场景，数据库中只保存令牌的摘要.
+type UserTokenStore interface {
+	Create(ctx context.Context, obj *model.UserTokenM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.UserTokenM, error)
+
+	UserTokenExpansion
+}
+
+// UserTokenExpansion 定义了一次性令牌操作的附加方法.
+type UserTokenExpansion interface {
+	// Consume 查询并删除指定用途和摘要的令牌，保证同一个令牌只能被使用一次.
+	// 令牌不存在或已经被使用时返回 errno.ErrUserTokenInvalid.
+	Consume(ctx context.Context, purpose string, tokenHash string) (*model.UserTokenM, error)
+}
+
+// userTokenStore 是 UserTokenStore 接口的实现.
+type userTokenStore struct {
+	store *datastore
+}
+
+// 确保 userTokenStore 实现了 UserTokenStore 接口.
+var _ UserTokenStore = (*userTokenStore)(nil)
+
+// newUserTokenStore 创建 userTokenStore 的实例.
+func newUserTokenStore(store *datastore) *userTokenStore {
+	return &userTokenStore{store}
+}
+
+// Create 插入一条令牌记录.
+func (s *userTokenStore) Create(ctx context.Context, obj *model.UserTokenM) error {
+	if err := s.store.DB(ctx).Create(obj).Error; err != nil {
+		log.Errorw("Failed to insert user token into database", "err", err, "userID", obj.UserID, "purpose", obj.Purpose)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Delete 根据条件删除令牌记录.
+func (s *userTokenStore) Delete(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Delete(new(model.UserTokenM)).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Errorw("Failed to delete user tokens from database", "err", err, "conditions", opts)
+		return errno.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Get 根据条件查询令牌记录，不存在时返回 errno.ErrUserTokenInvalid.
+func (s *userTokenStore) Get(ctx context.Context, opts *where.Options) (*model.UserTokenM, error) {
+	var obj model.UserTokenM
+	if err := s.store.DB(ctx, opts).First(&obj).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ErrUserTokenInvalid
+		}
+		log.Errorw("Failed to retrieve user token from database", "err", err, "conditions", opts)
+		return nil, errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+
+	return &obj, nil
+}
+
+// Consume 查询并删除令牌记录. 并发使用同一个令牌时，只有删除成功的一方会得到令牌记录.
+func (s *userTokenStore) Consume(ctx context.Context, purpose string, tokenHash string) (*model.UserTokenM, error) {
+	var obj model.UserTokenM
+	if err := s.store.DB(ctx).Where("purpose = ? AND tokenHash = ?", purpose, tokenHash).First(&obj).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ErrUserTokenInvalid
+		}
+		log.Errorw("Failed to retrieve user token from database", "err", err, "purpose", purpose)
+		return nil, errno.ErrDBRead.WithMessage("%s", err.Error())
+	}
+
+	result := s.store.DB(ctx).Where("id = ?", obj.ID).Delete(new(model.UserTokenM))
+	if result.Error != nil {
+		log.Errorw("Failed to delete user token from database", "err", result.Error, "purpose", purpose)
+		return nil, errno.ErrDBWrite.WithMessage("%s", result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return nil, errno.ErrUserTokenInvalid
+	}
+
+	return &obj, nil
+}
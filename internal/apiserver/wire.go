@@ -7,6 +7,8 @@ import (
 	"github.com/google/wire"
 
 	"github.com/ra1n6ow/miniblog/internal/apiserver/biz"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/revocation"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/usercache"
 	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/validation"
 	"github.com/ra1n6ow/miniblog/internal/apiserver/store"
 	ginmw "github.com/ra1n6ow/miniblog/internal/pkg/middleware/gin"
@@ -16,14 +18,21 @@ import (
 
 func InitializeWebServer(*Config) (server.Server, error) {
 	wire.Build(
-		wire.NewSet(NewWebServer, wire.FieldsOf(new(*Config), "ServerMode")),
+		wire.NewSet(NewWebServer, wire.FieldsOf(new(*Config), "ServerMode", "Reactions", "PostOptions", "PreviewOptions", "UserOptions")),
 		wire.Struct(new(ServerConfig), "*"), // * 表示注入全部字段
 		wire.NewSet(store.ProviderSet, biz.ProviderSet),
-		ProvideDB, // 提供数据库实例
+		ProvideDB,           // 提供数据库实例
+		ProvideRelatedIndex, // 提供相关博客索引
+		ProvideWebHandler,   // 提供 HTML 前端处理器
+		ProvideMailer,       // 提供邮件发送器
 		validation.ProviderSet,
 		wire.NewSet(
-			wire.Struct(new(UserRetriever), "*"),
-			wire.Bind(new(ginmw.UserRetriever), new(*UserRetriever)),
+			revocation.ProviderSet,
+			wire.Bind(new(ginmw.TokenChecker), new(*revocation.List)),
+		),
+		wire.NewSet(
+			usercache.ProviderSet,
+			wire.Bind(new(ginmw.UserRetriever), new(*usercache.Cache)),
 		),
 		auth.ProviderSet,
 	)
@@ -8,6 +8,8 @@ package apiserver
 
 import (
 	"github.com/ra1n6ow/miniblog/internal/apiserver/biz"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/revocation"
+	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/usercache"
 	"github.com/ra1n6ow/miniblog/internal/apiserver/pkg/validation"
 	"github.com/ra1n6ow/miniblog/internal/apiserver/store"
 	"github.com/ra1n6ow/miniblog/internal/pkg/server"
@@ -28,17 +30,34 @@ func InitializeWebServer(config *Config) (server.Server, error) {
 	if err != nil {
 		return nil, err
 	}
-	bizBiz := biz.NewBiz(datastore, authz)
-	validator := validation.New(datastore)
-	userRetriever := &UserRetriever{
-		store: datastore,
+	index, err := ProvideRelatedIndex(datastore)
+	if err != nil {
+		return nil, err
+	}
+	options := config.PostOptions
+	previewOptions := config.PreviewOptions
+	userOptions := config.UserOptions
+	mailerMailer, err := ProvideMailer(config)
+	if err != nil {
+		return nil, err
+	}
+	list := revocation.New(datastore)
+	cache := usercache.New(datastore)
+	bizBiz := biz.NewBiz(datastore, authz, index, options, previewOptions, userOptions, mailerMailer, list, cache)
+	reactionSet := config.Reactions
+	validator := validation.New(datastore, reactionSet)
+	handler, err := ProvideWebHandler(config, bizBiz, validator, cache, list, authz)
+	if err != nil {
+		return nil, err
 	}
 	serverConfig := &ServerConfig{
 		cfg:       config,
 		biz:       bizBiz,
 		val:       validator,
-		retriever: userRetriever,
+		retriever: cache,
+		checker:   list,
 		authz:     authz,
+		web:       handler,
 	}
 	serverServer, err := NewWebServer(string2, serverConfig)
 	if err != nil {
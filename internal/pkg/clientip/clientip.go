@@ -0,0 +1,126 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+// Package clientip 根据可信代理列表解析请求的客户端 IP. HTTP 和 gRPC 服务使用同一套规则，
+// 保证不同服务模式下按客户端 IP 限流和锁定登录的行为一致.
+//
+// 只有直接连接的对端是可信代理时才读取 X-Forwarded-For，并从右向左跳过可信代理，
+// 第一个不可信的地址即为客户端 IP. 客户端自己添加的地址总是位于左侧，无法伪造.
+package clientip
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+)
+
+// loopback 为本机地址. gRPC-Gateway 和 gRPC 服务运行在同一个进程中，通过本机地址转发请求.
+var loopback = []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8"), netip.MustParsePrefix("::1/128")}
+
+// Resolver 解析请求的客户端 IP，可以被多个 goroutine 并发使用.
+type Resolver struct {
+	// proxies 为配置的可信代理，保存原始配置，用于设置 Gin 引擎.
+	proxies []string
+	// trusted 为可信代理的地址范围.
+	trusted []netip.Prefix
+}
+
+// New 创建 Resolver. trustedProxies 为可信代理的 IP 或者 CIDR，为空时不信任任何代理，
+// 直接使用对端地址作为客户端 IP.
+func New(trustedProxies []string) (*Resolver, error) {
+	r := &Resolver{proxies: trustedProxies}
+	for _, proxy := range trustedProxies {
+		prefix, err := parsePrefix(proxy)
+		if err != nil {
+			return nil, err
+		}
+		r.trusted = append(r.trusted, prefix)
+	}
+	return r, nil
+}
+
+// parsePrefix 将 IP 或者 CIDR 解析为地址范围.
+func parsePrefix(s string) (netip.Prefix, error) {
+	if strings.Contains(s, "/") {
+		prefix, err := netip.ParsePrefix(s)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("invalid trusted proxy %q: %w", s, err)
+		}
+		return prefix.Masked(), nil
+	}
+
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid trusted proxy %q: %w", s, err)
+	}
+	addr = addr.Unmap()
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// Proxies 返回配置的可信代理.
+func (r *Resolver) Proxies() []string {
+	return r.proxies
+}
+
+// WithLoopback 返回同时信任本机地址的 Resolver，只用于 gRPC-Gateway 运行在同一个进程中时解析转发的请求.
+// gRPC-Gateway 会将它看到的对端地址追加到 X-Forwarded-For 的末尾.
+func (r *Resolver) WithLoopback() *Resolver {
+	trusted := append(append([]netip.Prefix{}, r.trusted...), loopback...)
+	return &Resolver{proxies: r.proxies, trusted: trusted}
+}
+
+// Resolve 返回客户端 IP. remoteAddr 为直接连接的对端地址（host:port 或者 IP），
+// forwardedFor 为所有 X-Forwarded-For 头的值，按出现顺序排列.
+func (r *Resolver) Resolve(remoteAddr string, forwardedFor []string) string {
+	remote, ok := parseAddr(remoteAddr)
+	if !ok {
+		return ""
+	}
+	if !r.isTrusted(remote) {
+		return remote.String()
+	}
+
+	var hops []string
+	for _, value := range forwardedFor {
+		hops = append(hops, strings.Split(value, ",")...)
+	}
+
+	client := remote
+	for i := len(hops) - 1; i >= 0; i-- {
+		addr, ok := parseAddr(strings.TrimSpace(hops[i]))
+		if !ok {
+			// 无法解析的地址可能是伪造的，停止解析，使用已经确认的地址
+			break
+		}
+		client = addr
+		if !r.isTrusted(addr) {
+			break
+		}
+	}
+	return client.String()
+}
+
+// isTrusted 判断地址是否为可信代理.
+func (r *Resolver) isTrusted(addr netip.Addr) bool {
+	for _, prefix := range r.trusted {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseAddr 解析 host:port 或者 IP 格式的地址.
+func parseAddr(s string) (netip.Addr, bool) {
+	if host, _, err := net.SplitHostPort(s); err == nil {
+		s = host
+	}
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addr.Unmap(), true
+}
@@ -0,0 +1,61 @@
+package clientip
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolve(t *testing.T) {
+	proxied, err := New([]string{"10.0.0.0/8", "192.0.2.1"})
+	require.NoError(t, err)
+	direct, err := New(nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		resolver     *Resolver
+		remoteAddr   string
+		forwardedFor []string
+		want         string
+	}{
+		{"no proxy", direct, "203.0.113.7:4321", nil, "203.0.113.7"},
+		{"untrusted peer ignores forwarded for", direct, "203.0.113.7:4321", []string{"198.51.100.1"}, "203.0.113.7"},
+		{"untrusted peer ignores forwarded for from proxy list", proxied, "203.0.113.7:4321", []string{"10.0.0.1"}, "203.0.113.7"},
+		{"trusted proxy", proxied, "10.1.2.3:80", []string{"198.51.100.1"}, "198.51.100.1"},
+		{"spoofed hops on the left are skipped", proxied, "10.1.2.3:80", []string{"1.1.1.1, 198.51.100.1"}, "198.51.100.1"},
+		{"chain of trusted proxies", proxied, "10.1.2.3:80", []string{"198.51.100.1, 192.0.2.1", "10.9.9.9"}, "198.51.100.1"},
+		{"all hops trusted", proxied, "10.1.2.3:80", []string{"10.0.0.2"}, "10.0.0.2"},
+		{"invalid hop stops parsing", proxied, "10.1.2.3:80", []string{"198.51.100.1, unknown"}, "10.1.2.3"},
+		{"ipv4 mapped ipv6", proxied, "[::ffff:10.1.2.3]:80", []string{"198.51.100.1"}, "198.51.100.1"},
+		{"bare ip", direct, "203.0.113.7", nil, "203.0.113.7"},
+		{"invalid remote", direct, "pipe", nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.resolver.Resolve(tt.remoteAddr, tt.forwardedFor))
+		})
+	}
+}
+
+func TestWithLoopback(t *testing.T) {
+	r, err := New(nil)
+	require.NoError(t, err)
+
+	// gRPC-Gateway 通过本机地址转发请求，并将 HTTP 客户端的地址追加到末尾
+	assert.Equal(t, "127.0.0.1", r.Resolve("127.0.0.1:5000", []string{"198.51.100.1"}))
+	assert.Equal(t, "198.51.100.1", r.WithLoopback().Resolve("127.0.0.1:5000", []string{"198.51.100.1"}))
+	assert.Equal(t, "198.51.100.1", r.WithLoopback().Resolve("[::1]:5000", []string{"1.1.1.1, 198.51.100.1"}))
+	assert.Empty(t, r.Proxies())
+}
+
+func TestNew(t *testing.T) {
+	_, err := New([]string{"10.0.0.0/8", "::1", "192.0.2.1"})
+	assert.NoError(t, err)
+
+	_, err = New([]string{"10.0.0.0/33"})
+	assert.Error(t, err)
+	_, err = New([]string{"proxy.local"})
+	assert.Error(t, err)
+}
@@ -6,6 +6,7 @@ package contextx
 
 import (
 	"context"
+	"time"
 )
 
 // 定义用于上下文的键.
@@ -18,6 +19,20 @@ type (
 	accessTokenKey struct{}
 	// requestIDKey 定义请求 ID 的上下文键.
 	requestIDKey struct{}
+	// clientIPKey 定义客户端 IP 的上下文键.
+	clientIPKey struct{}
+	// tokenIDKey 定义访问令牌唯一标识的上下文键.
+	tokenIDKey struct{}
+	// tokenExpiresAtKey 定义访问令牌过期时间的上下文键.
+	tokenExpiresAtKey struct{}
+	// sessionIDKey 定义登录会话 ID 的上下文键.
+	sessionIDKey struct{}
+	// userAgentKey 定义客户端 User-Agent 的上下文键.
+	userAgentKey struct{}
+	// scopesKey 定义个人访问令牌权限范围的上下文键.
+	scopesKey struct{}
+	// rolesKey 定义访问令牌中用户角色的上下文键.
+	rolesKey struct{}
 )
 
 // WithUserID 将用户 ID 存放到上下文中.
@@ -63,3 +78,81 @@ func RequestID(ctx context.Context) string {
 	requestID, _ := ctx.Value(requestIDKey{}).(string)
 	return requestID
 }
+
+// WithClientIP 将客户端 IP 存放到上下文中.
+func WithClientIP(ctx context.Context, clientIP string) context.Context {
+	return context.WithValue(ctx, clientIPKey{}, clientIP)
+}
+
+// ClientIP 从上下文中提取客户端 IP.
+func ClientIP(ctx context.Context) string {
+	clientIP, _ := ctx.Value(clientIPKey{}).(string)
+	return clientIP
+}
+
+// WithTokenID 将访问令牌的唯一标识存放到上下文中.
+func WithTokenID(ctx context.Context, tokenID string) context.Context {
+	return context.WithValue(ctx, tokenIDKey{}, tokenID)
+}
+
+// TokenID 从上下文中提取访问令牌的唯一标识.
+func TokenID(ctx context.Context) string {
+	tokenID, _ := ctx.Value(tokenIDKey{}).(string)
+	return tokenID
+}
+
+// WithTokenExpiresAt 将访问令牌的过期时间存放到上下文中.
+func WithTokenExpiresAt(ctx context.Context, expiresAt time.Time) context.Context {
+	return context.WithValue(ctx, tokenExpiresAtKey{}, expiresAt)
+}
+
+// TokenExpiresAt 从上下文中提取访问令牌的过期时间.
+func TokenExpiresAt(ctx context.Context) time.Time {
+	expiresAt, _ := ctx.Value(tokenExpiresAtKey{}).(time.Time)
+	return expiresAt
+}
+
+// WithSessionID 将登录会话 ID 存放到上下文中.
+func WithSessionID(ctx context.Context, sessionID string) context.Context {
+	return context.WithValue(ctx, sessionIDKey{}, sessionID)
+}
+
+// SessionID 从上下文中提取登录会话 ID.
+func SessionID(ctx context.Context) string {
+	sessionID, _ := ctx.Value(sessionIDKey{}).(string)
+	return sessionID
+}
+
+// WithUserAgent 将客户端 User-Agent 存放到上下文中.
+func WithUserAgent(ctx context.Context, userAgent string) context.Context {
+	return context.WithValue(ctx, userAgentKey{}, userAgent)
+}
+
+// UserAgent 从上下文中提取客户端 User-Agent.
+func UserAgent(ctx context.Context) string {
+	userAgent, _ := ctx.Value(userAgentKey{}).(string)
+	return userAgent
+}
+
+// WithScopes 将个人访问令牌的权限范围存放到上下文中.
+func WithScopes(ctx context.Context, scopes []string) context.Context {
+	return context.WithValue(ctx, scopesKey{}, scopes)
+}
+
+// Scopes 从上下文中提取个人访问令牌的权限范围. 请求不是使用个人访问令牌认证时返回 nil 和 false.
+func Scopes(ctx context.Context) ([]string, bool) {
+	scopes, ok := ctx.Value(scopesKey{}).([]string)
+	return scopes, ok
+}
+
+// WithRoles 将访问令牌中存放的用户角色存放到上下文中.
+func WithRoles(ctx context.Context, roles []string) context.Context {
+	return context.WithValue(ctx, rolesKey{}, roles)
+}
+
+// Roles 从上下文中提取访问令牌签发时用户拥有的角色. 令牌中没有角色信息，或者令牌签发后用户的角色发生了变化时，
+// 返回 nil 和 false，调用方需要自行查询. 其他实例上修改的角色最多在用户缓存过期后生效，授权仍然以 casbin 为准.
+func Roles(ctx context.Context) ([]string, bool) {
+	roles, ok := ctx.Value(rolesKey{}).([]string)
+	return roles, ok
+}
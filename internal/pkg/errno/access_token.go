@@ -0,0 +1,23 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+package errno
+
+import (
+	"net/http"
+
+	"github.com/ra1n6ow/gpkg/errorsx"
+)
+
+var (
+	// ErrAccessTokenNotFound 表示未找到指定的个人访问令牌.
+	ErrAccessTokenNotFound = &errorsx.ErrorX{Code: http.StatusNotFound, Reason: "NotFound.AccessTokenNotFound", Message: "Access token not found."}
+
+	// ErrAccessTokenInvalid 表示个人访问令牌不存在、已过期或者已被撤销.
+	ErrAccessTokenInvalid = &errorsx.ErrorX{Code: http.StatusUnauthorized, Reason: "Unauthenticated.AccessTokenInvalid", Message: "Access token is invalid or has expired."}
+
+	// ErrInsufficientScope 表示个人访问令牌的权限范围不允许访问当前接口.
+	ErrInsufficientScope = &errorsx.ErrorX{Code: http.StatusForbidden, Reason: "PermissionDenied.InsufficientScope", Message: "Access token does not have the required scope."}
+)
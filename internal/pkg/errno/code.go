@@ -36,6 +36,9 @@ var (
 	// ErrOperationFailed 表示操作失败.
 	ErrOperationFailed = errorsx.ErrOperationFailed
 
+	// ErrTooManyRequests 表示请求过于频繁，超过了限流阈值.
+	ErrTooManyRequests = &errorsx.ErrorX{Code: http.StatusTooManyRequests, Reason: "ResourceExhausted.TooManyRequests", Message: "Too many requests, please try again later."}
+
 	// ErrPageNotFound 表示页面未找到.
 	ErrPageNotFound = &errorsx.ErrorX{Code: http.StatusNotFound, Reason: "NotFound.PageNotFound", Message: "Page not found."}
 
@@ -51,6 +54,9 @@ var (
 	// ErrDBWrite 表示数据库写入失败.
 	ErrDBWrite = &errorsx.ErrorX{Code: http.StatusInternalServerError, Reason: "InternalError.DBWrite", Message: "Database write failure."}
 
+	// ErrSendMail 表示发送邮件失败.
+	ErrSendMail = &errorsx.ErrorX{Code: http.StatusInternalServerError, Reason: "InternalError.SendMail", Message: "Failed to send email."}
+
 	// ErrAddRole 表示在添加角色时发生错误.
 	ErrAddRole = &errorsx.ErrorX{Code: http.StatusInternalServerError, Reason: "InternalError.AddRole", Message: "Error occurred while adding the role."}
 
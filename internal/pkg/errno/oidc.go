@@ -0,0 +1,35 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+package errno
+
+import (
+	"net/http"
+
+	"github.com/ra1n6ow/gpkg/errorsx"
+)
+
+var (
+	// ErrOIDCProviderNotFound 表示请求的单点登录身份提供方未配置.
+	ErrOIDCProviderNotFound = &errorsx.ErrorX{Code: http.StatusNotFound, Reason: "NotFound.OIDCProviderNotFound", Message: "OIDC provider not found."}
+
+	// ErrOIDCProviderUnavailable 表示无法从身份提供方获取配置或令牌.
+	ErrOIDCProviderUnavailable = &errorsx.ErrorX{Code: http.StatusBadGateway, Reason: "Unavailable.OIDCProviderUnavailable", Message: "OIDC provider is unavailable."}
+
+	// ErrOIDCStateInvalid 表示单点登录回调中的 state 无效、已使用或已过期.
+	ErrOIDCStateInvalid = &errorsx.ErrorX{Code: http.StatusBadRequest, Reason: "InvalidArgument.OIDCStateInvalid", Message: "OIDC login state is invalid or has expired, please try again."}
+
+	// ErrOIDCLoginFailed 表示授权码无效或 ID Token 校验失败.
+	ErrOIDCLoginFailed = &errorsx.ErrorX{Code: http.StatusUnauthorized, Reason: "Unauthenticated.OIDCLoginFailed", Message: "OIDC login failed."}
+
+	// ErrOIDCEmailNotVerified 表示身份提供方没有返回已验证的电子邮箱地址，无法关联或创建用户.
+	ErrOIDCEmailNotVerified = &errorsx.ErrorX{Code: http.StatusForbidden, Reason: "PermissionDenied.OIDCEmailNotVerified", Message: "OIDC provider did not return a verified email address."}
+
+	// ErrOIDCAccountConflict 表示无法确定身份应关联的用户，例如多个用户使用同一个电子邮箱地址.
+	ErrOIDCAccountConflict = &errorsx.ErrorX{Code: http.StatusConflict, Reason: "AlreadyExist.OIDCAccountConflict", Message: "Unable to link the OIDC identity to a unique user."}
+
+	// ErrIdentityNotFound 表示未找到外部身份.
+	ErrIdentityNotFound = &errorsx.ErrorX{Code: http.StatusNotFound, Reason: "NotFound.IdentityNotFound", Message: "Identity not found."}
+)
@@ -0,0 +1,33 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+package errno
+
+import (
+	"net/http"
+
+	"github.com/ra1n6ow/gpkg/errorsx"
+)
+
+var (
+	// ErrPolicyNotFound 表示未找到指定的授权策略.
+	ErrPolicyNotFound = &errorsx.ErrorX{Code: http.StatusNotFound, Reason: "NotFound.PolicyNotFound", Message: "Policy not found."}
+
+	// ErrPolicyAlreadyExist 表示授权策略已经存在.
+	ErrPolicyAlreadyExist = &errorsx.ErrorX{Code: http.StatusBadRequest, Reason: "AlreadyExist.PolicyAlreadyExist", Message: "Policy already exists."}
+
+	// ErrPolicyProtected 表示修改会导致管理员失去管理权限，例如删除管理员的通配策略.
+	ErrPolicyProtected = &errorsx.ErrorX{Code: http.StatusForbidden, Reason: "PermissionDenied.PolicyProtected", Message: "The change would lock administrators out and is not allowed."}
+
+	// ErrPolicyUnrestricted 表示修改会使普通用户不再受拒绝策略的限制. 授权模型只包含拒绝策略，
+	// 没有拒绝策略的主体可以访问所有接口.
+	ErrPolicyUnrestricted = &errorsx.ErrorX{Code: http.StatusForbidden, Reason: "PermissionDenied.PolicyUnrestricted", Message: "The change would give users unrestricted access and is not allowed."}
+
+	// ErrRoleNotAssigned 表示用户没有指定的角色.
+	ErrRoleNotAssigned = &errorsx.ErrorX{Code: http.StatusNotFound, Reason: "NotFound.RoleNotAssigned", Message: "User does not have the role."}
+
+	// ErrRoleAlreadyAssigned 表示用户已经拥有指定的角色.
+	ErrRoleAlreadyAssigned = &errorsx.ErrorX{Code: http.StatusBadRequest, Reason: "AlreadyExist.RoleAlreadyAssigned", Message: "User already has the role."}
+)
@@ -11,5 +11,19 @@ import (
 	"github.com/ra1n6ow/gpkg/errorsx"
 )
 
-// ErrPostNotFound 表示未找到指定的博客.
-var ErrPostNotFound = &errorsx.ErrorX{Code: http.StatusNotFound, Reason: "NotFound.PostNotFound", Message: "Post not found."}
+var (
+	// ErrPostNotFound 表示未找到指定的博客.
+	ErrPostNotFound = &errorsx.ErrorX{Code: http.StatusNotFound, Reason: "NotFound.PostNotFound", Message: "Post not found."}
+
+	// ErrTooManyPinnedPosts 表示置顶的博客数量已达到上限.
+	ErrTooManyPinnedPosts = &errorsx.ErrorX{Code: http.StatusBadRequest, Reason: "FailedPrecondition.TooManyPinnedPosts", Message: "The maximum number of pinned posts has been reached."}
+
+	// ErrDuplicatePost 表示同一用户最近已经创建过相同或近似相同的博客.
+	ErrDuplicatePost = &errorsx.ErrorX{Code: http.StatusBadRequest, Reason: "AlreadyExist.DuplicatePost", Message: "A duplicate post was created recently."}
+
+	// ErrTranslationNotFound 表示未找到博客指定语言的译文.
+	ErrTranslationNotFound = &errorsx.ErrorX{Code: http.StatusNotFound, Reason: "NotFound.TranslationNotFound", Message: "Post translation not found."}
+
+	// ErrTranslationAlreadyExists 表示博客已经有指定语言的版本.
+	ErrTranslationAlreadyExists = &errorsx.ErrorX{Code: http.StatusBadRequest, Reason: "AlreadyExist.TranslationAlreadyExists", Message: "Post translation already exists."}
+)
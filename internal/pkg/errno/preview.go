@@ -0,0 +1,23 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+package errno
+
+import (
+	"net/http"
+
+	"github.com/ra1n6ow/gpkg/errorsx"
+)
+
+var (
+	// ErrPreviewLinkNotFound 表示预览链接不存在或已经被撤销.
+	ErrPreviewLinkNotFound = &errorsx.ErrorX{Code: http.StatusNotFound, Reason: "NotFound.PreviewLinkNotFound", Message: "Preview link not found."}
+
+	// ErrPreviewLinkInvalid 表示预览链接的签名无效.
+	ErrPreviewLinkInvalid = &errorsx.ErrorX{Code: http.StatusForbidden, Reason: "PermissionDenied.PreviewLinkInvalid", Message: "Preview link signature is invalid."}
+
+	// ErrPreviewLinkExpired 表示预览链接已经过期.
+	ErrPreviewLinkExpired = &errorsx.ErrorX{Code: http.StatusForbidden, Reason: "PermissionDenied.PreviewLinkExpired", Message: "Preview link has expired."}
+)
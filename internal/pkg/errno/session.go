@@ -0,0 +1,20 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+package errno
+
+import (
+	"net/http"
+
+	"github.com/ra1n6ow/gpkg/errorsx"
+)
+
+var (
+	// ErrSessionNotFound 表示未找到指定的登录会话.
+	ErrSessionNotFound = &errorsx.ErrorX{Code: http.StatusNotFound, Reason: "NotFound.SessionNotFound", Message: "Session not found."}
+
+	// ErrSessionRevoked 表示访问令牌所属的登录会话已被撤销.
+	ErrSessionRevoked = &errorsx.ErrorX{Code: http.StatusUnauthorized, Reason: "Unauthenticated.SessionRevoked", Message: "Session has been revoked, please log in again."}
+)
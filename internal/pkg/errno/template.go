@@ -0,0 +1,20 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+package errno
+
+import (
+	"net/http"
+
+	"github.com/ra1n6ow/gpkg/errorsx"
+)
+
+var (
+	// ErrTemplateNotFound 表示未找到指定的博客模板.
+	ErrTemplateNotFound = &errorsx.ErrorX{Code: http.StatusNotFound, Reason: "NotFound.TemplateNotFound", Message: "Post template not found."}
+
+	// ErrTemplateAlreadyExists 表示当前用户已经有同名的博客模板.
+	ErrTemplateAlreadyExists = &errorsx.ErrorX{Code: http.StatusBadRequest, Reason: "AlreadyExist.TemplateAlreadyExists", Message: "Post template already exists."}
+)
@@ -0,0 +1,23 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+package errno
+
+import (
+	"net/http"
+
+	"github.com/ra1n6ow/gpkg/errorsx"
+)
+
+var (
+	// ErrTokenRevoked 表示访问令牌已被撤销，例如用户已经退出登录或者修改了密码.
+	ErrTokenRevoked = &errorsx.ErrorX{Code: http.StatusUnauthorized, Reason: "Unauthenticated.TokenRevoked", Message: "Token has been revoked."}
+
+	// ErrRefreshTokenInvalid 表示刷新令牌无效或已过期.
+	ErrRefreshTokenInvalid = &errorsx.ErrorX{Code: http.StatusUnauthorized, Reason: "Unauthenticated.RefreshTokenInvalid", Message: "Refresh token is invalid or has expired."}
+
+	// ErrRefreshTokenReused 表示刷新令牌已经被使用过，同一令牌族的所有刷新令牌均已被撤销.
+	ErrRefreshTokenReused = &errorsx.ErrorX{Code: http.StatusUnauthorized, Reason: "Unauthenticated.RefreshTokenReused", Message: "Refresh token has already been used, please log in again."}
+)
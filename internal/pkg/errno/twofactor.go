@@ -0,0 +1,23 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+package errno
+
+import (
+	"net/http"
+
+	"github.com/ra1n6ow/gpkg/errorsx"
+)
+
+var (
+	// ErrTwoFactorAlreadyEnabled 表示用户已经启用了两步验证.
+	ErrTwoFactorAlreadyEnabled = &errorsx.ErrorX{Code: http.StatusBadRequest, Reason: "FailedPrecondition.TwoFactorAlreadyEnabled", Message: "Two-factor authentication is already enabled."}
+
+	// ErrTwoFactorNotEnrolled 表示用户还没有绑定两步验证.
+	ErrTwoFactorNotEnrolled = &errorsx.ErrorX{Code: http.StatusBadRequest, Reason: "FailedPrecondition.TwoFactorNotEnrolled", Message: "Two-factor authentication has not been enrolled."}
+
+	// ErrTwoFactorCodeInvalid 表示两步验证的验证码或恢复码不正确.
+	ErrTwoFactorCodeInvalid = &errorsx.ErrorX{Code: http.StatusBadRequest, Reason: "InvalidArgument.TwoFactorCodeInvalid", Message: "Two-factor authentication code is incorrect."}
+)
@@ -31,4 +31,22 @@ var (
 
 	// ErrUserNotFound 表示未找到指定用户.
 	ErrUserNotFound = &errorsx.ErrorX{Code: http.StatusNotFound, Reason: "NotFound.UserNotFound", Message: "User not found."}
+
+	// ErrEmailNotVerified 表示用户的电子邮箱尚未验证，不能执行当前操作.
+	ErrEmailNotVerified = &errorsx.ErrorX{Code: http.StatusForbidden, Reason: "PermissionDenied.EmailNotVerified", Message: "Email address has not been verified."}
+
+	// ErrEmailAlreadyVerified 表示用户的电子邮箱已经验证过.
+	ErrEmailAlreadyVerified = &errorsx.ErrorX{Code: http.StatusBadRequest, Reason: "FailedPrecondition.EmailAlreadyVerified", Message: "Email address has already been verified."}
+
+	// ErrUserTokenInvalid 表示一次性令牌无效、已过期或已被使用.
+	ErrUserTokenInvalid = &errorsx.ErrorX{Code: http.StatusBadRequest, Reason: "InvalidArgument.UserTokenInvalid", Message: "Token is invalid, expired or has already been used."}
+
+	// ErrLoginThrottled 表示登录失败后需要等待一段时间才能再次尝试登录.
+	ErrLoginThrottled = &errorsx.ErrorX{Code: http.StatusTooManyRequests, Reason: "ResourceExhausted.LoginThrottled", Message: "Too many failed login attempts, please wait before trying again."}
+
+	// ErrAccountLocked 表示用户连续登录失败次数过多，账号被临时锁定.
+	ErrAccountLocked = &errorsx.ErrorX{Code: http.StatusTooManyRequests, Reason: "ResourceExhausted.AccountLocked", Message: "Account is temporarily locked due to too many failed login attempts."}
+
+	// ErrClientLocked 表示客户端 IP 登录失败次数过多，被临时禁止登录.
+	ErrClientLocked = &errorsx.ErrorX{Code: http.StatusTooManyRequests, Reason: "ResourceExhausted.ClientLocked", Message: "Too many failed login attempts from this client, login is temporarily blocked."}
 )
@@ -33,4 +33,7 @@ const (
 	// 用于限制 errgroup 中同时执行的 Goroutine 数量，从而防止资源耗尽，提升程序的稳定性.
 	// 根据场景需求，可以调整该值大小.
 	MaxErrGroupConcurrency = 1000
+
+	// AccessTokenPrefix 为个人访问令牌的前缀，用于和 JWT 区分.
+	AccessTokenPrefix = "mbp_"
 )
@@ -0,0 +1,133 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+// Package lockout 提供按 key（例如用户名、客户端 IP）记录连续失败次数的跟踪器，
+// 用于实现失败后的递增等待时间和临时锁定.
+package lockout
+
+import (
+	"sync"
+	"time"
+)
+
+// minPruneSize 为触发清理过期 key 的最小 key 数量.
+const minPruneSize = 1024
+
+// Policy 定义失败次数达到多少时锁定，以及锁定和等待的时长.
+type Policy struct {
+	// Threshold 为触发锁定的连续失败次数.
+	Threshold int
+	// Duration 为锁定时长. 超过 Duration 没有新的失败时，之前的失败记录被清除.
+	Duration time.Duration
+	// BaseDelay 为第一次失败后需要等待的时间，之后每次失败等待时间翻倍，最长不超过 Duration. 为 0 时不需要等待.
+	BaseDelay time.Duration
+}
+
+// Tracker 按 key 记录连续失败次数，可以被多个 goroutine 并发使用.
+type Tracker struct {
+	mu     sync.Mutex
+	policy Policy
+
+	entries map[string]*entry
+	// pruneSize 为下次清理过期 key 时的 key 数量.
+	pruneSize int
+	now       func() time.Time
+}
+
+// entry 为单个 key 的失败记录.
+type entry struct {
+	failures    int
+	last        time.Time
+	lockedUntil time.Time
+}
+
+// New 创建使用 policy 的失败跟踪器.
+func New(policy Policy) *Tracker {
+	return &Tracker{
+		policy:    policy,
+		entries:   make(map[string]*entry),
+		pruneSize: minPruneSize,
+		now:       time.Now,
+	}
+}
+
+// Check 报告 key 当前是否允许尝试. 不允许时返回需要等待的时间，locked 为 true 表示 key 已被锁定，
+// 否则表示 key 还在失败后的等待时间内.
+func (t *Tracker) Check(key string) (wait time.Duration, locked bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	e, ok := t.entries[key]
+	if !ok || t.expired(e, now) {
+		return 0, false
+	}
+	if now.Before(e.lockedUntil) {
+		return e.lockedUntil.Sub(now), true
+	}
+	if next := e.last.Add(t.delay(e.failures)); now.Before(next) {
+		return next.Sub(now), false
+	}
+	return 0, false
+}
+
+// Fail 记录 key 的一次失败，返回本次失败后 key 是否被锁定.
+func (t *Tracker) Fail(key string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	e, ok := t.entries[key]
+	if !ok || t.expired(e, now) || (!e.lockedUntil.IsZero() && !now.Before(e.lockedUntil)) {
+		// 锁定结束后重新计数
+		if !ok && len(t.entries) >= t.pruneSize {
+			t.prune(now)
+		}
+		e = &entry{}
+		t.entries[key] = e
+	}
+
+	e.failures++
+	e.last = now
+	if e.failures >= t.policy.Threshold && e.lockedUntil.IsZero() {
+		e.lockedUntil = now.Add(t.policy.Duration)
+	}
+	return now.Before(e.lockedUntil)
+}
+
+// Reset 清除 key 的失败记录，解除锁定.
+func (t *Tracker) Reset(key string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.entries, key)
+}
+
+// delay 返回连续失败 failures 次后需要等待的时间.
+func (t *Tracker) delay(failures int) time.Duration {
+	if t.policy.BaseDelay <= 0 || failures <= 0 {
+		return 0
+	}
+	d := t.policy.BaseDelay
+	for i := 1; i < failures && d < t.policy.Duration; i++ {
+		d *= 2
+	}
+	return min(d, t.policy.Duration)
+}
+
+// expired 报告失败记录是否已经过期. 锁定结束、并且最后一次失败距今超过 Duration 时记录过期.
+func (t *Tracker) expired(e *entry, now time.Time) bool {
+	return !now.Before(e.lockedUntil) && now.Sub(e.last) >= t.policy.Duration
+}
+
+// prune 删除过期的 key. 清理后仍有大量 key 时，提高下次清理的阈值，避免每次失败都遍历所有 key.
+func (t *Tracker) prune(now time.Time) {
+	for key, e := range t.entries {
+		if t.expired(e, now) {
+			delete(t.entries, key)
+		}
+	}
+	t.pruneSize = max(minPruneSize, 2*len(t.entries))
+}
@@ -0,0 +1,78 @@
+package lockout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTracker(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	tr := New(Policy{Threshold: 3, Duration: 10 * time.Minute, BaseDelay: time.Second})
+	tr.now = func() time.Time { return now }
+
+	wait, locked := tr.Check("alice")
+	assert.Zero(t, wait)
+	assert.False(t, locked)
+
+	// 每次失败后的等待时间翻倍
+	assert.False(t, tr.Fail("alice"))
+	wait, locked = tr.Check("alice")
+	assert.Equal(t, time.Second, wait)
+	assert.False(t, locked)
+
+	now = now.Add(time.Second)
+	assert.False(t, tr.Fail("alice"))
+	wait, _ = tr.Check("alice")
+	assert.Equal(t, 2*time.Second, wait)
+
+	// 达到阈值后锁定
+	now = now.Add(2 * time.Second)
+	assert.True(t, tr.Fail("alice"))
+	wait, locked = tr.Check("alice")
+	assert.Equal(t, 10*time.Minute, wait)
+	assert.True(t, locked)
+
+	// 不同的 key 分别计数
+	_, locked = tr.Check("bob")
+	assert.False(t, locked)
+
+	// 锁定结束后重新计数
+	now = now.Add(10 * time.Minute)
+	wait, locked = tr.Check("alice")
+	assert.Zero(t, wait)
+	assert.False(t, locked)
+	assert.False(t, tr.Fail("alice"))
+	wait, _ = tr.Check("alice")
+	assert.Equal(t, time.Second, wait)
+
+	// Reset 清除失败记录
+	tr.Reset("alice")
+	wait, locked = tr.Check("alice")
+	assert.Zero(t, wait)
+	assert.False(t, locked)
+}
+
+func TestTrackerForgetsOldFailures(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	tr := New(Policy{Threshold: 2, Duration: time.Minute})
+	tr.now = func() time.Time { return now }
+
+	assert.False(t, tr.Fail("alice"))
+	// 没有设置 BaseDelay 时不需要等待
+	wait, _ := tr.Check("alice")
+	assert.Zero(t, wait)
+
+	// 超过 Duration 没有新的失败时，之前的失败不再计数
+	now = now.Add(time.Minute)
+	assert.False(t, tr.Fail("alice"))
+	assert.True(t, tr.Fail("alice"))
+}
+
+func TestDelayIsCapped(t *testing.T) {
+	tr := New(Policy{Threshold: 100, Duration: time.Minute, BaseDelay: time.Second})
+	assert.Equal(t, 32*time.Second, tr.delay(6))
+	assert.Equal(t, time.Minute, tr.delay(7))
+	assert.Equal(t, time.Minute, tr.delay(50))
+}
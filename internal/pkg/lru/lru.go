@@ -0,0 +1,103 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+// Package lru 提供带过期时间的 LRU 缓存. 缓存满时淘汰最久未访问的条目，过期的条目在访问时删除.
+package lru
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Cache 是固定容量的 LRU 缓存，可以被多个 goroutine 并发使用.
+type Cache[K comparable, V any] struct {
+	mu    sync.Mutex
+	size  int
+	ll    *list.List
+	items map[K]*list.Element
+	now   func() time.Time
+}
+
+// entry 为缓存中的一个条目.
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+	// expiresAt 为条目的过期时间，零值表示永不过期.
+	expiresAt time.Time
+}
+
+// New 创建最多保存 size 个条目的缓存.
+func New[K comparable, V any](size int) *Cache[K, V] {
+	return &Cache[K, V]{
+		size:  max(size, 1),
+		ll:    list.New(),
+		items: make(map[K]*list.Element),
+		now:   time.Now,
+	}
+}
+
+// Add 添加或者更新 key 对应的条目，条目在 expiresAt 之后失效. expiresAt 为零值时条目永不过期.
+func (c *Cache[K, V]) Add(key K, value V, expiresAt time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		e := el.Value.(*entry[K, V])
+		e.value, e.expiresAt = value, expiresAt
+		c.ll.MoveToFront(el)
+		return
+	}
+
+	c.items[key] = c.ll.PushFront(&entry[K, V]{key: key, value: value, expiresAt: expiresAt})
+	if c.ll.Len() > c.size {
+		c.removeElement(c.ll.Back())
+	}
+}
+
+// Get 返回 key 对应的未过期条目.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var zero V
+	el, ok := c.items[key]
+	if !ok {
+		return zero, false
+	}
+
+	e := el.Value.(*entry[K, V])
+	if !e.expiresAt.IsZero() && !c.now().Before(e.expiresAt) {
+		c.removeElement(el)
+		return zero, false
+	}
+
+	c.ll.MoveToFront(el)
+	return e.value, true
+}
+
+// Remove 删除 key 对应的条目.
+func (c *Cache[K, V]) Remove(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.removeElement(el)
+	}
+}
+
+// Len 返回缓存中的条目数量，包括已经过期但还没有被删除的条目.
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.ll.Len()
+}
+
+// removeElement 从链表和索引中删除条目，调用方需要持有锁.
+func (c *Cache[K, V]) removeElement(el *list.Element) {
+	c.ll.Remove(el)
+	delete(c.items, el.Value.(*entry[K, V]).key)
+}
@@ -0,0 +1,54 @@
+package lru
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	c := New[string, int](2)
+	c.Add("a", 1, time.Time{})
+	c.Add("b", 2, time.Time{})
+
+	// 访问 a 之后，b 成为最久未访问的条目
+	v, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+
+	c.Add("c", 3, time.Time{})
+	_, ok = c.Get("b")
+	assert.False(t, ok)
+	_, ok = c.Get("a")
+	assert.True(t, ok)
+	_, ok = c.Get("c")
+	assert.True(t, ok)
+	assert.Equal(t, 2, c.Len())
+
+	// 更新已有的条目不会淘汰其他条目
+	c.Add("a", 10, time.Time{})
+	v, _ = c.Get("a")
+	assert.Equal(t, 10, v)
+	assert.Equal(t, 2, c.Len())
+
+	c.Remove("a")
+	_, ok = c.Get("a")
+	assert.False(t, ok)
+	assert.Equal(t, 1, c.Len())
+}
+
+func TestCacheExpiry(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	c := New[string, bool](10)
+	c.now = func() time.Time { return now }
+
+	c.Add("a", true, now.Add(time.Minute))
+	_, ok := c.Get("a")
+	assert.True(t, ok)
+
+	now = now.Add(time.Minute)
+	_, ok = c.Get("a")
+	assert.False(t, ok)
+	assert.Zero(t, c.Len())
+}
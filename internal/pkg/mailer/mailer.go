@@ -0,0 +1,131 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+// Package mailer 定义发送邮件的 Mailer 接口，并提供 SMTP、文件和标准输出 3 种实现.
+// 文件和标准输出实现不依赖邮件服务器，用于开发和测试环境.
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/smtp"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Message 表示一封纯文本邮件.
+type Message struct {
+	// To 为收件人地址.
+	To string
+	// Subject 为邮件主题.
+	Subject string
+	// Body 为纯文本格式的邮件正文.
+	Body string
+}
+
+// Mailer 定义发送邮件的方法.
+type Mailer interface {
+	// Send 发送一封邮件.
+	Send(ctx context.Context, msg *Message) error
+}
+
+// SMTPMailer 通过 SMTP 服务器发送邮件，服务器支持时使用 STARTTLS 加密连接.
+type SMTPMailer struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+// 确保 SMTPMailer、FileMailer 和 WriterMailer 实现了 Mailer 接口.
+var (
+	_ Mailer = (*SMTPMailer)(nil)
+	_ Mailer = (*FileMailer)(nil)
+	_ Mailer = (*WriterMailer)(nil)
+)
+
+// NewSMTPMailer 创建 SMTPMailer. username 为空时不进行身份认证.
+func NewSMTPMailer(host string, port int, username string, password string, from string) *SMTPMailer {
+	m := &SMTPMailer{addr: net.JoinHostPort(host, strconv.Itoa(port)), from: from}
+	if username != "" {
+		m.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return m
+}
+
+// Send 实现 Mailer 接口中的 Send 方法.
+func (m *SMTPMailer) Send(ctx context.Context, msg *Message) error {
+	return smtp.SendMail(m.addr, m.auth, m.from, []string{msg.To}, format(m.from, msg, time.Now()))
+}
+
+// FileMailer 将每封邮件写入目录中的一个 .eml 文件.
+type FileMailer struct {
+	dir  string
+	from string
+}
+
+// NewFileMailer 创建 FileMailer，目录不存在时会自动创建.
+func NewFileMailer(dir string, from string) *FileMailer {
+	return &FileMailer{dir: dir, from: from}
+}
+
+// Send 实现 Mailer 接口中的 Send 方法.
+func (m *FileMailer) Send(ctx context.Context, msg *Message) error {
+	if err := os.MkdirAll(m.dir, 0o755); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	f, err := os.CreateTemp(m.dir, now.Format("20060102T150405")+"-*.eml")
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = f.Write(format(m.from, msg, now))
+	return err
+}
+
+// WriterMailer 将邮件写入 io.Writer，例如标准输出.
+type WriterMailer struct {
+	mu   sync.Mutex
+	w    io.Writer
+	from string
+}
+
+// NewWriterMailer 创建 WriterMailer.
+func NewWriterMailer(w io.Writer, from string) *WriterMailer {
+	return &WriterMailer{w: w, from: from}
+}
+
+// Send 实现 Mailer 接口中的 Send 方法.
+func (m *WriterMailer) Send(ctx context.Context, msg *Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	_, err := m.w.Write(append(format(m.from, msg, time.Now()), '\n'))
+	return err
+}
+
+// format 将邮件格式化为 RFC 5322 格式.
+func format(from string, msg *Message, date time.Time) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.ReplaceAll(strings.ReplaceAll(msg.Body, "\r\n", "\n"), "\n", "\r\n"))
+	return buf.Bytes()
+}
@@ -0,0 +1,62 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormat(t *testing.T) {
+	msg := &Message{To: "alice@example.com", Subject: "验证邮箱", Body: "line1\nline2"}
+	got := string(format("noreply@example.com", msg, time.Unix(0, 0).UTC()))
+
+	assert.Contains(t, got, "From: noreply@example.com\r\n")
+	assert.Contains(t, got, "To: alice@example.com\r\n")
+	assert.Contains(t, got, "Subject: =?utf-8?q?")
+	assert.True(t, strings.HasSuffix(got, "\r\n\r\nline1\r\nline2"))
+}
+
+func TestWriterMailer(t *testing.T) {
+	var buf bytes.Buffer
+	m := NewWriterMailer(&buf, "noreply@example.com")
+
+	assert.NoError(t, m.Send(context.Background(), &Message{To: "alice@example.com", Subject: "hi", Body: "hello"}))
+	assert.Contains(t, buf.String(), "To: alice@example.com\r\n")
+	assert.Contains(t, buf.String(), "hello")
+}
+
+func TestFileMailer(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "mail")
+	m := NewFileMailer(dir, "noreply@example.com")
+
+	assert.NoError(t, m.Send(context.Background(), &Message{To: "alice@example.com", Subject: "hi", Body: "hello"}))
+	assert.NoError(t, m.Send(context.Background(), &Message{To: "bob@example.com", Subject: "hi", Body: "hello"}))
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+	assert.NoError(t, err)
+	assert.Len(t, files, 2)
+
+	data, err := os.ReadFile(files[0])
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), "hello")
+}
+
+func TestOptionsValidate(t *testing.T) {
+	o := NewOptions()
+	assert.Empty(t, o.Validate())
+
+	o.Driver = DriverSMTP
+	assert.Len(t, o.Validate(), 1)
+
+	o.Host = "smtp.example.com"
+	assert.Empty(t, o.Validate())
+
+	o.Driver = "pigeon"
+	assert.Len(t, o.Validate(), 1)
+}
@@ -0,0 +1,109 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+package mailer
+
+import (
+	"fmt"
+	"net/mail"
+	"os"
+
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// 定义支持的邮件发送方式.
+const (
+	// DriverStdout 将邮件打印到标准输出.
+	DriverStdout = "stdout"
+	// DriverFile 将邮件写入目录中的文件.
+	DriverFile = "file"
+	// DriverSMTP 通过 SMTP 服务器发送邮件.
+	DriverSMTP = "smtp"
+)
+
+// 定义支持的邮件发送方式集合.
+var availableDrivers = sets.New(DriverStdout, DriverFile, DriverSMTP)
+
+// Options 包含邮件发送的配置选项.
+type Options struct {
+	// Driver 定义邮件发送方式：stdout、file、smtp.
+	Driver string `json:"driver" mapstructure:"driver"`
+	// From 定义发件人地址.
+	From string `json:"from" mapstructure:"from"`
+	// Dir 定义 file 方式下保存邮件的目录.
+	Dir string `json:"dir" mapstructure:"dir"`
+	// Host 定义 SMTP 服务器地址.
+	Host string `json:"host" mapstructure:"host"`
+	// Port 定义 SMTP 服务器端口.
+	Port int `json:"port" mapstructure:"port"`
+	// Username 定义 SMTP 认证用户名，为空时不进行认证.
+	Username string `json:"username" mapstructure:"username"`
+	// Password 定义 SMTP 认证密码.
+	Password string `json:"password" mapstructure:"password"`
+}
+
+// NewOptions 创建带有默认值的 Options 实例.
+func NewOptions() *Options {
+	return &Options{
+		Driver: DriverStdout,
+		From:   "miniblog <noreply@miniblog.local>",
+		Dir:    "_output/mail",
+		Port:   587,
+	}
+}
+
+// AddFlags 将 Options 的选项绑定到命令行标志.
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Driver, "mailer.driver", o.Driver, fmt.Sprintf("How emails are delivered, available options: %v", sets.List(availableDrivers)))
+	fs.StringVar(&o.From, "mailer.from", o.From, "The sender address of outgoing emails.")
+	fs.StringVar(&o.Dir, "mailer.dir", o.Dir, "Directory where emails are written when mailer.driver is file.")
+	fs.StringVar(&o.Host, "mailer.host", o.Host, "SMTP server host.")
+	fs.IntVar(&o.Port, "mailer.port", o.Port, "SMTP server port.")
+	fs.StringVar(&o.Username, "mailer.username", o.Username, "SMTP username. Authentication is skipped when empty.")
+	fs.StringVar(&o.Password, "mailer.password", o.Password, "SMTP password.")
+}
+
+// Validate 校验 Options 中的选项是否合法.
+func (o *Options) Validate() []error {
+	errs := []error{}
+
+	if !availableDrivers.Has(o.Driver) {
+		errs = append(errs, fmt.Errorf("invalid mailer driver: must be one of %v", sets.List(availableDrivers)))
+	}
+	if _, err := mail.ParseAddress(o.From); err != nil {
+		errs = append(errs, fmt.Errorf("invalid mailer from address %q: %w", o.From, err))
+	}
+
+	switch o.Driver {
+	case DriverFile:
+		if o.Dir == "" {
+			errs = append(errs, fmt.Errorf("mailer.dir cannot be empty when mailer.driver is %s", DriverFile))
+		}
+	case DriverSMTP:
+		if o.Host == "" {
+			errs = append(errs, fmt.Errorf("mailer.host cannot be empty when mailer.driver is %s", DriverSMTP))
+		}
+		if o.Port <= 0 || o.Port > 65535 {
+			errs = append(errs, fmt.Errorf("mailer.port must be between 1 and 65535"))
+		}
+	}
+
+	return errs
+}
+
+// NewMailer 根据配置创建 Mailer.
+func (o *Options) NewMailer() (Mailer, error) {
+	switch o.Driver {
+	case DriverStdout:
+		return NewWriterMailer(os.Stdout, o.From), nil
+	case DriverFile:
+		return NewFileMailer(o.Dir, o.From), nil
+	case DriverSMTP:
+		return NewSMTPMailer(o.Host, o.Port, o.Username, o.Password, o.From), nil
+	default:
+		return nil, fmt.Errorf("unsupported mailer driver %q", o.Driver)
+	}
+}
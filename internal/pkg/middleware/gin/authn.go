@@ -2,6 +2,7 @@ package gin
 
 import (
 	"context"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/ra1n6ow/gpkg/core"
@@ -9,7 +10,9 @@ import (
 	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
 	"github.com/ra1n6ow/miniblog/internal/pkg/contextx"
 	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/known"
 	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+	"github.com/ra1n6ow/miniblog/internal/pkg/scope"
 	"github.com/ra1n6ow/miniblog/pkg/token"
 )
 
@@ -19,30 +22,100 @@ type UserRetriever interface {
 	GetUser(ctx context.Context, userID string) (*model.UserM, error)
 }
 
+// TokenChecker 用于检查访问令牌是否已被撤销的接口.
+type TokenChecker interface {
+	// CheckToken 检查访问令牌是否仍然有效，令牌已被撤销时返回错误
+	CheckToken(ctx context.Context, claims *token.Claims, user *model.UserM) error
+	// CheckAccessToken 检查个人访问令牌是否有效，返回令牌信息
+	CheckAccessToken(ctx context.Context, accessToken string) (*model.AccessTokenM, error)
+}
+
 // AuthnMiddleware 是一个认证中间件，用于从 gin.Context 中提取 token 并验证 token 是否合法.
-func AuthnMiddleware(retriever UserRetriever) gin.HandlerFunc {
+// 除了 JWT Token 之外，还接受个人访问令牌.
+func AuthnMiddleware(retriever UserRetriever, checker TokenChecker) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		tokenString, err := token.FromRequest(c)
+		if err != nil {
+			core.WriteResponse(c, nil, errno.ErrTokenInvalid.WithMessage("%s", err.Error()))
+			c.Abort()
+			return
+		}
+
+		if strings.HasPrefix(tokenString, known.AccessTokenPrefix) {
+			authnAccessToken(c, retriever, checker, tokenString)
+			return
+		}
+
 		// 解析 JWT Token
-		userID, err := token.ParseRequest(c)
+		claims, err := token.ParseString(tokenString)
 		if err != nil {
 			core.WriteResponse(c, nil, errno.ErrTokenInvalid.WithMessage("%s", err.Error()))
 			c.Abort()
 			return
 		}
 
-		log.Debugw("Token parsing successful", "userID", userID)
+		log.Debugw("Token parsing successful", "userID", claims.Identity)
 
-		user, err := retriever.GetUser(c, userID)
+		// 用户信息从缓存中获取，用于检查令牌是否已被撤销. 用户名和角色优先使用令牌中的声明，
+		// 令牌在用户最后修改之前签发时声明可能已经过期，改为使用缓存中的用户名
+		user, err := retriever.GetUser(c, claims.Identity)
 		if err != nil {
 			core.WriteResponse(c, nil, errno.ErrUnauthenticated.WithMessage("%s", err.Error()))
 			c.Abort()
 			return
 		}
 
+		// 检查令牌是否已被撤销
+		if err := checker.CheckToken(c, claims, user); err != nil {
+			core.WriteResponse(c, nil, err)
+			c.Abort()
+			return
+		}
+
 		ctx := contextx.WithUserID(c.Request.Context(), user.UserID)
-		ctx = contextx.WithUsername(ctx, user.Username)
+		username, roles := userClaims(claims, user)
+		ctx = contextx.WithUsername(ctx, username)
+		if roles != nil {
+			ctx = contextx.WithRoles(ctx, roles)
+		}
+		ctx = contextx.WithTokenID(ctx, claims.ID)
+		ctx = contextx.WithTokenExpiresAt(ctx, claims.ExpiresAt)
+		ctx = contextx.WithSessionID(ctx, claims.SessionID)
 		c.Request = c.Request.WithContext(ctx)
 
 		c.Next()
 	}
 }
+
+// authnAccessToken 使用个人访问令牌认证请求，并将令牌的权限范围存入上下文，由 ScopeMiddleware 校验.
+func authnAccessToken(c *gin.Context, retriever UserRetriever, checker TokenChecker, accessToken string) {
+	tokenM, err := checker.CheckAccessToken(c, accessToken)
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		c.Abort()
+		return
+	}
+
+	user, err := retriever.GetUser(c, tokenM.UserID)
+	if err != nil {
+		core.WriteResponse(c, nil, errno.ErrUnauthenticated.WithMessage("%s", err.Error()))
+		c.Abort()
+		return
+	}
+
+	ctx := contextx.WithUserID(c.Request.Context(), user.UserID)
+	ctx = contextx.WithUsername(ctx, user.Username)
+	ctx = contextx.WithScopes(ctx, scope.Split(tokenM.Scopes))
+	c.Request = c.Request.WithContext(ctx)
+
+	c.Next()
+}
+
+// userClaims 返回令牌中存放的用户名和角色. 修改用户名或者角色时会更新用户的修改时间，
+// 令牌在用户最后修改之前签发（或者是旧版本签发的令牌）时，使用查询到的用户名，并且不返回角色，调用方需要自行查询.
+func userClaims(claims *token.Claims, user *model.UserM) (string, []string) {
+	if claims.Username == "" || !claims.IssuedAt.After(user.UpdatedAt) {
+		return user.Username, nil
+	}
+	return claims.Username, claims.Roles
+}
@@ -0,0 +1,37 @@
+package gin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ra1n6ow/miniblog/internal/apiserver/model"
+	"github.com/ra1n6ow/miniblog/pkg/token"
+)
+
+func TestUserClaims(t *testing.T) {
+	issuedAt := time.Unix(1700000000, 0)
+	roles := []string{"role::admin"}
+
+	tests := []struct {
+		name         string
+		claims       *token.Claims
+		updatedAt    time.Time
+		wantUsername string
+		wantRoles    []string
+	}{
+		{"issued after the last update", &token.Claims{Username: "alice", Roles: roles, IssuedAt: issuedAt}, issuedAt.Add(-time.Second), "alice", roles},
+		// 令牌签发后用户改名或者角色发生了变化，令牌中的声明已经过期
+		{"issued before the last update", &token.Claims{Username: "alice", Roles: roles, IssuedAt: issuedAt}, issuedAt.Add(time.Second), "bob", nil},
+		{"issued at the last update", &token.Claims{Username: "alice", Roles: roles, IssuedAt: issuedAt}, issuedAt, "bob", nil},
+		{"legacy token", &token.Claims{IssuedAt: issuedAt}, issuedAt.Add(-time.Second), "bob", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			username, roles := userClaims(tt.claims, &model.UserM{Username: "bob", UpdatedAt: tt.updatedAt})
+			assert.Equal(t, tt.wantUsername, username)
+			assert.Equal(t, tt.wantRoles, roles)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+package gin
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/ra1n6ow/miniblog/internal/pkg/clientip"
+	"github.com/ra1n6ow/miniblog/internal/pkg/contextx"
+)
+
+// ClientIPMiddleware 是一个 Gin 中间件，将客户端 IP 和 User-Agent 保存到请求的上下文中，供业务层使用.
+// 客户端 IP 由 resolver 解析，只有对端是可信代理时才读取 X-Forwarded-For，与 gRPC 服务的规则一致.
+func ClientIPMiddleware(resolver *clientip.Resolver) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ip := resolver.Resolve(c.Request.RemoteAddr, c.Request.Header.Values("X-Forwarded-For"))
+		ctx := contextx.WithClientIP(c.Request.Context(), ip)
+		ctx = contextx.WithUserAgent(ctx, c.Request.UserAgent())
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
@@ -0,0 +1,75 @@
+package gin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ra1n6ow/miniblog/internal/pkg/clientip"
+	"github.com/ra1n6ow/miniblog/internal/pkg/contextx"
+	"github.com/ra1n6ow/miniblog/internal/pkg/lockout"
+)
+
+func TestClientIPMiddlewareIgnoresSpoofedForwardedFor(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	resolver, err := clientip.New(nil)
+	require.NoError(t, err)
+
+	// 与登录保护一样，按上下文中的客户端 IP 记录登录失败
+	guard := lockout.New(lockout.Policy{Threshold: 3, Duration: time.Minute})
+	engine := gin.New()
+	engine.Use(ClientIPMiddleware(resolver))
+	engine.POST("/login", func(c *gin.Context) {
+		ip := contextx.ClientIP(c.Request.Context())
+		if _, locked := guard.Check(ip); locked {
+			c.Status(http.StatusTooManyRequests)
+			return
+		}
+		guard.Fail(ip)
+		c.String(http.StatusUnauthorized, ip)
+	})
+
+	for _, forwardedFor := range []string{"", "198.51.100.1", "198.51.100.2", "198.51.100.3"} {
+		req := httptest.NewRequest(http.MethodPost, "/login", nil)
+		req.RemoteAddr = "203.0.113.7:4321"
+		if forwardedFor != "" {
+			req.Header.Set("X-Forwarded-For", forwardedFor)
+		}
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, req)
+
+		// 每次伪造不同的 X-Forwarded-For 都不会改变锁定的 key
+		if forwardedFor == "198.51.100.3" {
+			assert.Equal(t, http.StatusTooManyRequests, w.Code)
+			continue
+		}
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Equal(t, "203.0.113.7", w.Body.String())
+	}
+}
+
+func TestClientIPMiddlewareTrustedProxy(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	resolver, err := clientip.New([]string{"10.0.0.0/8"})
+	require.NoError(t, err)
+
+	engine := gin.New()
+	engine.Use(ClientIPMiddleware(resolver))
+	engine.GET("/", func(c *gin.Context) {
+		c.String(http.StatusOK, contextx.ClientIP(c.Request.Context()))
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "10.0.0.1:4321"
+	req.Header.Set("X-Forwarded-For", "1.1.1.1, 198.51.100.1")
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, req)
+	assert.Equal(t, "198.51.100.1", w.Body.String())
+}
@@ -0,0 +1,29 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+package gin
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/ra1n6ow/gpkg/core"
+
+	"github.com/ra1n6ow/miniblog/internal/pkg/contextx"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/ratelimit"
+)
+
+// RateLimitMiddleware 是一个限流中间件，按客户端 IP 限制请求频率，超过限制时返回 429.
+// 客户端 IP 由 ClientIPMiddleware 解析，因此需要注册在 ClientIPMiddleware 之后.
+func RateLimitMiddleware(limiter *ratelimit.Limiter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !limiter.Allow(contextx.ClientIP(c.Request.Context())) {
+			core.WriteResponse(c, nil, errno.ErrTooManyRequests)
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
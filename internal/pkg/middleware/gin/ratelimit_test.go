@@ -0,0 +1,44 @@
+package gin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ra1n6ow/miniblog/internal/pkg/clientip"
+	"github.com/ra1n6ow/miniblog/internal/pkg/ratelimit"
+)
+
+func TestRateLimitMiddlewareIgnoresSpoofedForwardedFor(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	resolver, err := clientip.New(nil)
+	require.NoError(t, err)
+
+	engine := gin.New()
+	engine.Use(ClientIPMiddleware(resolver))
+	var served []string
+	engine.GET("/v1/previews/:linkID", RateLimitMiddleware(ratelimit.New(1, time.Minute)), func(c *gin.Context) {
+		served = append(served, c.Request.RemoteAddr)
+	})
+
+	serve := func(remoteAddr, forwardedFor string) {
+		req := httptest.NewRequest(http.MethodGet, "/v1/previews/link-1", nil)
+		req.RemoteAddr = remoteAddr
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+		engine.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	serve("203.0.113.7:4321", "198.51.100.1")
+	// 伪造不同的 X-Forwarded-For 不能绕过限流
+	serve("203.0.113.7:4321", "198.51.100.2")
+	// 其他客户端不受影响
+	serve("203.0.113.8:4321", "198.51.100.2")
+
+	assert.Equal(t, []string{"203.0.113.7:4321", "203.0.113.8:4321"}, served)
+}
@@ -0,0 +1,38 @@
+package gin
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/ra1n6ow/gpkg/core"
+
+	"github.com/ra1n6ow/miniblog/internal/pkg/contextx"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/scope"
+)
+
+// ScopeMiddleware 是一个 Gin 中间件，用于校验个人访问令牌的权限范围，需要放在认证中间件之后.
+// scopes 的 key 为请求方法和路由，例如 "GET /v1/posts/:postID"，value 为访问该路由需要的权限范围.
+// 不在 scopes 中的路由不允许使用个人访问令牌访问. 使用 JWT Token 认证的请求不受权限范围限制.
+func ScopeMiddleware(scopes map[string]string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		granted, ok := contextx.Scopes(c.Request.Context())
+		if !ok {
+			c.Next()
+			return
+		}
+
+		required, ok := scopes[c.Request.Method+" "+c.FullPath()]
+		if !ok {
+			core.WriteResponse(c, nil, errno.ErrInsufficientScope.WithMessage("Access tokens cannot be used for %s %s", c.Request.Method, c.FullPath()))
+			c.Abort()
+			return
+		}
+		if !scope.Allows(granted, required) {
+			core.WriteResponse(c, nil, errno.ErrInsufficientScope.WithMessage("Access token requires scope %s", required))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"strings"
 
 	"google.golang.org/grpc"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
 	"github.com/ra1n6ow/miniblog/internal/pkg/known"
 	"github.com/ra1n6ow/miniblog/internal/pkg/log"
+	"github.com/ra1n6ow/miniblog/internal/pkg/scope"
 	"github.com/ra1n6ow/miniblog/pkg/token"
 )
 
@@ -19,34 +21,104 @@ type UserRetriever interface {
 	GetUser(ctx context.Context, userID string) (*model.UserM, error)
 }
 
-// AuthnInterceptor 是一个 gRPC 拦截器，用于进行认证.
-func AuthnInterceptor(retriever UserRetriever) grpc.UnaryServerInterceptor {
+// TokenChecker 用于检查访问令牌是否已被撤销的接口.
+type TokenChecker interface {
+	// CheckToken 检查访问令牌是否仍然有效，令牌已被撤销时返回错误
+	CheckToken(ctx context.Context, claims *token.Claims, user *model.UserM) error
+	// CheckAccessToken 检查个人访问令牌是否有效，返回令牌信息
+	CheckAccessToken(ctx context.Context, accessToken string) (*model.AccessTokenM, error)
+}
+
+// AuthnInterceptor 是一个 gRPC 拦截器，用于进行认证. 除了 JWT Token 之外，还接受个人访问令牌.
+func AuthnInterceptor(retriever UserRetriever, checker TokenChecker) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		tokenString, err := token.FromRequest(ctx)
+		if err != nil {
+			log.Errorw("Failed to parse request", "err", err)
+			return nil, errno.ErrTokenInvalid.WithMessage("%s", err.Error())
+		}
+
+		if strings.HasPrefix(tokenString, known.AccessTokenPrefix) {
+			ctx, err = authnAccessToken(ctx, retriever, checker, tokenString)
+			if err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}
+
 		// 解析 JWT Token
-		userID, err := token.ParseRequest(ctx)
+		claims, err := token.ParseString(tokenString)
 		if err != nil {
 			log.Errorw("Failed to parse request", "err", err)
 			return nil, errno.ErrTokenInvalid.WithMessage("%s", err.Error())
 		}
+		userID := claims.Identity
 
 		log.Debugw("Token parsing successful", "userID", userID)
 
+		// 用户信息从缓存中获取，用于检查令牌是否已被撤销. 用户名和角色优先使用令牌中的声明，
+		// 令牌在用户最后修改之前签发时声明可能已经过期，改为使用缓存中的用户名
 		user, err := retriever.GetUser(ctx, userID)
 		if err != nil {
 			return nil, errno.ErrUnauthenticated.WithMessage("%s", err.Error())
 		}
 
+		// 检查令牌是否已被撤销
+		if err := checker.CheckToken(ctx, claims, user); err != nil {
+			return nil, err
+		}
+
+		username, roles := userClaims(claims, user)
+
 		// 将用户信息存入上下文
 		//nolint:staticcheck
-		ctx = context.WithValue(ctx, known.XUsername, user.Username)
+		ctx = context.WithValue(ctx, known.XUsername, username)
 		//nolint:staticcheck
 		ctx = context.WithValue(ctx, known.XUserID, userID)
 
 		// 供 log 和 contextx 使用
 		ctx = contextx.WithUserID(ctx, user.UserID)
-		ctx = contextx.WithUsername(ctx, user.Username)
+		ctx = contextx.WithUsername(ctx, username)
+		if roles != nil {
+			ctx = contextx.WithRoles(ctx, roles)
+		}
+		ctx = contextx.WithTokenID(ctx, claims.ID)
+		ctx = contextx.WithTokenExpiresAt(ctx, claims.ExpiresAt)
+		ctx = contextx.WithSessionID(ctx, claims.SessionID)
 
 		// 继续处理请求
 		return handler(ctx, req)
 	}
 }
+
+// authnAccessToken 使用个人访问令牌认证请求，返回存入了用户信息和令牌权限范围的上下文，权限范围由 ScopeInterceptor 校验.
+func authnAccessToken(ctx context.Context, retriever UserRetriever, checker TokenChecker, accessToken string) (context.Context, error) {
+	tokenM, err := checker.CheckAccessToken(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := retriever.GetUser(ctx, tokenM.UserID)
+	if err != nil {
+		return nil, errno.ErrUnauthenticated.WithMessage("%s", err.Error())
+	}
+
+	//nolint:staticcheck
+	ctx = context.WithValue(ctx, known.XUsername, user.Username)
+	//nolint:staticcheck
+	ctx = context.WithValue(ctx, known.XUserID, user.UserID)
+
+	ctx = contextx.WithUserID(ctx, user.UserID)
+	ctx = contextx.WithUsername(ctx, user.Username)
+	ctx = contextx.WithScopes(ctx, scope.Split(tokenM.Scopes))
+	return ctx, nil
+}
+
+// userClaims 返回令牌中存放的用户名和角色. 修改用户名或者角色时会更新用户的修改时间，
+// 令牌在用户最后修改之前签发（或者是旧版本签发的令牌）时，使用查询到的用户名，并且不返回角色，调用方需要自行查询.
+func userClaims(claims *token.Claims, user *model.UserM) (string, []string) {
+	if claims.Username == "" || !claims.IssuedAt.After(user.UpdatedAt) {
+		return user.Username, nil
+	}
+	return claims.Username, claims.Roles
+}
@@ -0,0 +1,48 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/ra1n6ow/miniblog/internal/pkg/clientip"
+	"github.com/ra1n6ow/miniblog/internal/pkg/contextx"
+)
+
+// ClientIPInterceptor 是一个 gRPC 拦截器，将客户端 IP 和 User-Agent 保存到请求的上下文中，供业务层使用.
+// 客户端 IP 由 resolver 根据对端地址和 x-forwarded-for 解析，gRPC-Gateway 转发的请求需要 resolver 信任本机地址.
+func ClientIPInterceptor(resolver *clientip.Resolver) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx = contextx.WithClientIP(ctx, clientIP(ctx, resolver))
+		ctx = contextx.WithUserAgent(ctx, userAgent(ctx))
+		return handler(ctx, req)
+	}
+}
+
+// clientIP 返回发起请求的客户端 IP.
+func clientIP(ctx context.Context, resolver *clientip.Resolver) string {
+	var remoteAddr string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		remoteAddr = p.Addr.String()
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	return resolver.Resolve(remoteAddr, md.Get("x-forwarded-for"))
+}
+
+// userAgent 返回客户端的 User-Agent. 通过 gRPC-Gateway 转发的请求优先使用原始 HTTP 请求的 User-Agent.
+func userAgent(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, key := range []string{"grpcgateway-user-agent", "user-agent"} {
+		if values := md.Get(key); len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,28 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/ra1n6ow/miniblog/internal/pkg/contextx"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/ratelimit"
+)
+
+// RateLimitInterceptor 是一个 gRPC 拦截器，按客户端 IP 限制请求频率.
+// 客户端 IP 由 ClientIPInterceptor 解析，因此需要注册在 ClientIPInterceptor 之后.
+func RateLimitInterceptor(limiter *ratelimit.Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !limiter.Allow(contextx.ClientIP(ctx)) {
+			return nil, errno.ErrTooManyRequests
+		}
+
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,33 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/ra1n6ow/miniblog/internal/pkg/contextx"
+	"github.com/ra1n6ow/miniblog/internal/pkg/errno"
+	"github.com/ra1n6ow/miniblog/internal/pkg/scope"
+)
+
+// ScopeInterceptor 是一个 gRPC 拦截器，用于校验个人访问令牌的权限范围，需要放在认证拦截器之后.
+// scopes 的 key 为 gRPC 方法全名，value 为调用该方法需要的权限范围. 不在 scopes 中的方法不允许使用
+// 个人访问令牌调用. 使用 JWT Token 认证的请求不受权限范围限制.
+func ScopeInterceptor(scopes map[string]string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		granted, ok := contextx.Scopes(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		required, ok := scopes[info.FullMethod]
+		if !ok {
+			return nil, errno.ErrInsufficientScope.WithMessage("Access tokens cannot be used for %s", info.FullMethod)
+		}
+		if !scope.Allows(granted, required) {
+			return nil, errno.ErrInsufficientScope.WithMessage("Access token requires scope %s", required)
+		}
+
+		return handler(ctx, req)
+	}
+}
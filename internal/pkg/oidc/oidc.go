@@ -0,0 +1,340 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+// Package oidc 实现 OpenID Connect 授权码模式的客户端：通过 Discovery 获取身份提供方的配置，
+// 使用 PKCE 防止授权码被截获后冒用，并使用 JWKS 中的公钥校验 ID Token.
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	// httpTimeout 为请求身份提供方的超时时间.
+	httpTimeout = 10 * time.Second
+	// maxResponseSize 为身份提供方响应的最大长度.
+	maxResponseSize = 1 << 20
+	// keysRefreshInterval 为遇到未知 kid 时重新获取 JWKS 的最小间隔，避免伪造的令牌导致频繁请求身份提供方.
+	keysRefreshInterval = time.Minute
+	// clockSkew 为校验 ID Token 时间时允许的时钟误差.
+	clockSkew = time.Minute
+)
+
+// defaultScopes 为未配置权限范围时申请的权限范围.
+var defaultScopes = []string{"openid", "email", "profile"}
+
+// validMethods 为允许的 ID Token 签名算法. 不允许 none 和 HMAC 算法.
+var validMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
+
+var (
+	// ErrUnknownKey 表示 ID Token 的签名公钥不在身份提供方的 JWKS 中.
+	ErrUnknownKey = errors.New("oidc: unknown signing key")
+	// ErrNonceMismatch 表示 ID Token 中的 nonce 和发起登录时生成的 nonce 不一致.
+	ErrNonceMismatch = errors.New("oidc: nonce mismatch")
+)
+
+// Claims 为 ID Token 中和登录相关的声明.
+type Claims struct {
+	// Subject 为用户在身份提供方的唯一标识.
+	Subject string
+	// Email 为用户的电子邮箱地址.
+	Email string
+	// EmailVerified 表示身份提供方是否验证过用户的电子邮箱地址.
+	EmailVerified bool
+	// Name 为用户的显示名称.
+	Name string
+	// PreferredUsername 为用户希望使用的用户名.
+	PreferredUsername string
+}
+
+// idTokenClaims 为 ID Token 的 payload.
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce             string `json:"nonce"`
+	AuthorizedParty   string `json:"azp"`
+	Email             string `json:"email"`
+	EmailVerified     any    `json:"email_verified"`
+	Name              string `json:"name"`
+	PreferredUsername string `json:"preferred_username"`
+}
+
+// discovery 为身份提供方 Discovery 文档中用到的字段.
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// tokenResponse 为令牌端点的响应.
+type tokenResponse struct {
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// Provider 为一个 OIDC 身份提供方的客户端，可以被多个 goroutine 并发使用.
+// Discovery 文档和 JWKS 在第一次使用时获取并缓存.
+type Provider struct {
+	opts   *Options
+	client *http.Client
+	now    func() time.Time
+
+	mu            sync.Mutex
+	discovery     *discovery
+	keys          map[string]crypto.PublicKey
+	keysFetchedAt time.Time
+}
+
+// NewProvider 创建身份提供方客户端. client 为 nil 时使用带超时的默认客户端.
+func NewProvider(opts *Options, client *http.Client) *Provider {
+	if client == nil {
+		client = &http.Client{Timeout: httpTimeout}
+	}
+	return &Provider{opts: opts, client: client, now: time.Now}
+}
+
+// Name 返回身份提供方的名称.
+func (p *Provider) Name() string {
+	return p.opts.Name
+}
+
+// AuthCodeURL 返回身份提供方的授权地址，用户在该地址完成认证后，身份提供方携带授权码和 state 跳转到 RedirectURL.
+// codeVerifier 由调用方保存，交换授权码时使用.
+func (p *Provider) AuthCodeURL(ctx context.Context, state string, nonce string, codeVerifier string) (string, error) {
+	d, err := p.getDiscovery(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(d.AuthorizationEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("oidc: invalid authorization endpoint: %w", err)
+	}
+
+	scopes := p.opts.Scopes
+	if len(scopes) == 0 {
+		scopes = defaultScopes
+	}
+
+	q := u.Query()
+	q.Set("response_type", "code")
+	q.Set("client_id", p.opts.ClientID)
+	q.Set("redirect_uri", p.opts.RedirectURL)
+	q.Set("scope", strings.Join(scopes, " "))
+	q.Set("state", state)
+	q.Set("nonce", nonce)
+	q.Set("code_challenge", S256Challenge(codeVerifier))
+	q.Set("code_challenge_method", "S256")
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// Exchange 使用授权码和 code verifier 换取 ID Token，校验后返回 ID Token 中的声明.
+func (p *Provider) Exchange(ctx context.Context, code string, codeVerifier string, nonce string) (*Claims, error) {
+	d, err := p.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.opts.RedirectURL)
+	form.Set("code_verifier", codeVerifier)
+	form.Set("client_id", p.opts.ClientID)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	// 使用 client_secret_basic 方式认证客户端，见 RFC 6749 2.3.1 节
+	if p.opts.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.opts.ClientID), url.QueryEscape(p.opts.ClientSecret))
+	}
+
+	var rsp tokenResponse
+	status, err := p.doJSON(req, &rsp)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK || rsp.Error != "" {
+		return nil, fmt.Errorf("oidc: token endpoint returned %d: %s %s", status, rsp.Error, rsp.ErrorDescription)
+	}
+	if rsp.IDToken == "" {
+		return nil, errors.New("oidc: token response does not contain an id_token")
+	}
+
+	return p.Verify(ctx, rsp.IDToken, nonce)
+}
+
+// Verify 校验 ID Token 的签名、issuer、audience、有效期和 nonce，返回 ID Token 中的声明.
+func (p *Provider) Verify(ctx context.Context, rawIDToken string, nonce string) (*Claims, error) {
+	d, err := p.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims idTokenClaims
+	parser := jwt.NewParser(jwt.WithValidMethods(validMethods), jwt.WithoutClaimsValidation())
+	_, err = parser.ParseWithClaims(rawIDToken, &claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return p.getKey(ctx, d, kid)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("oidc: invalid id token: %w", err)
+	}
+
+	now := p.now()
+	switch {
+	case !claims.VerifyIssuer(d.Issuer, true):
+		return nil, fmt.Errorf("oidc: unexpected issuer %q", claims.Issuer)
+	case !claims.VerifyAudience(p.opts.ClientID, true):
+		return nil, fmt.Errorf("oidc: id token is not issued for client %q", p.opts.ClientID)
+	case len(claims.Audience) > 1 && claims.AuthorizedParty != p.opts.ClientID:
+		return nil, fmt.Errorf("oidc: unexpected authorized party %q", claims.AuthorizedParty)
+	case !claims.VerifyExpiresAt(now.Add(-clockSkew), true):
+		return nil, errors.New("oidc: id token has expired")
+	case !claims.VerifyNotBefore(now.Add(clockSkew), false):
+		return nil, errors.New("oidc: id token is not valid yet")
+	case claims.Subject == "":
+		return nil, errors.New("oidc: id token does not contain a subject")
+	case claims.Nonce != nonce:
+		return nil, ErrNonceMismatch
+	}
+
+	return &Claims{
+		Subject:           claims.Subject,
+		Email:             claims.Email,
+		EmailVerified:     parseBool(claims.EmailVerified),
+		Name:              claims.Name,
+		PreferredUsername: claims.PreferredUsername,
+	}, nil
+}
+
+// getDiscovery 返回身份提供方的 Discovery 文档，第一次调用时从身份提供方获取.
+func (p *Provider) getDiscovery(ctx context.Context) (*discovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	issuer := strings.TrimSuffix(p.opts.Issuer, "/")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, issuer+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var d discovery
+	status, err := p.doJSON(req, &d)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("oidc: discovery endpoint returned %d", status)
+	}
+	// 防止 Discovery 文档被篡改后使用其他身份提供方签发的令牌，见 OpenID Connect Discovery 4.3 节
+	if strings.TrimSuffix(d.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("oidc: issuer %q in discovery document does not match %q", d.Issuer, p.opts.Issuer)
+	}
+	if d.AuthorizationEndpoint == "" || d.TokenEndpoint == "" || d.JWKSURI == "" {
+		return nil, errors.New("oidc: discovery document is missing required endpoints")
+	}
+
+	p.discovery = &d
+	return p.discovery, nil
+}
+
+// getKey 返回 kid 对应的签名公钥. 找不到时重新获取 JWKS，以支持身份提供方轮换密钥.
+// kid 为空并且 JWKS 中只有一个公钥时使用该公钥.
+func (p *Provider) getKey(ctx context.Context, d *discovery, kid string) (crypto.PublicKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key, ok := p.lookupKey(kid); ok {
+		return key, nil
+	}
+	if p.keys != nil && p.now().Sub(p.keysFetchedAt) < keysRefreshInterval {
+		return nil, ErrUnknownKey
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, d.JWKSURI, nil)
+	if err != nil {
+		return nil, err
+	}
+	var set jsonWebKeySet
+	status, err := p.doJSON(req, &set)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("oidc: jwks endpoint returned %d", status)
+	}
+	p.keys = set.publicKeys()
+	p.keysFetchedAt = p.now()
+
+	if key, ok := p.lookupKey(kid); ok {
+		return key, nil
+	}
+	return nil, ErrUnknownKey
+}
+
+// lookupKey 在缓存的 JWKS 中查找公钥，调用方需要持有锁.
+func (p *Provider) lookupKey(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+	key, ok := p.keys[kid]
+	return key, ok
+}
+
+// doJSON 发送请求并将响应解析为 JSON，返回响应的状态码.
+func (p *Provider) doJSON(req *http.Request, v any) (int, error) {
+	rsp, err := p.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("oidc: %w", err)
+	}
+	defer rsp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(rsp.Body, maxResponseSize))
+	if err != nil {
+		return 0, fmt.Errorf("oidc: %w", err)
+	}
+	if err := json.Unmarshal(body, v); err != nil && rsp.StatusCode == http.StatusOK {
+		return 0, fmt.Errorf("oidc: invalid response from %s: %w", req.URL.Path, err)
+	}
+	return rsp.StatusCode, nil
+}
+
+// parseBool 解析 email_verified 声明. 部分身份提供方使用字符串 "true" 表示 true.
+func parseBool(v any) bool {
+	switch b := v.(type) {
+	case bool:
+		return b
+	case string:
+		return b == "true"
+	default:
+		return false
+	}
+}
@@ -0,0 +1,230 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubIdP 为测试使用的本地身份提供方.
+type stubIdP struct {
+	t      *testing.T
+	server *httptest.Server
+
+	mu        sync.Mutex
+	key       *rsa.PrivateKey
+	kid       string
+	audience  string
+	challenge string
+	nonce     string
+	claims    jwt.MapClaims
+	jwksCalls int
+}
+
+func newStubIdP(t *testing.T) *stubIdP {
+	t.Helper()
+
+	idp := &stubIdP{t: t, audience: "miniblog"}
+	idp.rotateKey("key-1")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 idp.server.URL,
+			"authorization_endpoint": idp.server.URL + "/authorize",
+			"token_endpoint":         idp.server.URL + "/token",
+			"jwks_uri":               idp.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		idp.mu.Lock()
+		defer idp.mu.Unlock()
+		idp.jwksCalls++
+		pub := idp.key.PublicKey
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": idp.kid,
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		idp.mu.Lock()
+		defer idp.mu.Unlock()
+		require.NoError(t, r.ParseForm())
+		if r.PostForm.Get("code") != "good-code" || S256Challenge(r.PostForm.Get("code_verifier")) != idp.challenge {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"id_token": idp.sign()})
+	})
+	idp.server = httptest.NewServer(mux)
+	t.Cleanup(idp.server.Close)
+
+	return idp
+}
+
+func (idp *stubIdP) rotateKey(kid string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(idp.t, err)
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	idp.key, idp.kid = key, kid
+}
+
+// authorize 模拟用户在身份提供方完成认证，记录授权请求中的 code challenge 和 nonce.
+func (idp *stubIdP) authorize(authURL string) {
+	u, err := url.Parse(authURL)
+	require.NoError(idp.t, err)
+	q := u.Query()
+	require.Equal(idp.t, "S256", q.Get("code_challenge_method"))
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	idp.challenge, idp.nonce = q.Get("code_challenge"), q.Get("nonce")
+}
+
+func (idp *stubIdP) sign() string {
+	claims := jwt.MapClaims{
+		"iss":            idp.server.URL,
+		"sub":            "alice-sub",
+		"aud":            idp.audience,
+		"exp":            time.Now().Add(time.Hour).Unix(),
+		"iat":            time.Now().Unix(),
+		"nonce":          idp.nonce,
+		"email":          "alice@example.com",
+		"email_verified": true,
+	}
+	for k, v := range idp.claims {
+		claims[k] = v
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = idp.kid
+	raw, err := token.SignedString(idp.key)
+	require.NoError(idp.t, err)
+	return raw
+}
+
+func newTestProvider(idp *stubIdP) *Provider {
+	return NewProvider(&Options{
+		Name:        "stub",
+		Issuer:      idp.server.URL,
+		ClientID:    "miniblog",
+		RedirectURL: "http://localhost/callback",
+	}, nil)
+}
+
+// login 执行一次完整的授权码登录.
+func login(t *testing.T, idp *stubIdP, p *Provider, code string) (*Claims, error) {
+	t.Helper()
+	verifier, err := NewVerifier()
+	require.NoError(t, err)
+	authURL, err := p.AuthCodeURL(context.Background(), "state", "nonce-1", verifier)
+	require.NoError(t, err)
+	idp.authorize(authURL)
+	return p.Exchange(context.Background(), code, verifier, "nonce-1")
+}
+
+func TestExchange(t *testing.T) {
+	idp := newStubIdP(t)
+	p := newTestProvider(idp)
+
+	claims, err := login(t, idp, p, "good-code")
+	require.NoError(t, err)
+	assert.Equal(t, "alice-sub", claims.Subject)
+	assert.Equal(t, "alice@example.com", claims.Email)
+	assert.True(t, claims.EmailVerified)
+}
+
+func TestExchangeRejected(t *testing.T) {
+	idp := newStubIdP(t)
+	p := newTestProvider(idp)
+
+	// 授权码无效
+	_, err := login(t, idp, p, "bad-code")
+	assert.Error(t, err)
+
+	// code verifier 和授权请求中的 code challenge 不匹配
+	verifier, _ := NewVerifier()
+	authURL, err := p.AuthCodeURL(context.Background(), "state", "nonce-1", verifier)
+	require.NoError(t, err)
+	idp.authorize(authURL)
+	other, _ := NewVerifier()
+	_, err = p.Exchange(context.Background(), "good-code", other, "nonce-1")
+	assert.Error(t, err)
+}
+
+func TestVerify(t *testing.T) {
+	idp := newStubIdP(t)
+	p := newTestProvider(idp)
+	ctx := context.Background()
+	idp.nonce = "nonce-1"
+
+	_, err := p.Verify(ctx, idp.sign(), "nonce-2")
+	assert.ErrorIs(t, err, ErrNonceMismatch)
+
+	idp.audience = "other-client"
+	_, err = p.Verify(ctx, idp.sign(), "nonce-1")
+	assert.Error(t, err)
+	idp.audience = "miniblog"
+
+	idp.claims = jwt.MapClaims{"exp": time.Now().Add(-time.Hour).Unix()}
+	_, err = p.Verify(ctx, idp.sign(), "nonce-1")
+	assert.Error(t, err)
+
+	idp.claims = jwt.MapClaims{"email_verified": "true"}
+	claims, err := p.Verify(ctx, idp.sign(), "nonce-1")
+	require.NoError(t, err)
+	assert.True(t, claims.EmailVerified)
+
+	// 使用 HMAC 算法签名的令牌不被接受
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"iss": idp.server.URL, "aud": "miniblog", "sub": "x"})
+	raw, _ := token.SignedString([]byte("secret"))
+	_, err = p.Verify(ctx, raw, "")
+	assert.Error(t, err)
+}
+
+func TestKeyRotation(t *testing.T) {
+	idp := newStubIdP(t)
+	p := newTestProvider(idp)
+	ctx := context.Background()
+	idp.nonce = "nonce-1"
+
+	_, err := p.Verify(ctx, idp.sign(), "nonce-1")
+	require.NoError(t, err)
+
+	// 身份提供方轮换密钥后，未知的 kid 触发重新获取 JWKS
+	idp.rotateKey("key-2")
+	p.now = func() time.Time { return time.Now().Add(2 * keysRefreshInterval) }
+	_, err = p.Verify(ctx, idp.sign(), "nonce-1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, idp.jwksCalls)
+
+	// 短时间内不会因为未知的 kid 重复获取 JWKS
+	idp.rotateKey("key-3")
+	_, err = p.Verify(ctx, idp.sign(), "nonce-1")
+	assert.ErrorIs(t, err, ErrUnknownKey)
+	assert.Equal(t, 2, idp.jwksCalls)
+}
+
+func TestDiscoveryIssuerMismatch(t *testing.T) {
+	idp := newStubIdP(t)
+	p := NewProvider(&Options{Name: "stub", Issuer: idp.server.URL + "/other", ClientID: "miniblog"}, nil)
+
+	_, err := p.AuthCodeURL(context.Background(), "state", "nonce", "verifier")
+	assert.Error(t, err)
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+package oidc
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+)
+
+// nameRegex 限制身份提供方名称的格式，名称会出现在 URL 路径中.
+var nameRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,31}$`)
+
+// Options 包含一个 OIDC 身份提供方的配置选项.
+type Options struct {
+	// Name 定义身份提供方的名称，用于区分不同的身份提供方，例如 corp.
+	Name string `json:"name" mapstructure:"name"`
+	// Issuer 定义身份提供方的 Issuer 地址，服务会从 <Issuer>/.well-known/openid-configuration 获取配置.
+	Issuer string `json:"issuer" mapstructure:"issuer"`
+	// ClientID 定义在身份提供方注册的客户端 ID.
+	ClientID string `json:"client-id" mapstructure:"client-id"`
+	// ClientSecret 定义在身份提供方注册的客户端密钥，公共客户端可以为空.
+	ClientSecret string `json:"client-secret" mapstructure:"client-secret"`
+	// RedirectURL 定义身份提供方完成认证后的回调地址，需要和在身份提供方注册的地址一致.
+	RedirectURL string `json:"redirect-url" mapstructure:"redirect-url"`
+	// Scopes 定义申请的权限范围，为空时使用 openid、email 和 profile.
+	Scopes []string `json:"scopes" mapstructure:"scopes"`
+}
+
+// Validate 校验 Options 中的选项是否合法.
+func (o *Options) Validate() []error {
+	errs := []error{}
+
+	if !nameRegex.MatchString(o.Name) {
+		errs = append(errs, fmt.Errorf("invalid oidc provider name %q: must match %s", o.Name, nameRegex))
+	}
+	if u, err := url.Parse(o.Issuer); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errs = append(errs, fmt.Errorf("invalid issuer %q of oidc provider %s: must be an absolute http or https URL", o.Issuer, o.Name))
+	}
+	if o.ClientID == "" {
+		errs = append(errs, fmt.Errorf("client-id of oidc provider %s cannot be empty", o.Name))
+	}
+	if u, err := url.Parse(o.RedirectURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errs = append(errs, fmt.Errorf("invalid redirect-url %q of oidc provider %s: must be an absolute http or https URL", o.RedirectURL, o.Name))
+	}
+
+	// 没有申请 openid 权限范围时身份提供方不会返回 ID Token
+	if len(o.Scopes) > 0 && !slices.Contains(o.Scopes, "openid") {
+		errs = append(errs, fmt.Errorf("scopes of oidc provider %s must include openid", o.Name))
+	}
+
+	return errs
+}
@@ -0,0 +1,33 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+// RandomString 生成 n 个随机字节，返回其 Base64 URL 编码. 可以用作 state、nonce 和 PKCE 的 code verifier.
+func RandomString(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// NewVerifier 生成 PKCE 的 code verifier，见 RFC 7636 4.1 节.
+func NewVerifier() (string, error) {
+	// 32 个随机字节编码后为 43 个字符，满足 43 到 128 个字符的要求
+	return RandomString(32)
+}
+
+// S256Challenge 返回 code verifier 使用 S256 方法生成的 code challenge，见 RFC 7636 4.2 节.
+func S256Challenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
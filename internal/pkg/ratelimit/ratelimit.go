@@ -0,0 +1,87 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+// Package ratelimit 提供按 key（例如客户端 IP）分别限流的令牌桶限流器.
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// minPruneSize 为触发清理空闲 key 的最小 key 数量.
+const minPruneSize = 1024
+
+// Limiter 是按 key 分别计数的令牌桶限流器，可以被多个 goroutine 并发使用.
+// 每个 key 的令牌桶最多保存 burst 个令牌，并以固定速率补充.
+type Limiter struct {
+	mu sync.Mutex
+	// rate 为每秒补充的令牌数.
+	rate  float64
+	burst float64
+
+	buckets map[string]*bucket
+	// pruneSize 为下次清理空闲 key 时的 key 数量.
+	pruneSize int
+	now       func() time.Time
+}
+
+// bucket 为单个 key 的令牌桶.
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// New 创建限流器，每个 key 在 per 时间内最多允许 limit 次请求，允许的突发请求数也为 limit.
+func New(limit int, per time.Duration) *Limiter {
+	return &Limiter{
+		rate:      float64(limit) / per.Seconds(),
+		burst:     float64(limit),
+		buckets:   make(map[string]*bucket),
+		pruneSize: minPruneSize,
+		now:       time.Now,
+	}
+}
+
+// Allow 报告 key 当前是否允许一次请求，允许时消耗一个令牌.
+func (l *Limiter) Allow(key string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	b, ok := l.buckets[key]
+	if !ok {
+		if len(l.buckets) >= l.pruneSize {
+			l.prune(now)
+		}
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	} else {
+		b.tokens = l.refill(b, now)
+		b.last = now
+	}
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// refill 返回令牌桶在 now 时刻的令牌数.
+func (l *Limiter) refill(b *bucket, now time.Time) float64 {
+	return min(l.burst, b.tokens+now.Sub(b.last).Seconds()*l.rate)
+}
+
+// prune 删除令牌已经补满的 key，这些 key 的状态和新出现的 key 相同，删除后不影响限流结果.
+// 清理后仍有大量 key 时，提高下次清理的阈值，避免每次请求都遍历所有 key.
+func (l *Limiter) prune(now time.Time) {
+	for key, b := range l.buckets {
+		if l.refill(b, now) >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+	l.pruneSize = max(minPruneSize, 2*len(l.buckets))
+}
@@ -0,0 +1,50 @@
+package ratelimit
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllow(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	l := New(3, time.Minute)
+	l.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		assert.True(t, l.Allow("1.2.3.4"))
+	}
+	assert.False(t, l.Allow("1.2.3.4"))
+	// 不同的 key 分别计数
+	assert.True(t, l.Allow("5.6.7.8"))
+
+	// 每 20 秒补充一个令牌
+	now = now.Add(20 * time.Second)
+	assert.True(t, l.Allow("1.2.3.4"))
+	assert.False(t, l.Allow("1.2.3.4"))
+
+	// 令牌数不超过 burst
+	now = now.Add(time.Hour)
+	for i := 0; i < 3; i++ {
+		assert.True(t, l.Allow("1.2.3.4"))
+	}
+	assert.False(t, l.Allow("1.2.3.4"))
+}
+
+func TestPrune(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	l := New(1, time.Minute)
+	l.now = func() time.Time { return now }
+
+	for i := 0; i < minPruneSize; i++ {
+		l.Allow(fmt.Sprintf("10.0.%d.%d", i/256, i%256))
+	}
+	assert.Len(t, l.buckets, minPruneSize)
+
+	// 所有 key 的令牌都已补满，新 key 出现时会被清理
+	now = now.Add(time.Minute)
+	assert.True(t, l.Allow("1.2.3.4"))
+	assert.Len(t, l.buckets, 1)
+}
@@ -13,6 +13,10 @@ const (
 	UserID ResourceID = "user"
 	// PostID 定义博文资源标识符.
 	PostID ResourceID = "post"
+	// TemplateID 定义博文模板资源标识符.
+	TemplateID ResourceID = "template"
+	// PreviewLinkID 定义博文预览链接资源标识符.
+	PreviewLinkID ResourceID = "preview"
 )
 
 // String 将资源标识符转换为字符串.
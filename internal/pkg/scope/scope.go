@@ -0,0 +1,65 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+// Package scope 定义个人访问令牌的权限范围. 权限范围的格式为 <资源>:<操作>，
+// 拥有某个资源的 write 权限范围时同时拥有该资源的 read 权限范围.
+package scope
+
+import (
+	"slices"
+	"strings"
+)
+
+const (
+	// PostsRead 允许查询博客、书签、博客模板等博客相关资源.
+	PostsRead = "posts:read"
+	// PostsWrite 允许创建、修改和删除博客相关资源.
+	PostsWrite = "posts:write"
+	// UsersRead 允许查询用户信息.
+	UsersRead = "users:read"
+	// UsersWrite 允许删除、解锁用户和重置用户的两步验证. 修改用户信息不允许使用个人访问令牌.
+	UsersWrite = "users:write"
+)
+
+// separator 为多个权限范围保存到数据库时使用的分隔符.
+const separator = ","
+
+// all 为所有支持的权限范围.
+var all = []string{PostsRead, PostsWrite, UsersRead, UsersWrite}
+
+// All 返回所有支持的权限范围.
+func All() []string {
+	return slices.Clone(all)
+}
+
+// Valid 判断 scope 是否为支持的权限范围.
+func Valid(scope string) bool {
+	return slices.Contains(all, scope)
+}
+
+// Join 将多个权限范围合并为一个字符串，合并前去重并排序.
+func Join(scopes []string) string {
+	scopes = slices.Clone(scopes)
+	slices.Sort(scopes)
+	return strings.Join(slices.Compact(scopes), separator)
+}
+
+// Split 将 Join 合并的字符串拆分为权限范围列表.
+func Split(s string) []string {
+	if s == "" {
+		return []string{}
+	}
+	return strings.Split(s, separator)
+}
+
+// Allows 判断 granted 中的权限范围是否包含 required.
+func Allows(granted []string, required string) bool {
+	if slices.Contains(granted, required) {
+		return true
+	}
+
+	resource, action, ok := strings.Cut(required, ":")
+	return ok && action == "read" && slices.Contains(granted, resource+":write")
+}
@@ -0,0 +1,31 @@
+package scope
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJoinSplit(t *testing.T) {
+	s := Join([]string{UsersRead, PostsWrite, UsersRead})
+	assert.Equal(t, "posts:write,users:read", s)
+	assert.Equal(t, []string{PostsWrite, UsersRead}, Split(s))
+	assert.Empty(t, Split(""))
+}
+
+func TestAllows(t *testing.T) {
+	granted := []string{PostsWrite, UsersRead}
+
+	assert.True(t, Allows(granted, PostsWrite))
+	// write 权限范围包含 read 权限范围
+	assert.True(t, Allows(granted, PostsRead))
+	assert.True(t, Allows(granted, UsersRead))
+	assert.False(t, Allows(granted, UsersWrite))
+	assert.False(t, Allows(nil, PostsRead))
+}
+
+func TestValid(t *testing.T) {
+	assert.True(t, Valid(PostsRead))
+	assert.False(t, Valid("posts:admin"))
+	assert.False(t, Valid(""))
+}
@@ -29,11 +29,13 @@ type GRPCGatewayServer struct {
 }
 
 // NewGRPCGatewayServer 创建一个新的 GRPC 网关服务器实例.
+// wrappers 用于包装网关的 HTTP 处理器，可以在网关之前处理部分请求，按传入顺序由内向外包装.
 func NewGRPCGatewayServer(
 	httpOptions *genericoptions.HTTPOptions,
 	grpcOptions *genericoptions.GRPCOptions,
 	tlsOptions *genericoptions.TLSOptions,
 	registerHandler func(mux *runtime.ServeMux, conn *grpc.ClientConn) error,
+	wrappers ...func(http.Handler) http.Handler,
 ) (*GRPCGatewayServer, error) {
 	var tlsConfig *tls.Config
 	if tlsOptions != nil && tlsOptions.UseTLS {
@@ -73,10 +75,15 @@ func NewGRPCGatewayServer(
 		return nil, err
 	}
 
+	var handler http.Handler = gwmux
+	for _, wrap := range wrappers {
+		handler = wrap(handler)
+	}
+
 	return &GRPCGatewayServer{
 		srv: &http.Server{
 			Addr:      httpOptions.Addr,
-			Handler:   gwmux,
+			Handler:   handler,
 			TLSConfig: tlsConfig,
 		},
 	}, nil
@@ -0,0 +1,200 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+// Package textstat 计算 Markdown 格式博客内容的统计信息，包括字数、预计阅读时间和纯文本摘要.
+//
+// 字数统计区分两类文字：英文等以空格分词的文字按单词计数，中文和日文等不以空格分词的文字按字计数.
+// 代码块计入字数和阅读时间，但不会出现在摘要中.
+package textstat
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+const (
+	// ExcerptLength 为摘要的最大字符数.
+	ExcerptLength = 200
+	// wordsPerMinute 为阅读以空格分词的文字时每分钟阅读的单词数.
+	wordsPerMinute = 230
+	// cjkCharsPerMinute 为阅读中文和日文时每分钟阅读的字数.
+	cjkCharsPerMinute = 400
+	// escapeBase 为转义标点在替换行内语法期间使用的私有区字符的起始码点.
+	escapeBase = 0xE000
+)
+
+// Stats 表示博客内容的统计信息.
+type Stats struct {
+	// WordCount 为字数.
+	WordCount int64
+	// ReadingTime 为预计阅读时间，单位为分钟. 内容不为空时至少为 1 分钟.
+	ReadingTime int64
+	// Excerpt 为纯文本摘要，最多 ExcerptLength 个字符.
+	Excerpt string
+}
+
+var (
+	// 块级语法.
+	fenceRe     = regexp.MustCompile("^\\s{0,3}(```+|~~~+)")
+	refDefRe    = regexp.MustCompile(`^\s{0,3}\[[^\]]+\]:\s*\S+`)
+	ruleRe      = regexp.MustCompile(`^\s{0,3}(?:(?:-\s*){3,}|(?:\*\s*){3,}|(?:_\s*){3,}|=+)$`)
+	tableSepRe  = regexp.MustCompile(`^\s*\|?\s*:?-+:?\s*(?:\|\s*:?-+:?\s*)*\|?\s*$`)
+	quoteRe     = regexp.MustCompile(`^\s{0,3}>\s?`)
+	headingRe   = regexp.MustCompile(`^\s{0,3}#{1,6}(?:\s+|$)`)
+	closingRe   = regexp.MustCompile(`\s+#+\s*$`)
+	listRe      = regexp.MustCompile(`^\s*(?:[-*+]|\d{1,9}[.)])\s+(?:\[[ xX]\]\s+)?`)
+	tableCellRe = regexp.MustCompile(`\s*\|\s*`)
+
+	// 行内语法，按顺序替换.
+	inlineRules = []struct {
+		re   *regexp.Regexp
+		repl string
+	}{
+		{regexp.MustCompile("`+([^`]+)`+"), "$1"},
+		{regexp.MustCompile(`!\[([^\]]*)\]\([^)]*\)`), "$1"},
+		{regexp.MustCompile(`\[([^\]]+)\]\([^)]*\)`), "$1"},
+		{regexp.MustCompile(`\[([^\]]+)\]\[[^\]]*\]`), "$1"},
+		{regexp.MustCompile(`<((?:https?|mailto):[^>\s]+)>`), "$1"},
+		{regexp.MustCompile(`</?[a-zA-Z][^>]*>`), ""},
+		{regexp.MustCompile(`\*\*(.+?)\*\*`), "$1"},
+		{regexp.MustCompile(`__(.+?)__`), "$1"},
+		{regexp.MustCompile(`~~(.+?)~~`), "$1"},
+		{regexp.MustCompile(`\*([^*\s][^*]*?)\*`), "$1"},
+		{regexp.MustCompile(`\b_([^_]+)_\b`), "$1"},
+	}
+
+	// escapeRe 匹配反斜杠转义的标点，转义的标点在替换行内语法时不生效.
+	escapeRe = regexp.MustCompile("\\\\([\\\\`*_{}\\[\\]()#+\\-.!<>~|])")
+)
+
+// Compute 计算 Markdown 格式内容的统计信息.
+func Compute(markdown string) Stats {
+	prose, code := parse(markdown)
+
+	words, cjk := countWords(prose)
+	codeWords, codeCJK := countWords(code)
+	words += codeWords
+	cjk += codeCJK
+
+	stats := Stats{WordCount: int64(words + cjk), Excerpt: Excerpt(prose, ExcerptLength)}
+	if stats.WordCount > 0 {
+		// 按分钟向上取整
+		seconds := words*60/wordsPerMinute + cjk*60/cjkCharsPerMinute
+		stats.ReadingTime = int64(max((seconds+59)/60, 1))
+	}
+	return stats
+}
+
+// PlainText 去掉 Markdown 语法，返回内容中的纯文本. 代码块会被去掉，连续的空白会合并为一个空格.
+func PlainText(markdown string) string {
+	prose, _ := parse(markdown)
+	return prose
+}
+
+// Excerpt 截取纯文本的前 n 个字符作为摘要，被截断时以省略号结尾.
+// 截断位置位于英文单词中间时，会回退到前一个空格处.
+func Excerpt(text string, n int) string {
+	runes := []rune(text)
+	if len(runes) <= n {
+		return text
+	}
+
+	cut := n
+	if isWordRune(runes[cut-1]) && isWordRune(runes[cut]) {
+		for i := cut - 1; i >= n/2; i-- {
+			if unicode.IsSpace(runes[i]) {
+				cut = i
+				break
+			}
+		}
+	}
+	return strings.TrimRightFunc(string(runes[:cut]), unicode.IsSpace) + "…"
+}
+
+// parse 将 Markdown 内容拆分为正文和代码两部分，并去掉正文中的 Markdown 语法.
+// 这里只处理博客中常用的语法，不追求完整实现 CommonMark 规范.
+func parse(markdown string) (string, string) {
+	var prose, code []string
+	fence := ""
+
+	for _, line := range strings.Split(strings.ReplaceAll(markdown, "\r\n", "\n"), "\n") {
+		if m := fenceRe.FindStringSubmatch(line); m != nil {
+			switch {
+			case fence == "":
+				fence = m[1]
+				continue
+			case strings.HasPrefix(m[1], fence) && strings.TrimSpace(line) == m[1]:
+				fence = ""
+				continue
+			}
+		}
+		if fence != "" {
+			code = append(code, line)
+			continue
+		}
+
+		if refDefRe.MatchString(line) || ruleRe.MatchString(line) || tableSepRe.MatchString(line) && strings.Contains(line, "|") {
+			continue
+		}
+		for quoteRe.MatchString(line) {
+			line = quoteRe.ReplaceAllString(line, "")
+		}
+		if headingRe.MatchString(line) {
+			line = closingRe.ReplaceAllString(headingRe.ReplaceAllString(line, ""), "")
+		}
+		line = listRe.ReplaceAllString(line, "")
+		if strings.HasPrefix(strings.TrimSpace(line), "|") {
+			line = tableCellRe.ReplaceAllString(line, " ")
+		}
+		line = escapeRe.ReplaceAllStringFunc(line, func(m string) string { return string(rune(escapeBase + int(m[1]))) })
+		for _, rule := range inlineRules {
+			line = rule.re.ReplaceAllString(line, rule.repl)
+		}
+		line = strings.Map(func(r rune) rune {
+			if r >= escapeBase && r < escapeBase+0x80 {
+				return r - escapeBase
+			}
+			return r
+		}, line)
+		prose = append(prose, line)
+	}
+
+	return strings.Join(strings.Fields(strings.Join(prose, " ")), " "), strings.Join(code, "\n")
+}
+
+// countWords 统计文本中以空格分词的单词数，以及中文和日文的字数.
+func countWords(text string) (int, int) {
+	var words, cjk int
+	inWord := false
+	runes := []rune(text)
+	for i, r := range runes {
+		switch {
+		case isCJK(r):
+			cjk++
+			inWord = false
+		case isWordRune(r):
+			if !inWord {
+				words++
+			}
+			inWord = true
+		case inWord && (r == '\'' || r == '’') && i+1 < len(runes) && isWordRune(runes[i+1]):
+			// don't、it's 等单词中的撇号不拆分单词
+		default:
+			inWord = false
+		}
+	}
+	return words, cjk
+}
+
+// isCJK 判断字符是否为不以空格分词的中文或日文字符. 韩文以空格分词，按单词计数.
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana)
+}
+
+// isWordRune 判断字符是否属于以空格分词的单词.
+func isWordRune(r rune) bool {
+	return (unicode.IsLetter(r) || unicode.IsNumber(r)) && !isCJK(r)
+}
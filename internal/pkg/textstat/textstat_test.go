@@ -0,0 +1,75 @@
+package textstat
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlainText(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		want     string
+	}{
+		{"empty", "", ""},
+		{"heading", "# Hello ##\n\nWorld", "Hello World"},
+		{"emphasis", "**bold**, *italic*, __strong__, _em_ and ~~gone~~", "bold, italic, strong, em and gone"},
+		{"snake case", "call my_func_name now", "call my_func_name now"},
+		{"links and images", "See [the docs](https://example.com) and ![a cat](cat.png) or <https://go.dev>", "See the docs and a cat or https://go.dev"},
+		{"reference links", "Read [this][1].\n\n[1]: https://example.com", "Read this."},
+		{"inline code", "Run `go test` first", "Run go test first"},
+		{"code block", "Before\n\n```go\nfunc main() {}\n```\n\nAfter", "Before After"},
+		{"unclosed code block", "Text\n~~~\ncode", "Text"},
+		{"lists and quotes", "- one\n* two\n1. three\n- [x] done\n> quoted\n>> nested", "one two three done quoted nested"},
+		{"rules", "above\n\n---\n\nbelow\n===", "above below"},
+		{"table", "| a | b |\n|---|:-:|\n| 1 | 2 |", "a b 1 2"},
+		{"html", "<p>Hello <b>there</b></p>", "Hello there"},
+		{"escapes", `\*not emphasis\*`, "*not emphasis*"},
+		{"cjk", "# 标题\n\n这是**正文**。", "标题 这是正文。"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, PlainText(tt.markdown))
+		})
+	}
+}
+
+func TestCompute(t *testing.T) {
+	tests := []struct {
+		name        string
+		markdown    string
+		wordCount   int64
+		readingTime int64
+	}{
+		{"empty", "", 0, 0},
+		{"english", "Hello, **world**! It's a [nice](https://example.com) day.", 6, 1},
+		{"chinese", "你好，世界！", 4, 1},
+		{"mixed", "使用 Go 语言编写 miniblog 项目", 10, 1},
+		{"code counts", "Intro\n\n```\nfmt.Println(x)\n```", 4, 1},
+		{"long english", strings.Repeat("word ", 460), 460, 2},
+		{"long chinese", strings.Repeat("字", 1000), 1000, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats := Compute(tt.markdown)
+			assert.Equal(t, tt.wordCount, stats.WordCount)
+			assert.Equal(t, tt.readingTime, stats.ReadingTime)
+		})
+	}
+}
+
+func TestExcerpt(t *testing.T) {
+	assert.Equal(t, "short", Excerpt("short", 10))
+	assert.Equal(t, "hello…", Excerpt("hello wonderful world", 10))
+	assert.Equal(t, "你好世界…", Excerpt("你好世界你好世界", 4))
+
+	stats := Compute("# Title\n\n```\nignored code\n```\n\n" + strings.Repeat("lorem ipsum ", 50))
+	assert.True(t, strings.HasPrefix(stats.Excerpt, "Title lorem ipsum"))
+	assert.True(t, strings.HasSuffix(stats.Excerpt, "…"))
+	assert.LessOrEqual(t, len([]rune(stats.Excerpt)), ExcerptLength+1)
+	assert.NotContains(t, stats.Excerpt, "ignored")
+}
@@ -0,0 +1,110 @@
+// Copyright 2024 Ra1n6ow <jeffduuu@gmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/Ra1n6ow/miniblog.
+
+// Package theme 提供基于 html/template 的主题加载功能.
+// 主题由内置文件和磁盘上的自定义主题目录叠加而成，自定义目录中的同名文件优先.
+package theme
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"os"
+	"strings"
+)
+
+// Theme 表示一个主题.
+type Theme struct {
+	fsys fs.FS
+}
+
+// overlayFS 是一个叠加文件系统，优先从 upper 中读取文件.
+type overlayFS struct {
+	upper fs.FS
+	lower fs.FS
+}
+
+// Open 实现 fs.FS 接口.
+func (o overlayFS) Open(name string) (fs.File, error) {
+	if o.upper != nil {
+		if f, err := o.upper.Open(name); err == nil {
+			return f, nil
+		}
+	}
+	return o.lower.Open(name)
+}
+
+// New 创建一个 *Theme 实例. embedded 为内置主题，dir 为自定义主题目录，为空时只使用内置主题.
+func New(embedded fs.FS, dir string) (*Theme, error) {
+	fsys := overlayFS{lower: embedded}
+	if dir != "" {
+		info, err := os.Stat(dir)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			return nil, fmt.Errorf("theme path %s is not a directory", dir)
+		}
+		fsys.upper = os.DirFS(dir)
+	}
+
+	return &Theme{fsys: fsys}, nil
+}
+
+// FS 返回主题的文件系统，可用于对外提供静态资源.
+func (t *Theme) FS() fs.FS {
+	return t.fsys
+}
+
+// ReadFile 读取主题中的文件.
+func (t *Theme) ReadFile(name string) ([]byte, error) {
+	data, err := fs.ReadFile(t.fsys, name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("theme file not found: %s", name)
+	}
+	return data, err
+}
+
+// Parse 使用指定的模板函数解析主题中的模板文件.
+func (t *Theme) Parse(funcs template.FuncMap, names ...string) (*template.Template, error) {
+	return template.New(names[0]).Funcs(funcs).ParseFS(t.fsys, names...)
+}
+
+// Checksum 计算主题中指定文件的校验和，可用于判断主题是否发生变化.
+func (t *Theme) Checksum(names ...string) (string, error) {
+	h := sha256.New()
+	for _, name := range names {
+		data, err := t.ReadFile(name)
+		if err != nil {
+			return "", err
+		}
+		h.Write([]byte(name))
+		h.Write(data)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Paragraphs 将博客内容按空行拆分为多个段落.
+func Paragraphs(content string) []string {
+	var ret []string
+	for _, p := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n\n") {
+		if p = strings.TrimSpace(p); p != "" {
+			ret = append(ret, p)
+		}
+	}
+	return ret
+}
+
+// Excerpt 截取博客内容的前 n 个字符作为摘要.
+func Excerpt(n int, content string) string {
+	runes := []rune(strings.Join(strings.Fields(content), " "))
+	if len(runes) <= n {
+		return string(runes)
+	}
+	return string(runes[:n]) + "…"
+}
@@ -0,0 +1,72 @@
+package totp
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rfcSecret 为 RFC 6238 附录 B 中 SHA1 测试用例的密钥 "12345678901234567890".
+var rfcSecret = encoding.EncodeToString([]byte("12345678901234567890"))
+
+func TestCode(t *testing.T) {
+	// RFC 6238 附录 B 的测试向量，取 8 位结果的后 6 位
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, tt := range tests {
+		got, err := Code(rfcSecret, Step(time.Unix(tt.unix, 0)))
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, got, "time %d", tt.unix)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+
+	step, ok := Validate(rfcSecret, "005924", now, 1)
+	assert.True(t, ok)
+	assert.Equal(t, Step(now), step)
+
+	// 允许前后一个时间步的误差
+	_, ok = Validate(rfcSecret, "005924", now.Add(Period), 1)
+	assert.True(t, ok)
+	_, ok = Validate(rfcSecret, "005924", now.Add(2*Period), 1)
+	assert.False(t, ok)
+
+	_, ok = Validate(rfcSecret, "000000", now, 1)
+	assert.False(t, ok)
+	_, ok = Validate(rfcSecret, "5924", now, 1)
+	assert.False(t, ok)
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+	assert.Len(t, secret, 32)
+
+	code, err := Code(secret, Step(time.Now()))
+	require.NoError(t, err)
+	_, ok := Validate(secret, code, time.Now(), 1)
+	assert.True(t, ok)
+}
+
+func TestURI(t *testing.T) {
+	u, err := url.Parse(URI("miniblog", "alice", "JBSWY3DPEHPK3PXP"))
+	require.NoError(t, err)
+	assert.Equal(t, "otpauth", u.Scheme)
+	assert.Equal(t, "totp", u.Host)
+	assert.Equal(t, "/miniblog:alice", u.Path)
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", u.Query().Get("secret"))
+	assert.Equal(t, "miniblog", u.Query().Get("issuer"))
+}
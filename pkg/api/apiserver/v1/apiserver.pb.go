@@ -39,13 +39,31 @@ var file_apiserver_v1_apiserver_proto_rawDesc = []byte{
 	0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x61,
 	0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x7a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x61, 0x70, 0x69, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x68, 0x7a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x61, 0x70, 0x69, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x61, 0x70, 0x69, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17,
+	0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1a, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b,
+	0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x61, 0x70, 0x69,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
+	0x76, 0x31, 0x2f, 0x74, 0x77, 0x6f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x17, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31,
 	0x2f, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76,
 	0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xa0, 0x0e, 0x0a, 0x08,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xf0, 0x5a, 0x0a, 0x08,
 	0x4d, 0x69, 0x6e, 0x69, 0x42, 0x6c, 0x6f, 0x67, 0x12, 0x76, 0x0a, 0x07, 0x48, 0x65, 0x61, 0x6c,
 	0x74, 0x68, 0x7a, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e, 0x76, 0x31,
@@ -60,106 +78,719 @@ var file_apiserver_v1_apiserver_proto_rawDesc = []byte{
 	0x92, 0x41, 0x23, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90,
 	0x86, 0x12, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0x2a,
 	0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x3a, 0x01, 0x2a, 0x22,
-	0x06, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x89, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72,
+	0x06, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0xe2, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72,
 	0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
 	0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x92, 0x41, 0x2a,
-	0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c,
-	0xe5, 0x88, 0xb7, 0xe6, 0x96, 0xb0, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0x2a, 0x0c, 0x52, 0x65,
-	0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13,
-	0x3a, 0x01, 0x2a, 0x1a, 0x0e, 0x2f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x2d, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0xa5, 0x01, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c, 0x92,
-	0x41, 0x2c, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86,
-	0x12, 0x0c, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe5, 0xaf, 0x86, 0xe7, 0xa0, 0x81, 0x2a, 0x0e,
-	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a, 0x1a, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65,
-	0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x2f, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x2d, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x7c, 0x0a, 0x0a, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x92, 0x41, 0x28, 0x0a, 0x0c, 0xe7,
-	0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x88, 0x9b,
-	0xe5, 0xbb, 0xba, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x2a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x3a, 0x01, 0x2a, 0x22, 0x09,
-	0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x8b, 0x01, 0x0a, 0x0a, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x92, 0x41, 0x2e, 0x0a, 0x0c, 0xe7, 0x94,
-	0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe6, 0x9b, 0xb4, 0xe6,
-	0x96, 0xb0, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x2a, 0x0a,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17,
-	0x3a, 0x01, 0x2a, 0x1a, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x12, 0x82, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x92, 0x41, 0x28, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6,
-	0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4,
-	0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x2a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x2a, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73,
-	0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x12, 0x7c, 0x0a, 0x07,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x48, 0x92, 0x41, 0x2b, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1,
-	0xe7, 0x90, 0x86, 0x12, 0x12, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe7, 0x94, 0xa8, 0xe6, 0x88,
-	0xb7, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x2a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72,
-	0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x12, 0x77, 0x0a, 0x08, 0x4c, 0x69,
-	0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x40, 0x92, 0x41, 0x2c, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae,
-	0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe6, 0x89, 0x80, 0xe6,
-	0x9c, 0x89, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x2a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73,
-	0x65, 0x72, 0x73, 0x12, 0x7c, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
-	0x74, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x3f, 0x92, 0x41, 0x28, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1,
-	0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x96, 0x87, 0xe7, 0xab,
-	0xa0, 0x2a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x0e, 0x3a, 0x01, 0x2a, 0x22, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74,
-	0x73, 0x12, 0x85, 0x01, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
-	0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x48, 0x92, 0x41, 0x28, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7,
-	0x90, 0x86, 0x12, 0x0c, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0,
-	0x2a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x17, 0x3a, 0x01, 0x2a, 0x1a, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73,
-	0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x12, 0x7c, 0x0a, 0x0a, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x92, 0x41, 0x28, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a,
-	0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x88, 0xa0, 0xe9, 0x99,
-	0xa4, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
-	0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x3a, 0x01, 0x2a, 0x2a, 0x09, 0x2f, 0x76,
-	0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x7c, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x50, 0x6f,
-	0x73, 0x74, 0x12, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50,
-	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x92, 0x41, 0x2b,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9e, 0x01, 0x92, 0x41,
+	0x81, 0x01, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86,
+	0x12, 0x0c, 0xe5, 0x88, 0xb7, 0xe6, 0x96, 0xb0, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0x1a, 0x55,
+	0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe5, 0x88, 0xb7, 0xe6, 0x96, 0xb0, 0xe4, 0xbb, 0xa4, 0xe7,
+	0x89, 0x8c, 0xe6, 0x8d, 0xa2, 0xe5, 0x8f, 0x96, 0xe6, 0x96, 0xb0, 0xe7, 0x9a, 0x84, 0x20, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x20, 0xe5, 0x92, 0x8c, 0xe5, 0x88, 0xb7, 0xe6, 0x96, 0xb0, 0xe4, 0xbb,
+	0xa4, 0xe7, 0x89, 0x8c, 0xef, 0xbc, 0x8c, 0xe5, 0x88, 0xb7, 0xe6, 0x96, 0xb0, 0xe4, 0xbb, 0xa4,
+	0xe7, 0x89, 0x8c, 0xe5, 0x8f, 0xaa, 0xe8, 0x83, 0xbd, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe4,
+	0xb8, 0x80, 0xe6, 0xac, 0xa1, 0x2a, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x1a, 0x0e, 0x2f, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x2d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0xb5, 0x01, 0x0a,
+	0x06, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67,
+	0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x83,
+	0x01, 0x92, 0x41, 0x6e, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7,
+	0x90, 0x86, 0x12, 0x0c, 0xe9, 0x80, 0x80, 0xe5, 0x87, 0xba, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95,
+	0x1a, 0x48, 0xe6, 0x92, 0xa4, 0xe9, 0x94, 0x80, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe4, 0xbd,
+	0xbf, 0xe7, 0x94, 0xa8, 0xe7, 0x9a, 0x84, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xef, 0xbc, 0x8c,
+	0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xe4, 0xb8, 0xad, 0xe5, 0xb8, 0xa6, 0xe6, 0x9c, 0x89, 0xe5,
+	0x88, 0xb7, 0xe6, 0x96, 0xb0, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0xe6, 0x97, 0xb6, 0xe4, 0xb8,
+	0x80, 0xe5, 0xb9, 0xb6, 0xe6, 0x92, 0xa4, 0xe9, 0x94, 0x80, 0x2a, 0x06, 0x4c, 0x6f, 0x67, 0x6f,
+	0x75, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x3a, 0x01, 0x2a, 0x22, 0x07, 0x2f, 0x6c, 0x6f,
+	0x67, 0x6f, 0x75, 0x74, 0x12, 0xa5, 0x01, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c,
+	0x92, 0x41, 0x2c, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90,
+	0x86, 0x12, 0x0c, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe5, 0xaf, 0x86, 0xe7, 0xa0, 0x81, 0x2a,
+	0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a, 0x1a, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x2f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x2d, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x7c, 0x0a, 0x0a,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x92, 0x41, 0x28, 0x0a, 0x0c,
+	0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x88,
+	0x9b, 0xe5, 0xbb, 0xba, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x2a, 0x0a, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x3a, 0x01, 0x2a, 0x22,
+	0x09, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x8b, 0x01, 0x0a, 0x0a, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x92, 0x41, 0x2e, 0x0a, 0x0c, 0xe7,
+	0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe6, 0x9b, 0xb4,
+	0xe6, 0x96, 0xb0, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x2a,
+	0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x17, 0x3a, 0x01, 0x2a, 0x1a, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f,
+	0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x12, 0x82, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x92, 0x41, 0x28, 0x0a, 0x0c, 0xe7, 0x94, 0xa8,
+	0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x88, 0xa0, 0xe9, 0x99,
+	0xa4, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x2a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x2a, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x12, 0x7c, 0x0a,
+	0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x48, 0x92, 0x41, 0x2b, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae,
+	0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe7, 0x94, 0xa8, 0xe6,
+	0x88, 0xb7, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x2a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x12, 0x77, 0x0a, 0x08, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x40, 0x92, 0x41, 0x2c, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7,
+	0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe6, 0x89, 0x80,
+	0xe6, 0x9c, 0x89, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x2a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x12, 0x7c, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x3f, 0x92, 0x41, 0x28, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae,
+	0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x96, 0x87, 0xe7,
+	0xab, 0xa0, 0x2a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x0e, 0x3a, 0x01, 0x2a, 0x22, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73,
+	0x74, 0x73, 0x12, 0x85, 0x01, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x48, 0x92, 0x41, 0x28, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1,
+	0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe6, 0x96, 0x87, 0xe7, 0xab,
+	0xa0, 0x2a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a, 0x1a, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74,
+	0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x12, 0x7c, 0x0a, 0x0a, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x92, 0x41, 0x28, 0x0a, 0x0c, 0xe5, 0x8d,
+	0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x88, 0xa0, 0xe9,
+	0x99, 0xa4, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x3a, 0x01, 0x2a, 0x2a, 0x09, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x7c, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x73, 0x74, 0x12, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x92, 0x41,
+	0x2b, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12,
+	0x12, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0xe4, 0xbf, 0xa1,
+	0xe6, 0x81, 0xaf, 0x2a, 0x07, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70,
+	0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x12, 0x77, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f,
+	0x73, 0x74, 0x12, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x92,
+	0x41, 0x2c, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86,
+	0x12, 0x12, 0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe6, 0x89, 0x80, 0xe6, 0x9c, 0x89, 0xe6, 0x96,
+	0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12,
+	0x99, 0x01, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64,
+	0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x59, 0x92, 0x41, 0x2f, 0x0a, 0x0c, 0xe5, 0x8f, 0x8d, 0xe5, 0xba, 0x94, 0xe7, 0xae, 0xa1,
+	0xe7, 0x90, 0x86, 0x12, 0x12, 0xe6, 0xb7, 0xbb, 0xe5, 0x8a, 0xa0, 0xe6, 0x96, 0x87, 0xe7, 0xab,
+	0xa0, 0xe5, 0x8f, 0x8d, 0xe5, 0xba, 0x94, 0x2a, 0x0b, 0x41, 0x64, 0x64, 0x52, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a, 0x22, 0x1c, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44,
+	0x7d, 0x2f, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xa5, 0x01, 0x0a, 0x0e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c, 0x92, 0x41, 0x32, 0x0a, 0x0c, 0xe5, 0x8f, 0x8d, 0xe5,
+	0xba, 0x94, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe7, 0xa7, 0xbb, 0xe9, 0x99, 0xa4,
+	0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0xe5, 0x8f, 0x8d, 0xe5, 0xba, 0x94, 0x2a, 0x0e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x21, 0x3a, 0x01, 0x2a, 0x2a, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73,
+	0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x9f, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x73, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c, 0x92, 0x41, 0x36, 0x0a, 0x0c, 0xe5, 0x8f,
+	0x8d, 0xe5, 0xba, 0x94, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x18, 0xe5, 0x88, 0x97, 0xe5,
+	0x87, 0xba, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0xe5, 0x8f, 0x8d, 0xe5, 0xba, 0x94, 0xe7, 0x94,
+	0xa8, 0xe6, 0x88, 0xb7, 0x2a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f,
+	0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x72, 0x65, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x84, 0x01, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x42, 0x6f, 0x6f,
+	0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x42, 0x6f,
+	0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x92, 0x41, 0x29, 0x0a, 0x0c, 0xe4, 0xb9, 0xa6,
+	0xe7, 0xad, 0xbe, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe6, 0x94, 0xb6, 0xe8, 0x97,
+	0x8f, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x0b, 0x41, 0x64, 0x64, 0x42, 0x6f, 0x6f, 0x6b,
+	0x6d, 0x61, 0x72, 0x6b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22, 0x0d, 0x2f,
+	0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x9c, 0x01, 0x0a,
+	0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x12,
+	0x19, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d,
+	0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x53, 0x92, 0x41, 0x32, 0x0a, 0x0c, 0xe4, 0xb9, 0xa6,
+	0xe7, 0xad, 0xbe, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe5, 0x8f, 0x96, 0xe6, 0xb6,
+	0x88, 0xe6, 0x94, 0xb6, 0xe8, 0x97, 0x8f, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x0e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x18, 0x2a, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72,
+	0x6b, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x12, 0x85, 0x01, 0x0a, 0x0c,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x17, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x42, 0x92, 0x41, 0x2a, 0x0a, 0x0c, 0xe4, 0xb9, 0xa6, 0xe7, 0xad, 0xbe, 0xe7, 0xae, 0xa1, 0xe7,
+	0x90, 0x86, 0x12, 0x0c, 0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe4, 0xb9, 0xa6, 0xe7, 0xad, 0xbe,
+	0x2a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61,
+	0x72, 0x6b, 0x73, 0x12, 0xa4, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42,
+	0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x55, 0x92, 0x41, 0x34, 0x0a, 0x0c, 0xe4, 0xb9, 0xa6, 0xe7, 0xad, 0xbe,
+	0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe8, 0xb0, 0x83, 0xe6, 0x95, 0xb4, 0xe4, 0xb9,
+	0xa6, 0xe7, 0xad, 0xbe, 0xe9, 0xa1, 0xba, 0xe5, 0xba, 0x8f, 0x2a, 0x10, 0x52, 0x65, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x18, 0x3a, 0x01, 0x2a, 0x1a, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x6d,
+	0x61, 0x72, 0x6b, 0x73, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x9b, 0x01, 0x0a, 0x0f, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x92, 0x41, 0x33, 0x0a, 0x0c, 0xe4, 0xb9,
+	0xa6, 0xe7, 0xad, 0xbe, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe5, 0x88, 0x97, 0xe5,
+	0x87, 0xba, 0xe9, 0x98, 0x85, 0xe8, 0xaf, 0xbb, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x2a, 0x0f,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x2d, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x90, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0x92, 0x41,
+	0x36, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12,
+	0x18, 0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe6, 0x8f, 0x90, 0xe5, 0x8f, 0x8a, 0xe6, 0x88, 0x91,
+	0xe7, 0x9a, 0x84, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f,
+	0x76, 0x31, 0x2f, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xa8, 0x01, 0x0a, 0x10,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73,
+	0x12, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65,
+	0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x59, 0x92, 0x41, 0x34,
 	0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12,
-	0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0xe4, 0xbf, 0xa1, 0xe6,
-	0x81, 0xaf, 0x2a, 0x07, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f,
-	0x73, 0x74, 0x49, 0x44, 0x7d, 0x12, 0x77, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73,
-	0x74, 0x12, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x92, 0x41,
-	0x2c, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12,
-	0x12, 0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe6, 0x89, 0x80, 0xe6, 0x9c, 0x89, 0xe6, 0x96, 0x87,
-	0xe7, 0xab, 0xa0, 0x2a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x96,
+	0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe7, 0x9b, 0xb8, 0xe5, 0x85, 0xb3, 0xe6, 0x96, 0x87, 0xe7,
+	0xab, 0xa0, 0x2a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x50,
+	0x6f, 0x73, 0x74, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x12, 0x7d, 0x0a, 0x07, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73,
+	0x74, 0x12, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x92, 0x41, 0x25, 0x0a,
+	0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe7,
+	0xbd, 0xae, 0xe9, 0xa1, 0xb6, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x07, 0x50, 0x69, 0x6e,
+	0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44,
+	0x7d, 0x2f, 0x70, 0x69, 0x6e, 0x12, 0x88, 0x01, 0x0a, 0x09, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x50,
+	0x6f, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x6e, 0x70, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x4e, 0x92, 0x41, 0x2d, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1,
+	0xe7, 0x90, 0x86, 0x12, 0x12, 0xe5, 0x8f, 0x96, 0xe6, 0xb6, 0x88, 0xe7, 0xbd, 0xae, 0xe9, 0xa1,
+	0xb6, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x09, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x50, 0x6f,
+	0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x2a, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f,
+	0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x70, 0x69, 0x6e,
+	0x12, 0x91, 0x01, 0x0a, 0x0b, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x51, 0x92, 0x41, 0x29, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae,
+	0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe7, 0xb2, 0xbe, 0xe9, 0x80, 0x89, 0xe6, 0x96, 0x87, 0xe7,
+	0xab, 0xa0, 0x2a, 0x0b, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f,
+	0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x66, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x9c, 0x01, 0x0a, 0x0d, 0x55, 0x6e, 0x66, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x66, 0x65,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x56, 0x92, 0x41, 0x31,
+	0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12,
+	0xe5, 0x8f, 0x96, 0xe6, 0xb6, 0x88, 0xe7, 0xb2, 0xbe, 0xe9, 0x80, 0x89, 0xe6, 0x96, 0x87, 0xe7,
+	0xab, 0xa0, 0x2a, 0x0d, 0x55, 0x6e, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x2a, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73,
+	0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x66, 0x65, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0xad, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x6f, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x5e, 0x92, 0x41, 0x40, 0x0a, 0x0c, 0xe7, 0xbb, 0x9f, 0xe8, 0xae, 0xa1,
+	0xe5, 0x88, 0x86, 0xe6, 0x9e, 0x90, 0x12, 0x1e, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe7, 0x94,
+	0xa8, 0xe6, 0x88, 0xb7, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba,
+	0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x2a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13,
+	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2f, 0x70, 0x6f,
+	0x73, 0x74, 0x73, 0x12, 0xa2, 0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x6f, 0x70, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x59, 0x92,
+	0x41, 0x35, 0x0a, 0x0c, 0xe7, 0xbb, 0x9f, 0xe8, 0xae, 0xa1, 0xe5, 0x88, 0x86, 0xe6, 0x9e, 0x90,
+	0x12, 0x15, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe6, 0x9c, 0x80, 0xe6, 0xb4, 0xbb, 0xe8, 0xb7,
+	0x83, 0xe4, 0xbd, 0x9c, 0xe8, 0x80, 0x85, 0x2a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x70,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2f, 0x74, 0x6f, 0x70,
+	0x2d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x12, 0x9a, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x69,
+	0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x54, 0x92, 0x41, 0x37, 0x0a, 0x0c, 0xe7, 0xbb, 0x9f, 0xe8, 0xae, 0xa1, 0xe5, 0x88, 0x86, 0xe6,
+	0x9e, 0x90, 0x12, 0x18, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe5, 0x85, 0xa8, 0xe7, 0xab, 0x99,
+	0xe7, 0xbb, 0x9f, 0xe8, 0xae, 0xa1, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x2a, 0x0d, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x2f, 0x73, 0x69, 0x74, 0x65, 0x12, 0xa2, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x50,
+	0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x50, 0x6f,
+	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x59, 0x92, 0x41, 0x3e, 0x0a, 0x0c, 0xe7, 0xbb, 0x9f, 0xe8, 0xae, 0xa1, 0xe5, 0x88, 0x86, 0xe6,
+	0x9e, 0x90, 0x12, 0x1e, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe6, 0x88, 0x91, 0xe7, 0x9a, 0x84,
+	0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xbb, 0x9f, 0xe8, 0xae, 0xa1, 0xe6, 0x95, 0xb0, 0xe6,
+	0x8d, 0xae, 0x2a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6e,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2f, 0x6d, 0x65, 0x12, 0xc4, 0x01, 0x0a, 0x15, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x66, 0x92, 0x41, 0x39, 0x0a, 0x0c,
+	0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe5, 0x88,
+	0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0xe8, 0xaf, 0x91, 0xe6, 0x96, 0x87,
+	0x2a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a,
+	0x22, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73,
+	0x74, 0x49, 0x44, 0x7d, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0xcd, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x6f, 0x92, 0x41, 0x39, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1,
+	0xe7, 0x90, 0x86, 0x12, 0x12, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe6, 0x96, 0x87, 0xe7, 0xab,
+	0xa0, 0xe8, 0xaf, 0x91, 0xe6, 0x96, 0x87, 0x2a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2d, 0x3a, 0x01, 0x2a, 0x1a, 0x28, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73,
+	0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65,
+	0x7d, 0x12, 0xca, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x6c, 0x92, 0x41, 0x39, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1,
+	0xe7, 0x90, 0x86, 0x12, 0x12, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe6, 0x96, 0x87, 0xe7, 0xab,
+	0xa0, 0xe8, 0xaf, 0x91, 0xe6, 0x96, 0x87, 0x2a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2a, 0x2a, 0x28, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f,
+	0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x7d, 0x12, 0xab,
+	0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x56, 0x92, 0x41, 0x36, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae,
+	0xa2, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0x12, 0x12, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe5,
+	0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0x2a, 0x12, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a, 0x22, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f,
+	0x73, 0x74, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0xb8, 0x01, 0x0a,
+	0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x63, 0x92, 0x41, 0x36, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe6,
+	0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0x12, 0x12, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe5, 0x8d, 0x9a,
+	0xe5, 0xae, 0xa2, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0x2a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x1a, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74,
+	0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x7d, 0x12, 0xb5, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1d,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x60, 0x92,
+	0x41, 0x36, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf,
+	0x12, 0x12, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe6, 0xa8,
+	0xa1, 0xe6, 0x9d, 0xbf, 0x2a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x2a, 0x1f,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x73, 0x2f, 0x7b, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x7d, 0x12,
+	0xa9, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x12, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5d, 0x92, 0x41,
+	0x33, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0x12,
+	0x12, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe6, 0xa8, 0xa1,
+	0xe6, 0x9d, 0xbf, 0x2a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x6f, 0x73, 0x74, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x7b,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x7d, 0x12, 0xa0, 0x01, 0x0a, 0x10,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x12, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x92, 0x41, 0x34,
+	0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0x12, 0x12,
+	0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe6, 0xa8, 0xa1, 0xe6,
+	0x9d, 0xbf, 0x2a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x6f, 0x73, 0x74, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0xd1,
+	0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x46, 0x72, 0x6f,
+	0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x46, 0x72, 0x6f, 0x6d,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x70, 0x92, 0x41, 0x3d, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe6, 0xa8, 0xa1,
+	0xe6, 0x9d, 0xbf, 0x12, 0x15, 0xe4, 0xbb, 0x8e, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0xe5, 0x88,
+	0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x16, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x01, 0x2a, 0x22, 0x25, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x6f, 0x73, 0x74, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x2f,
+	0x7b, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x7d, 0x2f, 0x70, 0x6f, 0x73,
+	0x74, 0x73, 0x12, 0xb5, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4c, 0x69, 0x6e, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x63, 0x92, 0x41, 0x35, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5,
+	0xae, 0xa2, 0xe9, 0xa2, 0x84, 0xe8, 0xa7, 0x88, 0x12, 0x12, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba,
+	0xe9, 0xa2, 0x84, 0xe8, 0xa7, 0x88, 0xe9, 0x93, 0xbe, 0xe6, 0x8e, 0xa5, 0x2a, 0x11, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4c, 0x69, 0x6e, 0x6b, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x01, 0x2a, 0x22, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f,
+	0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x2d, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0xae, 0x01, 0x0a, 0x10, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12,
+	0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4c, 0x69, 0x6e,
+	0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5f, 0x92, 0x41, 0x34, 0x0a,
+	0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe9, 0xa2, 0x84, 0xe8, 0xa7, 0x88, 0x12, 0x12, 0xe5,
+	0x88, 0x97, 0xe5, 0x87, 0xba, 0xe9, 0xa2, 0x84, 0xe8, 0xa7, 0x88, 0xe9, 0x93, 0xbe, 0xe6, 0x8e,
+	0xa5, 0x2a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4c, 0x69,
+	0x6e, 0x6b, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x70,
+	0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x2d, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0xbb, 0x01, 0x0a, 0x11,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4c, 0x69, 0x6e,
+	0x6b, 0x12, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69,
+	0x92, 0x41, 0x35, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe9, 0xa2, 0x84, 0xe8, 0xa7,
+	0x88, 0x12, 0x12, 0xe6, 0x92, 0xa4, 0xe9, 0x94, 0x80, 0xe9, 0xa2, 0x84, 0xe8, 0xa7, 0x88, 0xe9,
+	0x93, 0xbe, 0xe6, 0x8e, 0xa5, 0x2a, 0x11, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x4c, 0x69, 0x6e, 0x6b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x2a, 0x29,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49,
+	0x44, 0x7d, 0x2f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2d, 0x6c, 0x69, 0x6e, 0x6b, 0x73,
+	0x2f, 0x7b, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x44, 0x7d, 0x12, 0x97, 0x01, 0x0a, 0x0a, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5a, 0x92, 0x41, 0x3a, 0x0a, 0x0c, 0xe5, 0x8d,
+	0x9a, 0xe5, 0xae, 0xa2, 0xe9, 0xa2, 0x84, 0xe8, 0xa7, 0x88, 0x12, 0x1e, 0xe9, 0x80, 0x9a, 0xe8,
+	0xbf, 0x87, 0xe9, 0xa2, 0x84, 0xe8, 0xa7, 0x88, 0xe9, 0x93, 0xbe, 0xe6, 0x8e, 0xa5, 0xe6, 0x9f,
+	0xa5, 0xe7, 0x9c, 0x8b, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0x2a, 0x0a, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x76,
+	0x31, 0x2f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x2f, 0x7b, 0x6c, 0x69, 0x6e, 0x6b,
+	0x49, 0x44, 0x7d, 0x12, 0xd0, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x20, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x72, 0x92, 0x41, 0x3f, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7,
+	0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x18, 0xe5, 0x8f, 0x91, 0xe9, 0x80, 0x81, 0xe9, 0x82, 0xae,
+	0xe7, 0xae, 0xb1, 0xe9, 0xaa, 0x8c, 0xe8, 0xaf, 0x81, 0xe9, 0x82, 0xae, 0xe4, 0xbb, 0xb6, 0x2a,
+	0x15, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x01, 0x2a, 0x22,
+	0x25, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x7d, 0x2f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2d, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x8a, 0x01, 0x0a, 0x0b, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4a, 0x92, 0x41, 0x2f, 0x0a, 0x0c, 0xe7, 0x94,
+	0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe9, 0xaa, 0x8c, 0xe8,
+	0xaf, 0x81, 0xe7, 0x94, 0xb5, 0xe5, 0xad, 0x90, 0xe9, 0x82, 0xae, 0xe7, 0xae, 0xb1, 0x2a, 0x0b,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x12, 0x12, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x2d, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0xb3, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x58, 0x92, 0x41, 0x38, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7,
+	0x90, 0x86, 0x12, 0x12, 0xe7, 0x94, 0xb3, 0xe8, 0xaf, 0xb7, 0xe9, 0x87, 0x8d, 0xe7, 0xbd, 0xae,
+	0xe5, 0xaf, 0x86, 0xe7, 0xa0, 0x81, 0x2a, 0x14, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x17, 0x3a, 0x01, 0x2a, 0x22, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x2d, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x99, 0x01, 0x0a, 0x0d, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x53, 0x92, 0x41, 0x2b, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1,
+	0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe9, 0x87, 0x8d, 0xe7, 0xbd, 0xae, 0xe5, 0xaf, 0x86, 0xe7, 0xa0,
+	0x81, 0x2a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x2d, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x12, 0x98, 0x01, 0x0a, 0x0a, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x5b, 0x92, 0x41, 0x34, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7,
+	0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x18, 0xe8, 0xa7, 0xa3, 0xe9, 0x99, 0xa4, 0xe7, 0x94,
+	0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe9, 0x94, 0x81, 0xe5, 0xae, 0x9a,
+	0x2a, 0x0a, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x2f, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0xaa, 0x01, 0x0a, 0x0f, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x77, 0x6f, 0x46, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x77, 0x6f, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5e, 0x92,
+	0x41, 0x33, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86,
+	0x12, 0x12, 0xe7, 0xbb, 0x91, 0xe5, 0xae, 0x9a, 0xe4, 0xb8, 0xa4, 0xe6, 0xad, 0xa5, 0xe9, 0xaa,
+	0x8c, 0xe8, 0xaf, 0x81, 0x2a, 0x0f, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x77, 0x6f, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d,
+	0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x7d, 0x2f, 0x74, 0x77, 0x6f, 0x2d, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0xb6, 0x01,
+	0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54,
+	0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x77, 0x6f, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x67, 0x92,
+	0x41, 0x34, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86,
+	0x12, 0x12, 0xe5, 0x90, 0xaf, 0xe7, 0x94, 0xa8, 0xe4, 0xb8, 0xa4, 0xe6, 0xad, 0xa5, 0xe9, 0xaa,
+	0x8c, 0xe8, 0xaf, 0x81, 0x2a, 0x10, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x77, 0x6f,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x01, 0x2a, 0x22,
+	0x25, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x7d, 0x2f, 0x74, 0x77, 0x6f, 0x2d, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x12, 0x9e, 0x01, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x52, 0x92, 0x41, 0x33, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7,
+	0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe4, 0xb8, 0xa4, 0xe6, 0xad, 0xa5, 0xe9, 0xaa,
+	0x8c, 0xe8, 0xaf, 0x81, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0x2a, 0x0f, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x2f, 0x74, 0x77, 0x6f,
+	0x2d, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0xa9, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x60, 0x92, 0x41, 0x38, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae,
+	0xa1, 0xe7, 0x90, 0x86, 0x12, 0x18, 0xe9, 0x87, 0x8d, 0xe7, 0xbd, 0xae, 0xe7, 0x94, 0xa8, 0xe6,
+	0x88, 0xb7, 0xe4, 0xb8, 0xa4, 0xe6, 0xad, 0xa5, 0xe9, 0xaa, 0x8c, 0xe8, 0xaf, 0x81, 0x2a, 0x0e,
+	0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1f, 0x2a, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f,
+	0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x2f, 0x74, 0x77, 0x6f, 0x2d, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x8a, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x92, 0x41, 0x30, 0x0a, 0x0c, 0xe7, 0x99,
+	0xbb, 0xe5, 0xbd, 0x95, 0xe4, 0xbc, 0x9a, 0xe8, 0xaf, 0x9d, 0x12, 0x12, 0xe5, 0x88, 0x97, 0xe5,
+	0x87, 0xba, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe4, 0xbc, 0x9a, 0xe8, 0xaf, 0x9d, 0x2a, 0x0c,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x9a, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x92, 0x41, 0x31, 0x0a, 0x0c, 0xe7, 0x99,
+	0xbb, 0xe5, 0xbd, 0x95, 0xe4, 0xbc, 0x9a, 0xe8, 0xaf, 0x9d, 0x12, 0x12, 0xe6, 0x92, 0xa4, 0xe9,
+	0x94, 0x80, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe4, 0xbc, 0x9a, 0xe8, 0xaf, 0x9d, 0x2a, 0x0d,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1a, 0x2a, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x7b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x7d, 0x12, 0xc9, 0x01,
+	0x0a, 0x16, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x6c, 0x6c, 0x4f, 0x74, 0x68, 0x65, 0x72,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x41, 0x6c, 0x6c, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x6c, 0x6c, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x68, 0x92, 0x41, 0x40, 0x0a, 0x0c, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe4, 0xbc, 0x9a, 0xe8,
+	0xaf, 0x9d, 0x12, 0x18, 0xe6, 0x92, 0xa4, 0xe9, 0x94, 0x80, 0xe5, 0x85, 0xb6, 0xe4, 0xbb, 0x96,
+	0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe4, 0xbc, 0x9a, 0xe8, 0xaf, 0x9d, 0x2a, 0x16, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x41, 0x6c, 0x6c, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f,
+	0x76, 0x31, 0x2f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x72, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x2d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x12, 0xb2, 0x01, 0x0a, 0x11, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x60, 0x92, 0x41,
+	0x41, 0x0a, 0x12, 0xe4, 0xb8, 0xaa, 0xe4, 0xba, 0xba, 0xe8, 0xae, 0xbf, 0xe9, 0x97, 0xae, 0xe4,
+	0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0x12, 0x18, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe4, 0xb8, 0xaa,
+	0xe4, 0xba, 0xba, 0xe8, 0xae, 0xbf, 0xe9, 0x97, 0xae, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0x2a,
+	0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x76, 0x31,
+	0x2f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0xab,
+	0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x12, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c,
+	0x92, 0x41, 0x40, 0x0a, 0x12, 0xe4, 0xb8, 0xaa, 0xe4, 0xba, 0xba, 0xe8, 0xae, 0xbf, 0xe9, 0x97,
+	0xae, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0x12, 0x18, 0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe4,
+	0xb8, 0xaa, 0xe4, 0xba, 0xba, 0xe8, 0xae, 0xbf, 0xe9, 0x97, 0xae, 0xe4, 0xbb, 0xa4, 0xe7, 0x89,
+	0x8c, 0x2a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x2d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0xb9, 0x01, 0x0a,
+	0x11, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x67, 0x92, 0x41, 0x41, 0x0a, 0x12, 0xe4, 0xb8, 0xaa, 0xe4, 0xba, 0xba, 0xe8, 0xae, 0xbf, 0xe9,
+	0x97, 0xae, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0x12, 0x18, 0xe6, 0x92, 0xa4, 0xe9, 0x94, 0x80,
+	0xe4, 0xb8, 0xaa, 0xe4, 0xba, 0xba, 0xe8, 0xae, 0xbf, 0xe9, 0x97, 0xae, 0xe4, 0xbb, 0xa4, 0xe7,
+	0x89, 0x8c, 0x2a, 0x11, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x2a, 0x1b, 0x2f, 0x76, 0x31,
+	0x2f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x2f, 0x7b,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44, 0x7d, 0x12, 0x9c, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x4f, 0x49, 0x44, 0x43, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x19, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x49, 0x44, 0x43, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x4f, 0x49, 0x44, 0x43, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x53, 0x92, 0x41, 0x32, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7,
+	0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe5, 0x8f, 0x91, 0xe8, 0xb5, 0xb7, 0xe5, 0x8d, 0x95,
+	0xe7, 0x82, 0xb9, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0x2a, 0x0e, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x4f, 0x49, 0x44, 0x43, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12,
+	0x16, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x2f, 0x6f, 0x69, 0x64, 0x63, 0x2f, 0x7b, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x7d, 0x12, 0xb1, 0x01, 0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x4f, 0x49, 0x44, 0x43, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x49, 0x44, 0x43, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x49, 0x44, 0x43, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5f, 0x92, 0x41, 0x35, 0x0a,
+	0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe5,
+	0xae, 0x8c, 0xe6, 0x88, 0x90, 0xe5, 0x8d, 0x95, 0xe7, 0x82, 0xb9, 0xe7, 0x99, 0xbb, 0xe5, 0xbd,
+	0x95, 0x2a, 0x11, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x49, 0x44, 0x43, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x6c, 0x6f, 0x67,
+	0x69, 0x6e, 0x2f, 0x6f, 0x69, 0x64, 0x63, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x7d, 0x2f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x90, 0x01, 0x0a, 0x0c,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x4d, 0x92, 0x41, 0x36, 0x0a, 0x0c, 0xe6, 0x9d, 0x83, 0xe9, 0x99, 0x90, 0xe7, 0xae, 0xa1, 0xe7,
+	0x90, 0x86, 0x12, 0x18, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe6, 0x8e, 0x88, 0xe6, 0x9d, 0x83,
+	0xe7, 0xad, 0x96, 0xe7, 0x95, 0xa5, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x2a, 0x0c, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e,
+	0x12, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x8d,
+	0x01, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x17, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x4a, 0x92, 0x41, 0x30, 0x0a, 0x0c, 0xe6, 0x9d, 0x83, 0xe9, 0x99, 0x90, 0xe7,
+	0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe6, 0xb7, 0xbb, 0xe5, 0x8a, 0xa0, 0xe6, 0x8e, 0x88,
+	0xe6, 0x9d, 0x83, 0xe7, 0xad, 0x96, 0xe7, 0x95, 0xa5, 0x2a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a, 0x01, 0x2a,
+	0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x8d,
+	0x01, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x17, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x4a, 0x92, 0x41, 0x30, 0x0a, 0x0c, 0xe6, 0x9d, 0x83, 0xe9, 0x99, 0x90, 0xe7,
+	0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe6, 0x8e, 0x88,
+	0xe6, 0x9d, 0x83, 0xe7, 0xad, 0x96, 0xe7, 0x95, 0xa5, 0x2a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a, 0x01, 0x2a,
+	0x2a, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x9f,
+	0x01, 0x0a, 0x0f, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x12, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x53, 0x92, 0x41, 0x39,
+	0x0a, 0x0c, 0xe6, 0x9d, 0x83, 0xe9, 0x99, 0x90, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x18,
+	0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe6, 0x9b, 0xbf, 0xe6, 0x8d, 0xa2, 0xe6, 0x8e, 0x88, 0xe6,
+	0x9d, 0x83, 0xe7, 0xad, 0x96, 0xe7, 0x95, 0xa5, 0x2a, 0x0f, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a,
+	0x01, 0x2a, 0x1a, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73,
+	0x12, 0xb4, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c, 0x92, 0x41, 0x3d, 0x0a, 0x0c,
+	0xe6, 0x9d, 0x83, 0xe9, 0x99, 0x90, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x18, 0xe6, 0x9f,
+	0xa5, 0xe8, 0xaf, 0xa2, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe5, 0x88, 0x86, 0xe9, 0x85, 0x8d,
+	0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x2a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x2d, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x94, 0x01, 0x0a, 0x0a, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x92, 0x41, 0x31, 0x0a, 0x0c, 0xe6, 0x9d, 0x83, 0xe9,
+	0x99, 0x90, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x15, 0xe4, 0xb8, 0xba, 0xe7, 0x94, 0xa8,
+	0xe6, 0x88, 0xb7, 0xe5, 0x88, 0x86, 0xe9, 0x85, 0x8d, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0x2a,
+	0x0a, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f,
+	0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x99,
+	0x01, 0x0a, 0x0c, 0x55, 0x6e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x12,
+	0x17, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x56, 0x92, 0x41, 0x30, 0x0a, 0x0c, 0xe6, 0x9d, 0x83, 0xe9, 0x99, 0x90, 0xe7,
+	0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe7, 0xa7, 0xbb, 0xe9, 0x99, 0xa4, 0xe7, 0x94, 0xa8,
+	0xe6, 0x88, 0xb7, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0x2a, 0x0c, 0x55, 0x6e, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a,
+	0x2a, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0xa0, 0x01, 0x0a, 0x0d, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x5a, 0x92, 0x41, 0x37, 0x0a, 0x0c, 0xe6, 0x9d, 0x83, 0xe9, 0x99, 0x90, 0xe7, 0xae,
+	0xa1, 0xe7, 0x90, 0x86, 0x12, 0x18, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0x94, 0xa8, 0xe6,
+	0x88, 0xb7, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x2a, 0x0d,
+	0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x9e, 0x01,
+	0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12,
+	0x18, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x58, 0x92, 0x41, 0x37, 0x0a, 0x0c, 0xe6, 0x9d, 0x83, 0xe9, 0x99,
+	0x90, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x18, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe8,
+	0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe5, 0x88, 0x97, 0xe8, 0xa1,
+	0xa8, 0x2a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x6f, 0x6c, 0x65,
+	0x73, 0x2f, 0x7b, 0x72, 0x6f, 0x6c, 0x65, 0x7d, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x42, 0x96,
 	0x02, 0x92, 0x41, 0xe0, 0x01, 0x12, 0xb6, 0x01, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c,
 	0x6f, 0x67, 0x20, 0x41, 0x50, 0x49, 0x22, 0x57, 0x0a, 0x18, 0xe5, 0xb0, 0x8f, 0xe8, 0x80, 0x8c,
 	0xe7, 0xbe, 0x8e, 0xe7, 0x9a, 0x84, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe9, 0xa1, 0xb9, 0xe7,
@@ -181,69 +812,301 @@ var file_apiserver_v1_apiserver_proto_rawDesc = []byte{
 }
 
 var file_apiserver_v1_apiserver_proto_goTypes = []any{
-	(*emptypb.Empty)(nil),          // 0: google.protobuf.Empty
-	(*LoginRequest)(nil),           // 1: v1.LoginRequest
-	(*RefreshTokenRequest)(nil),    // 2: v1.RefreshTokenRequest
-	(*ChangePasswordRequest)(nil),  // 3: v1.ChangePasswordRequest
-	(*CreateUserRequest)(nil),      // 4: v1.CreateUserRequest
-	(*UpdateUserRequest)(nil),      // 5: v1.UpdateUserRequest
-	(*DeleteUserRequest)(nil),      // 6: v1.DeleteUserRequest
-	(*GetUserRequest)(nil),         // 7: v1.GetUserRequest
-	(*ListUserRequest)(nil),        // 8: v1.ListUserRequest
-	(*CreatePostRequest)(nil),      // 9: v1.CreatePostRequest
-	(*UpdatePostRequest)(nil),      // 10: v1.UpdatePostRequest
-	(*DeletePostRequest)(nil),      // 11: v1.DeletePostRequest
-	(*GetPostRequest)(nil),         // 12: v1.GetPostRequest
-	(*ListPostRequest)(nil),        // 13: v1.ListPostRequest
-	(*HealthzResponse)(nil),        // 14: v1.HealthzResponse
-	(*LoginResponse)(nil),          // 15: v1.LoginResponse
-	(*RefreshTokenResponse)(nil),   // 16: v1.RefreshTokenResponse
-	(*ChangePasswordResponse)(nil), // 17: v1.ChangePasswordResponse
-	(*CreateUserResponse)(nil),     // 18: v1.CreateUserResponse
-	(*UpdateUserResponse)(nil),     // 19: v1.UpdateUserResponse
-	(*DeleteUserResponse)(nil),     // 20: v1.DeleteUserResponse
-	(*GetUserResponse)(nil),        // 21: v1.GetUserResponse
-	(*ListUserResponse)(nil),       // 22: v1.ListUserResponse
-	(*CreatePostResponse)(nil),     // 23: v1.CreatePostResponse
-	(*UpdatePostResponse)(nil),     // 24: v1.UpdatePostResponse
-	(*DeletePostResponse)(nil),     // 25: v1.DeletePostResponse
-	(*GetPostResponse)(nil),        // 26: v1.GetPostResponse
-	(*ListPostResponse)(nil),       // 27: v1.ListPostResponse
+	(*emptypb.Empty)(nil),                  // 0: google.protobuf.Empty
+	(*LoginRequest)(nil),                   // 1: v1.LoginRequest
+	(*RefreshTokenRequest)(nil),            // 2: v1.RefreshTokenRequest
+	(*LogoutRequest)(nil),                  // 3: v1.LogoutRequest
+	(*ChangePasswordRequest)(nil),          // 4: v1.ChangePasswordRequest
+	(*CreateUserRequest)(nil),              // 5: v1.CreateUserRequest
+	(*UpdateUserRequest)(nil),              // 6: v1.UpdateUserRequest
+	(*DeleteUserRequest)(nil),              // 7: v1.DeleteUserRequest
+	(*GetUserRequest)(nil),                 // 8: v1.GetUserRequest
+	(*ListUserRequest)(nil),                // 9: v1.ListUserRequest
+	(*CreatePostRequest)(nil),              // 10: v1.CreatePostRequest
+	(*UpdatePostRequest)(nil),              // 11: v1.UpdatePostRequest
+	(*DeletePostRequest)(nil),              // 12: v1.DeletePostRequest
+	(*GetPostRequest)(nil),                 // 13: v1.GetPostRequest
+	(*ListPostRequest)(nil),                // 14: v1.ListPostRequest
+	(*AddReactionRequest)(nil),             // 15: v1.AddReactionRequest
+	(*RemoveReactionRequest)(nil),          // 16: v1.RemoveReactionRequest
+	(*ListReactorsRequest)(nil),            // 17: v1.ListReactorsRequest
+	(*AddBookmarkRequest)(nil),             // 18: v1.AddBookmarkRequest
+	(*RemoveBookmarkRequest)(nil),          // 19: v1.RemoveBookmarkRequest
+	(*ListBookmarkRequest)(nil),            // 20: v1.ListBookmarkRequest
+	(*ReorderBookmarksRequest)(nil),        // 21: v1.ReorderBookmarksRequest
+	(*ListReadingListRequest)(nil),         // 22: v1.ListReadingListRequest
+	(*ListMentionsRequest)(nil),            // 23: v1.ListMentionsRequest
+	(*ListRelatedPostsRequest)(nil),        // 24: v1.ListRelatedPostsRequest
+	(*PinPostRequest)(nil),                 // 25: v1.PinPostRequest
+	(*UnpinPostRequest)(nil),               // 26: v1.UnpinPostRequest
+	(*FeaturePostRequest)(nil),             // 27: v1.FeaturePostRequest
+	(*UnfeaturePostRequest)(nil),           // 28: v1.UnfeaturePostRequest
+	(*ListPostActivityRequest)(nil),        // 29: v1.ListPostActivityRequest
+	(*ListTopAuthorsRequest)(nil),          // 30: v1.ListTopAuthorsRequest
+	(*ListSiteStatsRequest)(nil),           // 31: v1.ListSiteStatsRequest
+	(*GetMyPostStatsRequest)(nil),          // 32: v1.GetMyPostStatsRequest
+	(*CreatePostTranslationRequest)(nil),   // 33: v1.CreatePostTranslationRequest
+	(*UpdatePostTranslationRequest)(nil),   // 34: v1.UpdatePostTranslationRequest
+	(*DeletePostTranslationRequest)(nil),   // 35: v1.DeletePostTranslationRequest
+	(*CreatePostTemplateRequest)(nil),      // 36: v1.CreatePostTemplateRequest
+	(*UpdatePostTemplateRequest)(nil),      // 37: v1.UpdatePostTemplateRequest
+	(*DeletePostTemplateRequest)(nil),      // 38: v1.DeletePostTemplateRequest
+	(*GetPostTemplateRequest)(nil),         // 39: v1.GetPostTemplateRequest
+	(*ListPostTemplateRequest)(nil),        // 40: v1.ListPostTemplateRequest
+	(*CreatePostFromTemplateRequest)(nil),  // 41: v1.CreatePostFromTemplateRequest
+	(*CreatePreviewLinkRequest)(nil),       // 42: v1.CreatePreviewLinkRequest
+	(*ListPreviewLinksRequest)(nil),        // 43: v1.ListPreviewLinksRequest
+	(*RevokePreviewLinkRequest)(nil),       // 44: v1.RevokePreviewLinkRequest
+	(*GetPreviewRequest)(nil),              // 45: v1.GetPreviewRequest
+	(*SendVerificationEmailRequest)(nil),   // 46: v1.SendVerificationEmailRequest
+	(*VerifyEmailRequest)(nil),             // 47: v1.VerifyEmailRequest
+	(*RequestPasswordResetRequest)(nil),    // 48: v1.RequestPasswordResetRequest
+	(*ResetPasswordRequest)(nil),           // 49: v1.ResetPasswordRequest
+	(*UnlockUserRequest)(nil),              // 50: v1.UnlockUserRequest
+	(*EnrollTwoFactorRequest)(nil),         // 51: v1.EnrollTwoFactorRequest
+	(*ConfirmTwoFactorRequest)(nil),        // 52: v1.ConfirmTwoFactorRequest
+	(*VerifyTwoFactorRequest)(nil),         // 53: v1.VerifyTwoFactorRequest
+	(*ResetTwoFactorRequest)(nil),          // 54: v1.ResetTwoFactorRequest
+	(*ListSessionsRequest)(nil),            // 55: v1.ListSessionsRequest
+	(*RevokeSessionRequest)(nil),           // 56: v1.RevokeSessionRequest
+	(*RevokeAllOtherSessionsRequest)(nil),  // 57: v1.RevokeAllOtherSessionsRequest
+	(*CreateAccessTokenRequest)(nil),       // 58: v1.CreateAccessTokenRequest
+	(*ListAccessTokensRequest)(nil),        // 59: v1.ListAccessTokensRequest
+	(*RevokeAccessTokenRequest)(nil),       // 60: v1.RevokeAccessTokenRequest
+	(*StartOIDCLoginRequest)(nil),          // 61: v1.StartOIDCLoginRequest
+	(*CompleteOIDCLoginRequest)(nil),       // 62: v1.CompleteOIDCLoginRequest
+	(*ListPoliciesRequest)(nil),            // 63: v1.ListPoliciesRequest
+	(*CreatePolicyRequest)(nil),            // 64: v1.CreatePolicyRequest
+	(*DeletePolicyRequest)(nil),            // 65: v1.DeletePolicyRequest
+	(*ReplacePoliciesRequest)(nil),         // 66: v1.ReplacePoliciesRequest
+	(*ListRoleAssignmentsRequest)(nil),     // 67: v1.ListRoleAssignmentsRequest
+	(*AssignRoleRequest)(nil),              // 68: v1.AssignRoleRequest
+	(*UnassignRoleRequest)(nil),            // 69: v1.UnassignRoleRequest
+	(*ListUserRolesRequest)(nil),           // 70: v1.ListUserRolesRequest
+	(*ListRoleUsersRequest)(nil),           // 71: v1.ListRoleUsersRequest
+	(*HealthzResponse)(nil),                // 72: v1.HealthzResponse
+	(*LoginResponse)(nil),                  // 73: v1.LoginResponse
+	(*RefreshTokenResponse)(nil),           // 74: v1.RefreshTokenResponse
+	(*LogoutResponse)(nil),                 // 75: v1.LogoutResponse
+	(*ChangePasswordResponse)(nil),         // 76: v1.ChangePasswordResponse
+	(*CreateUserResponse)(nil),             // 77: v1.CreateUserResponse
+	(*UpdateUserResponse)(nil),             // 78: v1.UpdateUserResponse
+	(*DeleteUserResponse)(nil),             // 79: v1.DeleteUserResponse
+	(*GetUserResponse)(nil),                // 80: v1.GetUserResponse
+	(*ListUserResponse)(nil),               // 81: v1.ListUserResponse
+	(*CreatePostResponse)(nil),             // 82: v1.CreatePostResponse
+	(*UpdatePostResponse)(nil),             // 83: v1.UpdatePostResponse
+	(*DeletePostResponse)(nil),             // 84: v1.DeletePostResponse
+	(*GetPostResponse)(nil),                // 85: v1.GetPostResponse
+	(*ListPostResponse)(nil),               // 86: v1.ListPostResponse
+	(*AddReactionResponse)(nil),            // 87: v1.AddReactionResponse
+	(*RemoveReactionResponse)(nil),         // 88: v1.RemoveReactionResponse
+	(*ListReactorsResponse)(nil),           // 89: v1.ListReactorsResponse
+	(*AddBookmarkResponse)(nil),            // 90: v1.AddBookmarkResponse
+	(*RemoveBookmarkResponse)(nil),         // 91: v1.RemoveBookmarkResponse
+	(*ListBookmarkResponse)(nil),           // 92: v1.ListBookmarkResponse
+	(*ReorderBookmarksResponse)(nil),       // 93: v1.ReorderBookmarksResponse
+	(*ListReadingListResponse)(nil),        // 94: v1.ListReadingListResponse
+	(*ListMentionsResponse)(nil),           // 95: v1.ListMentionsResponse
+	(*ListRelatedPostsResponse)(nil),       // 96: v1.ListRelatedPostsResponse
+	(*PinPostResponse)(nil),                // 97: v1.PinPostResponse
+	(*UnpinPostResponse)(nil),              // 98: v1.UnpinPostResponse
+	(*FeaturePostResponse)(nil),            // 99: v1.FeaturePostResponse
+	(*UnfeaturePostResponse)(nil),          // 100: v1.UnfeaturePostResponse
+	(*ListPostActivityResponse)(nil),       // 101: v1.ListPostActivityResponse
+	(*ListTopAuthorsResponse)(nil),         // 102: v1.ListTopAuthorsResponse
+	(*ListSiteStatsResponse)(nil),          // 103: v1.ListSiteStatsResponse
+	(*GetMyPostStatsResponse)(nil),         // 104: v1.GetMyPostStatsResponse
+	(*CreatePostTranslationResponse)(nil),  // 105: v1.CreatePostTranslationResponse
+	(*UpdatePostTranslationResponse)(nil),  // 106: v1.UpdatePostTranslationResponse
+	(*DeletePostTranslationResponse)(nil),  // 107: v1.DeletePostTranslationResponse
+	(*CreatePostTemplateResponse)(nil),     // 108: v1.CreatePostTemplateResponse
+	(*UpdatePostTemplateResponse)(nil),     // 109: v1.UpdatePostTemplateResponse
+	(*DeletePostTemplateResponse)(nil),     // 110: v1.DeletePostTemplateResponse
+	(*GetPostTemplateResponse)(nil),        // 111: v1.GetPostTemplateResponse
+	(*ListPostTemplateResponse)(nil),       // 112: v1.ListPostTemplateResponse
+	(*CreatePostFromTemplateResponse)(nil), // 113: v1.CreatePostFromTemplateResponse
+	(*CreatePreviewLinkResponse)(nil),      // 114: v1.CreatePreviewLinkResponse
+	(*ListPreviewLinksResponse)(nil),       // 115: v1.ListPreviewLinksResponse
+	(*RevokePreviewLinkResponse)(nil),      // 116: v1.RevokePreviewLinkResponse
+	(*GetPreviewResponse)(nil),             // 117: v1.GetPreviewResponse
+	(*SendVerificationEmailResponse)(nil),  // 118: v1.SendVerificationEmailResponse
+	(*VerifyEmailResponse)(nil),            // 119: v1.VerifyEmailResponse
+	(*RequestPasswordResetResponse)(nil),   // 120: v1.RequestPasswordResetResponse
+	(*ResetPasswordResponse)(nil),          // 121: v1.ResetPasswordResponse
+	(*UnlockUserResponse)(nil),             // 122: v1.UnlockUserResponse
+	(*EnrollTwoFactorResponse)(nil),        // 123: v1.EnrollTwoFactorResponse
+	(*ConfirmTwoFactorResponse)(nil),       // 124: v1.ConfirmTwoFactorResponse
+	(*VerifyTwoFactorResponse)(nil),        // 125: v1.VerifyTwoFactorResponse
+	(*ResetTwoFactorResponse)(nil),         // 126: v1.ResetTwoFactorResponse
+	(*ListSessionsResponse)(nil),           // 127: v1.ListSessionsResponse
+	(*RevokeSessionResponse)(nil),          // 128: v1.RevokeSessionResponse
+	(*RevokeAllOtherSessionsResponse)(nil), // 129: v1.RevokeAllOtherSessionsResponse
+	(*CreateAccessTokenResponse)(nil),      // 130: v1.CreateAccessTokenResponse
+	(*ListAccessTokensResponse)(nil),       // 131: v1.ListAccessTokensResponse
+	(*RevokeAccessTokenResponse)(nil),      // 132: v1.RevokeAccessTokenResponse
+	(*StartOIDCLoginResponse)(nil),         // 133: v1.StartOIDCLoginResponse
+	(*CompleteOIDCLoginResponse)(nil),      // 134: v1.CompleteOIDCLoginResponse
+	(*ListPoliciesResponse)(nil),           // 135: v1.ListPoliciesResponse
+	(*CreatePolicyResponse)(nil),           // 136: v1.CreatePolicyResponse
+	(*DeletePolicyResponse)(nil),           // 137: v1.DeletePolicyResponse
+	(*ReplacePoliciesResponse)(nil),        // 138: v1.ReplacePoliciesResponse
+	(*ListRoleAssignmentsResponse)(nil),    // 139: v1.ListRoleAssignmentsResponse
+	(*AssignRoleResponse)(nil),             // 140: v1.AssignRoleResponse
+	(*UnassignRoleResponse)(nil),           // 141: v1.UnassignRoleResponse
+	(*ListUserRolesResponse)(nil),          // 142: v1.ListUserRolesResponse
+	(*ListRoleUsersResponse)(nil),          // 143: v1.ListRoleUsersResponse
 }
 var file_apiserver_v1_apiserver_proto_depIdxs = []int32{
-	0,  // 0: v1.MiniBlog.Healthz:input_type -> google.protobuf.Empty
-	1,  // 1: v1.MiniBlog.Login:input_type -> v1.LoginRequest
-	2,  // 2: v1.MiniBlog.RefreshToken:input_type -> v1.RefreshTokenRequest
-	3,  // 3: v1.MiniBlog.ChangePassword:input_type -> v1.ChangePasswordRequest
-	4,  // 4: v1.MiniBlog.CreateUser:input_type -> v1.CreateUserRequest
-	5,  // 5: v1.MiniBlog.UpdateUser:input_type -> v1.UpdateUserRequest
-	6,  // 6: v1.MiniBlog.DeleteUser:input_type -> v1.DeleteUserRequest
-	7,  // 7: v1.MiniBlog.GetUser:input_type -> v1.GetUserRequest
-	8,  // 8: v1.MiniBlog.ListUser:input_type -> v1.ListUserRequest
-	9,  // 9: v1.MiniBlog.CreatePost:input_type -> v1.CreatePostRequest
-	10, // 10: v1.MiniBlog.UpdatePost:input_type -> v1.UpdatePostRequest
-	11, // 11: v1.MiniBlog.DeletePost:input_type -> v1.DeletePostRequest
-	12, // 12: v1.MiniBlog.GetPost:input_type -> v1.GetPostRequest
-	13, // 13: v1.MiniBlog.ListPost:input_type -> v1.ListPostRequest
-	14, // 14: v1.MiniBlog.Healthz:output_type -> v1.HealthzResponse
-	15, // 15: v1.MiniBlog.Login:output_type -> v1.LoginResponse
-	16, // 16: v1.MiniBlog.RefreshToken:output_type -> v1.RefreshTokenResponse
-	17, // 17: v1.MiniBlog.ChangePassword:output_type -> v1.ChangePasswordResponse
-	18, // 18: v1.MiniBlog.CreateUser:output_type -> v1.CreateUserResponse
-	19, // 19: v1.MiniBlog.UpdateUser:output_type -> v1.UpdateUserResponse
-	20, // 20: v1.MiniBlog.DeleteUser:output_type -> v1.DeleteUserResponse
-	21, // 21: v1.MiniBlog.GetUser:output_type -> v1.GetUserResponse
-	22, // 22: v1.MiniBlog.ListUser:output_type -> v1.ListUserResponse
-	23, // 23: v1.MiniBlog.CreatePost:output_type -> v1.CreatePostResponse
-	24, // 24: v1.MiniBlog.UpdatePost:output_type -> v1.UpdatePostResponse
-	25, // 25: v1.MiniBlog.DeletePost:output_type -> v1.DeletePostResponse
-	26, // 26: v1.MiniBlog.GetPost:output_type -> v1.GetPostResponse
-	27, // 27: v1.MiniBlog.ListPost:output_type -> v1.ListPostResponse
-	14, // [14:28] is the sub-list for method output_type
-	0,  // [0:14] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	0,   // 0: v1.MiniBlog.Healthz:input_type -> google.protobuf.Empty
+	1,   // 1: v1.MiniBlog.Login:input_type -> v1.LoginRequest
+	2,   // 2: v1.MiniBlog.RefreshToken:input_type -> v1.RefreshTokenRequest
+	3,   // 3: v1.MiniBlog.Logout:input_type -> v1.LogoutRequest
+	4,   // 4: v1.MiniBlog.ChangePassword:input_type -> v1.ChangePasswordRequest
+	5,   // 5: v1.MiniBlog.CreateUser:input_type -> v1.CreateUserRequest
+	6,   // 6: v1.MiniBlog.UpdateUser:input_type -> v1.UpdateUserRequest
+	7,   // 7: v1.MiniBlog.DeleteUser:input_type -> v1.DeleteUserRequest
+	8,   // 8: v1.MiniBlog.GetUser:input_type -> v1.GetUserRequest
+	9,   // 9: v1.MiniBlog.ListUser:input_type -> v1.ListUserRequest
+	10,  // 10: v1.MiniBlog.CreatePost:input_type -> v1.CreatePostRequest
+	11,  // 11: v1.MiniBlog.UpdatePost:input_type -> v1.UpdatePostRequest
+	12,  // 12: v1.MiniBlog.DeletePost:input_type -> v1.DeletePostRequest
+	13,  // 13: v1.MiniBlog.GetPost:input_type -> v1.GetPostRequest
+	14,  // 14: v1.MiniBlog.ListPost:input_type -> v1.ListPostRequest
+	15,  // 15: v1.MiniBlog.AddReaction:input_type -> v1.AddReactionRequest
+	16,  // 16: v1.MiniBlog.RemoveReaction:input_type -> v1.RemoveReactionRequest
+	17,  // 17: v1.MiniBlog.ListReactors:input_type -> v1.ListReactorsRequest
+	18,  // 18: v1.MiniBlog.AddBookmark:input_type -> v1.AddBookmarkRequest
+	19,  // 19: v1.MiniBlog.RemoveBookmark:input_type -> v1.RemoveBookmarkRequest
+	20,  // 20: v1.MiniBlog.ListBookmark:input_type -> v1.ListBookmarkRequest
+	21,  // 21: v1.MiniBlog.ReorderBookmarks:input_type -> v1.ReorderBookmarksRequest
+	22,  // 22: v1.MiniBlog.ListReadingList:input_type -> v1.ListReadingListRequest
+	23,  // 23: v1.MiniBlog.ListMentions:input_type -> v1.ListMentionsRequest
+	24,  // 24: v1.MiniBlog.ListRelatedPosts:input_type -> v1.ListRelatedPostsRequest
+	25,  // 25: v1.MiniBlog.PinPost:input_type -> v1.PinPostRequest
+	26,  // 26: v1.MiniBlog.UnpinPost:input_type -> v1.UnpinPostRequest
+	27,  // 27: v1.MiniBlog.FeaturePost:input_type -> v1.FeaturePostRequest
+	28,  // 28: v1.MiniBlog.UnfeaturePost:input_type -> v1.UnfeaturePostRequest
+	29,  // 29: v1.MiniBlog.ListPostActivity:input_type -> v1.ListPostActivityRequest
+	30,  // 30: v1.MiniBlog.ListTopAuthors:input_type -> v1.ListTopAuthorsRequest
+	31,  // 31: v1.MiniBlog.ListSiteStats:input_type -> v1.ListSiteStatsRequest
+	32,  // 32: v1.MiniBlog.GetMyPostStats:input_type -> v1.GetMyPostStatsRequest
+	33,  // 33: v1.MiniBlog.CreatePostTranslation:input_type -> v1.CreatePostTranslationRequest
+	34,  // 34: v1.MiniBlog.UpdatePostTranslation:input_type -> v1.UpdatePostTranslationRequest
+	35,  // 35: v1.MiniBlog.DeletePostTranslation:input_type -> v1.DeletePostTranslationRequest
+	36,  // 36: v1.MiniBlog.CreatePostTemplate:input_type -> v1.CreatePostTemplateRequest
+	37,  // 37: v1.MiniBlog.UpdatePostTemplate:input_type -> v1.UpdatePostTemplateRequest
+	38,  // 38: v1.MiniBlog.DeletePostTemplate:input_type -> v1.DeletePostTemplateRequest
+	39,  // 39: v1.MiniBlog.GetPostTemplate:input_type -> v1.GetPostTemplateRequest
+	40,  // 40: v1.MiniBlog.ListPostTemplate:input_type -> v1.ListPostTemplateRequest
+	41,  // 41: v1.MiniBlog.CreatePostFromTemplate:input_type -> v1.CreatePostFromTemplateRequest
+	42,  // 42: v1.MiniBlog.CreatePreviewLink:input_type -> v1.CreatePreviewLinkRequest
+	43,  // 43: v1.MiniBlog.ListPreviewLinks:input_type -> v1.ListPreviewLinksRequest
+	44,  // 44: v1.MiniBlog.RevokePreviewLink:input_type -> v1.RevokePreviewLinkRequest
+	45,  // 45: v1.MiniBlog.GetPreview:input_type -> v1.GetPreviewRequest
+	46,  // 46: v1.MiniBlog.SendVerificationEmail:input_type -> v1.SendVerificationEmailRequest
+	47,  // 47: v1.MiniBlog.VerifyEmail:input_type -> v1.VerifyEmailRequest
+	48,  // 48: v1.MiniBlog.RequestPasswordReset:input_type -> v1.RequestPasswordResetRequest
+	49,  // 49: v1.MiniBlog.ResetPassword:input_type -> v1.ResetPasswordRequest
+	50,  // 50: v1.MiniBlog.UnlockUser:input_type -> v1.UnlockUserRequest
+	51,  // 51: v1.MiniBlog.EnrollTwoFactor:input_type -> v1.EnrollTwoFactorRequest
+	52,  // 52: v1.MiniBlog.ConfirmTwoFactor:input_type -> v1.ConfirmTwoFactorRequest
+	53,  // 53: v1.MiniBlog.VerifyTwoFactor:input_type -> v1.VerifyTwoFactorRequest
+	54,  // 54: v1.MiniBlog.ResetTwoFactor:input_type -> v1.ResetTwoFactorRequest
+	55,  // 55: v1.MiniBlog.ListSessions:input_type -> v1.ListSessionsRequest
+	56,  // 56: v1.MiniBlog.RevokeSession:input_type -> v1.RevokeSessionRequest
+	57,  // 57: v1.MiniBlog.RevokeAllOtherSessions:input_type -> v1.RevokeAllOtherSessionsRequest
+	58,  // 58: v1.MiniBlog.CreateAccessToken:input_type -> v1.CreateAccessTokenRequest
+	59,  // 59: v1.MiniBlog.ListAccessTokens:input_type -> v1.ListAccessTokensRequest
+	60,  // 60: v1.MiniBlog.RevokeAccessToken:input_type -> v1.RevokeAccessTokenRequest
+	61,  // 61: v1.MiniBlog.StartOIDCLogin:input_type -> v1.StartOIDCLoginRequest
+	62,  // 62: v1.MiniBlog.CompleteOIDCLogin:input_type -> v1.CompleteOIDCLoginRequest
+	63,  // 63: v1.MiniBlog.ListPolicies:input_type -> v1.ListPoliciesRequest
+	64,  // 64: v1.MiniBlog.CreatePolicy:input_type -> v1.CreatePolicyRequest
+	65,  // 65: v1.MiniBlog.DeletePolicy:input_type -> v1.DeletePolicyRequest
+	66,  // 66: v1.MiniBlog.ReplacePolicies:input_type -> v1.ReplacePoliciesRequest
+	67,  // 67: v1.MiniBlog.ListRoleAssignments:input_type -> v1.ListRoleAssignmentsRequest
+	68,  // 68: v1.MiniBlog.AssignRole:input_type -> v1.AssignRoleRequest
+	69,  // 69: v1.MiniBlog.UnassignRole:input_type -> v1.UnassignRoleRequest
+	70,  // 70: v1.MiniBlog.ListUserRoles:input_type -> v1.ListUserRolesRequest
+	71,  // 71: v1.MiniBlog.ListRoleUsers:input_type -> v1.ListRoleUsersRequest
+	72,  // 72: v1.MiniBlog.Healthz:output_type -> v1.HealthzResponse
+	73,  // 73: v1.MiniBlog.Login:output_type -> v1.LoginResponse
+	74,  // 74: v1.MiniBlog.RefreshToken:output_type -> v1.RefreshTokenResponse
+	75,  // 75: v1.MiniBlog.Logout:output_type -> v1.LogoutResponse
+	76,  // 76: v1.MiniBlog.ChangePassword:output_type -> v1.ChangePasswordResponse
+	77,  // 77: v1.MiniBlog.CreateUser:output_type -> v1.CreateUserResponse
+	78,  // 78: v1.MiniBlog.UpdateUser:output_type -> v1.UpdateUserResponse
+	79,  // 79: v1.MiniBlog.DeleteUser:output_type -> v1.DeleteUserResponse
+	80,  // 80: v1.MiniBlog.GetUser:output_type -> v1.GetUserResponse
+	81,  // 81: v1.MiniBlog.ListUser:output_type -> v1.ListUserResponse
+	82,  // 82: v1.MiniBlog.CreatePost:output_type -> v1.CreatePostResponse
+	83,  // 83: v1.MiniBlog.UpdatePost:output_type -> v1.UpdatePostResponse
+	84,  // 84: v1.MiniBlog.DeletePost:output_type -> v1.DeletePostResponse
+	85,  // 85: v1.MiniBlog.GetPost:output_type -> v1.GetPostResponse
+	86,  // 86: v1.MiniBlog.ListPost:output_type -> v1.ListPostResponse
+	87,  // 87: v1.MiniBlog.AddReaction:output_type -> v1.AddReactionResponse
+	88,  // 88: v1.MiniBlog.RemoveReaction:output_type -> v1.RemoveReactionResponse
+	89,  // 89: v1.MiniBlog.ListReactors:output_type -> v1.ListReactorsResponse
+	90,  // 90: v1.MiniBlog.AddBookmark:output_type -> v1.AddBookmarkResponse
+	91,  // 91: v1.MiniBlog.RemoveBookmark:output_type -> v1.RemoveBookmarkResponse
+	92,  // 92: v1.MiniBlog.ListBookmark:output_type -> v1.ListBookmarkResponse
+	93,  // 93: v1.MiniBlog.ReorderBookmarks:output_type -> v1.ReorderBookmarksResponse
+	94,  // 94: v1.MiniBlog.ListReadingList:output_type -> v1.ListReadingListResponse
+	95,  // 95: v1.MiniBlog.ListMentions:output_type -> v1.ListMentionsResponse
+	96,  // 96: v1.MiniBlog.ListRelatedPosts:output_type -> v1.ListRelatedPostsResponse
+	97,  // 97: v1.MiniBlog.PinPost:output_type -> v1.PinPostResponse
+	98,  // 98: v1.MiniBlog.UnpinPost:output_type -> v1.UnpinPostResponse
+	99,  // 99: v1.MiniBlog.FeaturePost:output_type -> v1.FeaturePostResponse
+	100, // 100: v1.MiniBlog.UnfeaturePost:output_type -> v1.UnfeaturePostResponse
+	101, // 101: v1.MiniBlog.ListPostActivity:output_type -> v1.ListPostActivityResponse
+	102, // 102: v1.MiniBlog.ListTopAuthors:output_type -> v1.ListTopAuthorsResponse
+	103, // 103: v1.MiniBlog.ListSiteStats:output_type -> v1.ListSiteStatsResponse
+	104, // 104: v1.MiniBlog.GetMyPostStats:output_type -> v1.GetMyPostStatsResponse
+	105, // 105: v1.MiniBlog.CreatePostTranslation:output_type -> v1.CreatePostTranslationResponse
+	106, // 106: v1.MiniBlog.UpdatePostTranslation:output_type -> v1.UpdatePostTranslationResponse
+	107, // 107: v1.MiniBlog.DeletePostTranslation:output_type -> v1.DeletePostTranslationResponse
+	108, // 108: v1.MiniBlog.CreatePostTemplate:output_type -> v1.CreatePostTemplateResponse
+	109, // 109: v1.MiniBlog.UpdatePostTemplate:output_type -> v1.UpdatePostTemplateResponse
+	110, // 110: v1.MiniBlog.DeletePostTemplate:output_type -> v1.DeletePostTemplateResponse
+	111, // 111: v1.MiniBlog.GetPostTemplate:output_type -> v1.GetPostTemplateResponse
+	112, // 112: v1.MiniBlog.ListPostTemplate:output_type -> v1.ListPostTemplateResponse
+	113, // 113: v1.MiniBlog.CreatePostFromTemplate:output_type -> v1.CreatePostFromTemplateResponse
+	114, // 114: v1.MiniBlog.CreatePreviewLink:output_type -> v1.CreatePreviewLinkResponse
+	115, // 115: v1.MiniBlog.ListPreviewLinks:output_type -> v1.ListPreviewLinksResponse
+	116, // 116: v1.MiniBlog.RevokePreviewLink:output_type -> v1.RevokePreviewLinkResponse
+	117, // 117: v1.MiniBlog.GetPreview:output_type -> v1.GetPreviewResponse
+	118, // 118: v1.MiniBlog.SendVerificationEmail:output_type -> v1.SendVerificationEmailResponse
+	119, // 119: v1.MiniBlog.VerifyEmail:output_type -> v1.VerifyEmailResponse
+	120, // 120: v1.MiniBlog.RequestPasswordReset:output_type -> v1.RequestPasswordResetResponse
+	121, // 121: v1.MiniBlog.ResetPassword:output_type -> v1.ResetPasswordResponse
+	122, // 122: v1.MiniBlog.UnlockUser:output_type -> v1.UnlockUserResponse
+	123, // 123: v1.MiniBlog.EnrollTwoFactor:output_type -> v1.EnrollTwoFactorResponse
+	124, // 124: v1.MiniBlog.ConfirmTwoFactor:output_type -> v1.ConfirmTwoFactorResponse
+	125, // 125: v1.MiniBlog.VerifyTwoFactor:output_type -> v1.VerifyTwoFactorResponse
+	126, // 126: v1.MiniBlog.ResetTwoFactor:output_type -> v1.ResetTwoFactorResponse
+	127, // 127: v1.MiniBlog.ListSessions:output_type -> v1.ListSessionsResponse
+	128, // 128: v1.MiniBlog.RevokeSession:output_type -> v1.RevokeSessionResponse
+	129, // 129: v1.MiniBlog.RevokeAllOtherSessions:output_type -> v1.RevokeAllOtherSessionsResponse
+	130, // 130: v1.MiniBlog.CreateAccessToken:output_type -> v1.CreateAccessTokenResponse
+	131, // 131: v1.MiniBlog.ListAccessTokens:output_type -> v1.ListAccessTokensResponse
+	132, // 132: v1.MiniBlog.RevokeAccessToken:output_type -> v1.RevokeAccessTokenResponse
+	133, // 133: v1.MiniBlog.StartOIDCLogin:output_type -> v1.StartOIDCLoginResponse
+	134, // 134: v1.MiniBlog.CompleteOIDCLogin:output_type -> v1.CompleteOIDCLoginResponse
+	135, // 135: v1.MiniBlog.ListPolicies:output_type -> v1.ListPoliciesResponse
+	136, // 136: v1.MiniBlog.CreatePolicy:output_type -> v1.CreatePolicyResponse
+	137, // 137: v1.MiniBlog.DeletePolicy:output_type -> v1.DeletePolicyResponse
+	138, // 138: v1.MiniBlog.ReplacePolicies:output_type -> v1.ReplacePoliciesResponse
+	139, // 139: v1.MiniBlog.ListRoleAssignments:output_type -> v1.ListRoleAssignmentsResponse
+	140, // 140: v1.MiniBlog.AssignRole:output_type -> v1.AssignRoleResponse
+	141, // 141: v1.MiniBlog.UnassignRole:output_type -> v1.UnassignRoleResponse
+	142, // 142: v1.MiniBlog.ListUserRoles:output_type -> v1.ListUserRolesResponse
+	143, // 143: v1.MiniBlog.ListRoleUsers:output_type -> v1.ListRoleUsersResponse
+	72,  // [72:144] is the sub-list for method output_type
+	0,   // [0:72] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_apiserver_v1_apiserver_proto_init() }
@@ -252,7 +1115,17 @@ func file_apiserver_v1_apiserver_proto_init() {
 		return
 	}
 	file_apiserver_v1_healthz_proto_init()
+	file_apiserver_v1_access_token_proto_init()
+	file_apiserver_v1_analytics_proto_init()
+	file_apiserver_v1_bookmark_proto_init()
+	file_apiserver_v1_oidc_proto_init()
 	file_apiserver_v1_post_proto_init()
+	file_apiserver_v1_policy_proto_init()
+	file_apiserver_v1_preview_proto_init()
+	file_apiserver_v1_reaction_proto_init()
+	file_apiserver_v1_template_proto_init()
+	file_apiserver_v1_session_proto_init()
+	file_apiserver_v1_twofactor_proto_init()
 	file_apiserver_v1_user_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -27,20 +27,78 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MiniBlog_Healthz_FullMethodName        = "/v1.MiniBlog/Healthz"
-	MiniBlog_Login_FullMethodName          = "/v1.MiniBlog/Login"
-	MiniBlog_RefreshToken_FullMethodName   = "/v1.MiniBlog/RefreshToken"
-	MiniBlog_ChangePassword_FullMethodName = "/v1.MiniBlog/ChangePassword"
-	MiniBlog_CreateUser_FullMethodName     = "/v1.MiniBlog/CreateUser"
-	MiniBlog_UpdateUser_FullMethodName     = "/v1.MiniBlog/UpdateUser"
-	MiniBlog_DeleteUser_FullMethodName     = "/v1.MiniBlog/DeleteUser"
-	MiniBlog_GetUser_FullMethodName        = "/v1.MiniBlog/GetUser"
-	MiniBlog_ListUser_FullMethodName       = "/v1.MiniBlog/ListUser"
-	MiniBlog_CreatePost_FullMethodName     = "/v1.MiniBlog/CreatePost"
-	MiniBlog_UpdatePost_FullMethodName     = "/v1.MiniBlog/UpdatePost"
-	MiniBlog_DeletePost_FullMethodName     = "/v1.MiniBlog/DeletePost"
-	MiniBlog_GetPost_FullMethodName        = "/v1.MiniBlog/GetPost"
-	MiniBlog_ListPost_FullMethodName       = "/v1.MiniBlog/ListPost"
+	MiniBlog_Healthz_FullMethodName                = "/v1.MiniBlog/Healthz"
+	MiniBlog_Login_FullMethodName                  = "/v1.MiniBlog/Login"
+	MiniBlog_RefreshToken_FullMethodName           = "/v1.MiniBlog/RefreshToken"
+	MiniBlog_Logout_FullMethodName                 = "/v1.MiniBlog/Logout"
+	MiniBlog_ChangePassword_FullMethodName         = "/v1.MiniBlog/ChangePassword"
+	MiniBlog_CreateUser_FullMethodName             = "/v1.MiniBlog/CreateUser"
+	MiniBlog_UpdateUser_FullMethodName             = "/v1.MiniBlog/UpdateUser"
+	MiniBlog_DeleteUser_FullMethodName             = "/v1.MiniBlog/DeleteUser"
+	MiniBlog_GetUser_FullMethodName                = "/v1.MiniBlog/GetUser"
+	MiniBlog_ListUser_FullMethodName               = "/v1.MiniBlog/ListUser"
+	MiniBlog_CreatePost_FullMethodName             = "/v1.MiniBlog/CreatePost"
+	MiniBlog_UpdatePost_FullMethodName             = "/v1.MiniBlog/UpdatePost"
+	MiniBlog_DeletePost_FullMethodName             = "/v1.MiniBlog/DeletePost"
+	MiniBlog_GetPost_FullMethodName                = "/v1.MiniBlog/GetPost"
+	MiniBlog_ListPost_FullMethodName               = "/v1.MiniBlog/ListPost"
+	MiniBlog_AddReaction_FullMethodName            = "/v1.MiniBlog/AddReaction"
+	MiniBlog_RemoveReaction_FullMethodName         = "/v1.MiniBlog/RemoveReaction"
+	MiniBlog_ListReactors_FullMethodName           = "/v1.MiniBlog/ListReactors"
+	MiniBlog_AddBookmark_FullMethodName            = "/v1.MiniBlog/AddBookmark"
+	MiniBlog_RemoveBookmark_FullMethodName         = "/v1.MiniBlog/RemoveBookmark"
+	MiniBlog_ListBookmark_FullMethodName           = "/v1.MiniBlog/ListBookmark"
+	MiniBlog_ReorderBookmarks_FullMethodName       = "/v1.MiniBlog/ReorderBookmarks"
+	MiniBlog_ListReadingList_FullMethodName        = "/v1.MiniBlog/ListReadingList"
+	MiniBlog_ListMentions_FullMethodName           = "/v1.MiniBlog/ListMentions"
+	MiniBlog_ListRelatedPosts_FullMethodName       = "/v1.MiniBlog/ListRelatedPosts"
+	MiniBlog_PinPost_FullMethodName                = "/v1.MiniBlog/PinPost"
+	MiniBlog_UnpinPost_FullMethodName              = "/v1.MiniBlog/UnpinPost"
+	MiniBlog_FeaturePost_FullMethodName            = "/v1.MiniBlog/FeaturePost"
+	MiniBlog_UnfeaturePost_FullMethodName          = "/v1.MiniBlog/UnfeaturePost"
+	MiniBlog_ListPostActivity_FullMethodName       = "/v1.MiniBlog/ListPostActivity"
+	MiniBlog_ListTopAuthors_FullMethodName         = "/v1.MiniBlog/ListTopAuthors"
+	MiniBlog_ListSiteStats_FullMethodName          = "/v1.MiniBlog/ListSiteStats"
+	MiniBlog_GetMyPostStats_FullMethodName         = "/v1.MiniBlog/GetMyPostStats"
+	MiniBlog_CreatePostTranslation_FullMethodName  = "/v1.MiniBlog/CreatePostTranslation"
+	MiniBlog_UpdatePostTranslation_FullMethodName  = "/v1.MiniBlog/UpdatePostTranslation"
+	MiniBlog_DeletePostTranslation_FullMethodName  = "/v1.MiniBlog/DeletePostTranslation"
+	MiniBlog_CreatePostTemplate_FullMethodName     = "/v1.MiniBlog/CreatePostTemplate"
+	MiniBlog_UpdatePostTemplate_FullMethodName     = "/v1.MiniBlog/UpdatePostTemplate"
+	MiniBlog_DeletePostTemplate_FullMethodName     = "/v1.MiniBlog/DeletePostTemplate"
+	MiniBlog_GetPostTemplate_FullMethodName        = "/v1.MiniBlog/GetPostTemplate"
+	MiniBlog_ListPostTemplate_FullMethodName       = "/v1.MiniBlog/ListPostTemplate"
+	MiniBlog_CreatePostFromTemplate_FullMethodName = "/v1.MiniBlog/CreatePostFromTemplate"
+	MiniBlog_CreatePreviewLink_FullMethodName      = "/v1.MiniBlog/CreatePreviewLink"
+	MiniBlog_ListPreviewLinks_FullMethodName       = "/v1.MiniBlog/ListPreviewLinks"
+	MiniBlog_RevokePreviewLink_FullMethodName      = "/v1.MiniBlog/RevokePreviewLink"
+	MiniBlog_GetPreview_FullMethodName             = "/v1.MiniBlog/GetPreview"
+	MiniBlog_SendVerificationEmail_FullMethodName  = "/v1.MiniBlog/SendVerificationEmail"
+	MiniBlog_VerifyEmail_FullMethodName            = "/v1.MiniBlog/VerifyEmail"
+	MiniBlog_RequestPasswordReset_FullMethodName   = "/v1.MiniBlog/RequestPasswordReset"
+	MiniBlog_ResetPassword_FullMethodName          = "/v1.MiniBlog/ResetPassword"
+	MiniBlog_UnlockUser_FullMethodName             = "/v1.MiniBlog/UnlockUser"
+	MiniBlog_EnrollTwoFactor_FullMethodName        = "/v1.MiniBlog/EnrollTwoFactor"
+	MiniBlog_ConfirmTwoFactor_FullMethodName       = "/v1.MiniBlog/ConfirmTwoFactor"
+	MiniBlog_VerifyTwoFactor_FullMethodName        = "/v1.MiniBlog/VerifyTwoFactor"
+	MiniBlog_ResetTwoFactor_FullMethodName         = "/v1.MiniBlog/ResetTwoFactor"
+	MiniBlog_ListSessions_FullMethodName           = "/v1.MiniBlog/ListSessions"
+	MiniBlog_RevokeSession_FullMethodName          = "/v1.MiniBlog/RevokeSession"
+	MiniBlog_RevokeAllOtherSessions_FullMethodName = "/v1.MiniBlog/RevokeAllOtherSessions"
+	MiniBlog_CreateAccessToken_FullMethodName      = "/v1.MiniBlog/CreateAccessToken"
+	MiniBlog_ListAccessTokens_FullMethodName       = "/v1.MiniBlog/ListAccessTokens"
+	MiniBlog_RevokeAccessToken_FullMethodName      = "/v1.MiniBlog/RevokeAccessToken"
+	MiniBlog_StartOIDCLogin_FullMethodName         = "/v1.MiniBlog/StartOIDCLogin"
+	MiniBlog_CompleteOIDCLogin_FullMethodName      = "/v1.MiniBlog/CompleteOIDCLogin"
+	MiniBlog_ListPolicies_FullMethodName           = "/v1.MiniBlog/ListPolicies"
+	MiniBlog_CreatePolicy_FullMethodName           = "/v1.MiniBlog/CreatePolicy"
+	MiniBlog_DeletePolicy_FullMethodName           = "/v1.MiniBlog/DeletePolicy"
+	MiniBlog_ReplacePolicies_FullMethodName        = "/v1.MiniBlog/ReplacePolicies"
+	MiniBlog_ListRoleAssignments_FullMethodName    = "/v1.MiniBlog/ListRoleAssignments"
+	MiniBlog_AssignRole_FullMethodName             = "/v1.MiniBlog/AssignRole"
+	MiniBlog_UnassignRole_FullMethodName           = "/v1.MiniBlog/UnassignRole"
+	MiniBlog_ListUserRoles_FullMethodName          = "/v1.MiniBlog/ListUserRoles"
+	MiniBlog_ListRoleUsers_FullMethodName          = "/v1.MiniBlog/ListRoleUsers"
 )
 
 // MiniBlogClient is the client API for MiniBlog service.
@@ -55,6 +113,8 @@ type MiniBlogClient interface {
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 	// RefreshToken 刷新令牌
 	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
+	// Logout 退出登录
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
 	// ChangePassword 修改密码
 	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
 	// CreateUser 创建用户
@@ -77,6 +137,120 @@ type MiniBlogClient interface {
 	GetPost(ctx context.Context, in *GetPostRequest, opts ...grpc.CallOption) (*GetPostResponse, error)
 	// ListPost 列出所有文章
 	ListPost(ctx context.Context, in *ListPostRequest, opts ...grpc.CallOption) (*ListPostResponse, error)
+	// AddReaction 对文章添加反应，重复添加同一反应不会产生副作用
+	AddReaction(ctx context.Context, in *AddReactionRequest, opts ...grpc.CallOption) (*AddReactionResponse, error)
+	// RemoveReaction 移除对文章的反应，移除不存在的反应不会产生副作用
+	RemoveReaction(ctx context.Context, in *RemoveReactionRequest, opts ...grpc.CallOption) (*RemoveReactionResponse, error)
+	// ListReactors 列出对文章做出反应的用户
+	ListReactors(ctx context.Context, in *ListReactorsRequest, opts ...grpc.CallOption) (*ListReactorsResponse, error)
+	// AddBookmark 收藏文章，文章已收藏时会移动到指定的阅读列表
+	AddBookmark(ctx context.Context, in *AddBookmarkRequest, opts ...grpc.CallOption) (*AddBookmarkResponse, error)
+	// RemoveBookmark 取消收藏文章
+	RemoveBookmark(ctx context.Context, in *RemoveBookmarkRequest, opts ...grpc.CallOption) (*RemoveBookmarkResponse, error)
+	// ListBookmark 列出当前用户的书签
+	ListBookmark(ctx context.Context, in *ListBookmarkRequest, opts ...grpc.CallOption) (*ListBookmarkResponse, error)
+	// ReorderBookmarks 调整阅读列表中书签的顺序
+	ReorderBookmarks(ctx context.Context, in *ReorderBookmarksRequest, opts ...grpc.CallOption) (*ReorderBookmarksResponse, error)
+	// ListReadingList 列出当前用户的阅读列表
+	ListReadingList(ctx context.Context, in *ListReadingListRequest, opts ...grpc.CallOption) (*ListReadingListResponse, error)
+	// ListMentions 列出提及当前用户的文章
+	ListMentions(ctx context.Context, in *ListMentionsRequest, opts ...grpc.CallOption) (*ListMentionsResponse, error)
+	// ListRelatedPosts 列出和指定文章相关的文章
+	ListRelatedPosts(ctx context.Context, in *ListRelatedPostsRequest, opts ...grpc.CallOption) (*ListRelatedPostsResponse, error)
+	// PinPost 置顶文章，已置顶的文章会移动到指定位置
+	PinPost(ctx context.Context, in *PinPostRequest, opts ...grpc.CallOption) (*PinPostResponse, error)
+	// UnpinPost 取消置顶文章
+	UnpinPost(ctx context.Context, in *UnpinPostRequest, opts ...grpc.CallOption) (*UnpinPostResponse, error)
+	// FeaturePost 将文章设为精选，仅管理员可用
+	FeaturePost(ctx context.Context, in *FeaturePostRequest, opts ...grpc.CallOption) (*FeaturePostResponse, error)
+	// UnfeaturePost 取消精选文章，仅管理员可用
+	UnfeaturePost(ctx context.Context, in *UnfeaturePostRequest, opts ...grpc.CallOption) (*UnfeaturePostResponse, error)
+	// ListPostActivity 获取用户每天或每周创建的博客数量，仅管理员可用
+	ListPostActivity(ctx context.Context, in *ListPostActivityRequest, opts ...grpc.CallOption) (*ListPostActivityResponse, error)
+	// ListTopAuthors 获取一段时间内创建博客最多的作者，仅管理员可用
+	ListTopAuthors(ctx context.Context, in *ListTopAuthorsRequest, opts ...grpc.CallOption) (*ListTopAuthorsResponse, error)
+	// ListSiteStats 获取全站用户和博客数量随时间的变化，仅管理员可用
+	ListSiteStats(ctx context.Context, in *ListSiteStatsRequest, opts ...grpc.CallOption) (*ListSiteStatsResponse, error)
+	// GetMyPostStats 获取当前用户的博客统计数据
+	GetMyPostStats(ctx context.Context, in *GetMyPostStatsRequest, opts ...grpc.CallOption) (*GetMyPostStatsResponse, error)
+	// CreatePostTranslation 为文章添加其他语言的译文
+	CreatePostTranslation(ctx context.Context, in *CreatePostTranslationRequest, opts ...grpc.CallOption) (*CreatePostTranslationResponse, error)
+	// UpdatePostTranslation 更新文章的译文
+	UpdatePostTranslation(ctx context.Context, in *UpdatePostTranslationRequest, opts ...grpc.CallOption) (*UpdatePostTranslationResponse, error)
+	// DeletePostTranslation 删除文章的译文
+	DeletePostTranslation(ctx context.Context, in *DeletePostTranslationRequest, opts ...grpc.CallOption) (*DeletePostTranslationResponse, error)
+	// CreatePostTemplate 创建博客模板
+	CreatePostTemplate(ctx context.Context, in *CreatePostTemplateRequest, opts ...grpc.CallOption) (*CreatePostTemplateResponse, error)
+	// UpdatePostTemplate 更新博客模板
+	UpdatePostTemplate(ctx context.Context, in *UpdatePostTemplateRequest, opts ...grpc.CallOption) (*UpdatePostTemplateResponse, error)
+	// DeletePostTemplate 删除博客模板
+	DeletePostTemplate(ctx context.Context, in *DeletePostTemplateRequest, opts ...grpc.CallOption) (*DeletePostTemplateResponse, error)
+	// GetPostTemplate 获取博客模板
+	GetPostTemplate(ctx context.Context, in *GetPostTemplateRequest, opts ...grpc.CallOption) (*GetPostTemplateResponse, error)
+	// ListPostTemplate 列出当前用户的博客模板
+	ListPostTemplate(ctx context.Context, in *ListPostTemplateRequest, opts ...grpc.CallOption) (*ListPostTemplateResponse, error)
+	// CreatePostFromTemplate 渲染模板中的占位符，并使用渲染结果创建文章
+	CreatePostFromTemplate(ctx context.Context, in *CreatePostFromTemplateRequest, opts ...grpc.CallOption) (*CreatePostFromTemplateResponse, error)
+	// CreatePreviewLink 为博客创建带签名的预览链接
+	CreatePreviewLink(ctx context.Context, in *CreatePreviewLinkRequest, opts ...grpc.CallOption) (*CreatePreviewLinkResponse, error)
+	// ListPreviewLinks 列出博客未过期的预览链接
+	ListPreviewLinks(ctx context.Context, in *ListPreviewLinksRequest, opts ...grpc.CallOption) (*ListPreviewLinksResponse, error)
+	// RevokePreviewLink 撤销博客的预览链接
+	RevokePreviewLink(ctx context.Context, in *RevokePreviewLinkRequest, opts ...grpc.CallOption) (*RevokePreviewLinkResponse, error)
+	// GetPreview 通过预览链接查看博客，不需要认证
+	GetPreview(ctx context.Context, in *GetPreviewRequest, opts ...grpc.CallOption) (*GetPreviewResponse, error)
+	// SendVerificationEmail 向当前用户的电子邮箱重新发送验证邮件
+	SendVerificationEmail(ctx context.Context, in *SendVerificationEmailRequest, opts ...grpc.CallOption) (*SendVerificationEmailResponse, error)
+	// VerifyEmail 使用验证邮件中的一次性令牌验证电子邮箱，不需要认证
+	VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error)
+	// RequestPasswordReset 向电子邮箱发送重置密码邮件，不需要认证。为避免泄露账号是否存在，响应总是成功
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error)
+	// ResetPassword 使用重置密码邮件中的一次性令牌设置新密码，不需要认证
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error)
+	// UnlockUser 清除用户的登录失败记录，解除登录锁定（仅管理员）
+	UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...grpc.CallOption) (*UnlockUserResponse, error)
+	// EnrollTwoFactor 为当前用户生成新的 TOTP 密钥，返回验证器应用的配置 URI
+	EnrollTwoFactor(ctx context.Context, in *EnrollTwoFactorRequest, opts ...grpc.CallOption) (*EnrollTwoFactorResponse, error)
+	// ConfirmTwoFactor 使用验证码确认并启用两步验证，返回一次性恢复码
+	ConfirmTwoFactor(ctx context.Context, in *ConfirmTwoFactorRequest, opts ...grpc.CallOption) (*ConfirmTwoFactorResponse, error)
+	// VerifyTwoFactor 使用 Login 返回的挑战令牌和验证码完成登录，不需要认证
+	VerifyTwoFactor(ctx context.Context, in *VerifyTwoFactorRequest, opts ...grpc.CallOption) (*VerifyTwoFactorResponse, error)
+	// ResetTwoFactor 关闭用户的两步验证并删除恢复码（仅管理员）
+	ResetTwoFactor(ctx context.Context, in *ResetTwoFactorRequest, opts ...grpc.CallOption) (*ResetTwoFactorResponse, error)
+	// ListSessions 列出当前用户的登录会话
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	// RevokeSession 撤销当前用户的一个登录会话，该会话签发的令牌立即失效
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
+	// RevokeAllOtherSessions 撤销当前用户除当前会话以外的所有登录会话
+	RevokeAllOtherSessions(ctx context.Context, in *RevokeAllOtherSessionsRequest, opts ...grpc.CallOption) (*RevokeAllOtherSessionsResponse, error)
+	// CreateAccessToken 为当前用户创建个人访问令牌
+	CreateAccessToken(ctx context.Context, in *CreateAccessTokenRequest, opts ...grpc.CallOption) (*CreateAccessTokenResponse, error)
+	// ListAccessTokens 列出当前用户的个人访问令牌
+	ListAccessTokens(ctx context.Context, in *ListAccessTokensRequest, opts ...grpc.CallOption) (*ListAccessTokensResponse, error)
+	// RevokeAccessToken 撤销当前用户的个人访问令牌，令牌立即失效
+	RevokeAccessToken(ctx context.Context, in *RevokeAccessTokenRequest, opts ...grpc.CallOption) (*RevokeAccessTokenResponse, error)
+	// StartOIDCLogin 生成身份提供方的授权地址，发起单点登录，不需要认证
+	StartOIDCLogin(ctx context.Context, in *StartOIDCLoginRequest, opts ...grpc.CallOption) (*StartOIDCLoginResponse, error)
+	// CompleteOIDCLogin 使用身份提供方返回的授权码完成单点登录，不需要认证
+	CompleteOIDCLogin(ctx context.Context, in *CompleteOIDCLoginRequest, opts ...grpc.CallOption) (*CompleteOIDCLoginResponse, error)
+	// ListPolicies 列出授权策略，可以按角色或者用户过滤（仅管理员）
+	ListPolicies(ctx context.Context, in *ListPoliciesRequest, opts ...grpc.CallOption) (*ListPoliciesResponse, error)
+	// CreatePolicy 添加一条授权策略（仅管理员）
+	CreatePolicy(ctx context.Context, in *CreatePolicyRequest, opts ...grpc.CallOption) (*CreatePolicyResponse, error)
+	// DeletePolicy 删除一条授权策略（仅管理员）
+	DeletePolicy(ctx context.Context, in *DeletePolicyRequest, opts ...grpc.CallOption) (*DeletePolicyResponse, error)
+	// ReplacePolicies 在一个事务中将所有授权策略替换为请求中的策略（仅管理员）
+	ReplacePolicies(ctx context.Context, in *ReplacePoliciesRequest, opts ...grpc.CallOption) (*ReplacePoliciesResponse, error)
+	// ListRoleAssignments 列出所有用户的角色分配（仅管理员）
+	ListRoleAssignments(ctx context.Context, in *ListRoleAssignmentsRequest, opts ...grpc.CallOption) (*ListRoleAssignmentsResponse, error)
+	// AssignRole 为用户分配角色（仅管理员）
+	AssignRole(ctx context.Context, in *AssignRoleRequest, opts ...grpc.CallOption) (*AssignRoleResponse, error)
+	// UnassignRole 移除用户的角色（仅管理员）
+	UnassignRole(ctx context.Context, in *UnassignRoleRequest, opts ...grpc.CallOption) (*UnassignRoleResponse, error)
+	// ListUserRoles 列出用户拥有的角色（仅管理员）
+	ListUserRoles(ctx context.Context, in *ListUserRolesRequest, opts ...grpc.CallOption) (*ListUserRolesResponse, error)
+	// ListRoleUsers 列出拥有某个角色的用户（仅管理员）
+	ListRoleUsers(ctx context.Context, in *ListRoleUsersRequest, opts ...grpc.CallOption) (*ListRoleUsersResponse, error)
 }
 
 type miniBlogClient struct {
@@ -117,6 +291,16 @@ func (c *miniBlogClient) RefreshToken(ctx context.Context, in *RefreshTokenReque
 	return out, nil
 }
 
+func (c *miniBlogClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogoutResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_Logout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *miniBlogClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ChangePasswordResponse)
@@ -227,361 +411,2265 @@ func (c *miniBlogClient) ListPost(ctx context.Context, in *ListPostRequest, opts
 	return out, nil
 }
 
-// MiniBlogServer is the server API for MiniBlog service.
-// All implementations must embed UnimplementedMiniBlogServer
-// for forward compatibility.
-//
-// MiniBlog 定义了一个 MiniBlog RPC 服务
-type MiniBlogServer interface {
-	// Healthz 健康检查
-	Healthz(context.Context, *emptypb.Empty) (*HealthzResponse, error)
-	// Login 用户登录
-	Login(context.Context, *LoginRequest) (*LoginResponse, error)
-	// RefreshToken 刷新令牌
-	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
-	// ChangePassword 修改密码
-	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
-	// CreateUser 创建用户
-	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
-	// UpdateUser 更新用户信息
-	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
-	// DeleteUser 删除用户
-	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
-	// GetUser 获取用户信息
-	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
-	// ListUser 列出所有用户
-	ListUser(context.Context, *ListUserRequest) (*ListUserResponse, error)
-	// CreatePost 创建文章
-	CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error)
-	// UpdatePost 更新文章
-	UpdatePost(context.Context, *UpdatePostRequest) (*UpdatePostResponse, error)
-	// DeletePost 删除文章
-	DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error)
-	// GetPost 获取文章信息
-	GetPost(context.Context, *GetPostRequest) (*GetPostResponse, error)
-	// ListPost 列出所有文章
-	ListPost(context.Context, *ListPostRequest) (*ListPostResponse, error)
-	mustEmbedUnimplementedMiniBlogServer()
+func (c *miniBlogClient) AddReaction(ctx context.Context, in *AddReactionRequest, opts ...grpc.CallOption) (*AddReactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddReactionResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_AddReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedMiniBlogServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedMiniBlogServer struct{}
+func (c *miniBlogClient) RemoveReaction(ctx context.Context, in *RemoveReactionRequest, opts ...grpc.CallOption) (*RemoveReactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveReactionResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_RemoveReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedMiniBlogServer) Healthz(context.Context, *emptypb.Empty) (*HealthzResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Healthz not implemented")
+func (c *miniBlogClient) ListReactors(ctx context.Context, in *ListReactorsRequest, opts ...grpc.CallOption) (*ListReactorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReactorsResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ListReactors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMiniBlogServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+
+func (c *miniBlogClient) AddBookmark(ctx context.Context, in *AddBookmarkRequest, opts ...grpc.CallOption) (*AddBookmarkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddBookmarkResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_AddBookmark_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMiniBlogServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+
+func (c *miniBlogClient) RemoveBookmark(ctx context.Context, in *RemoveBookmarkRequest, opts ...grpc.CallOption) (*RemoveBookmarkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveBookmarkResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_RemoveBookmark_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMiniBlogServer) ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+
+func (c *miniBlogClient) ListBookmark(ctx context.Context, in *ListBookmarkRequest, opts ...grpc.CallOption) (*ListBookmarkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBookmarkResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ListBookmark_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMiniBlogServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+
+func (c *miniBlogClient) ReorderBookmarks(ctx context.Context, in *ReorderBookmarksRequest, opts ...grpc.CallOption) (*ReorderBookmarksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderBookmarksResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ReorderBookmarks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMiniBlogServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+
+func (c *miniBlogClient) ListReadingList(ctx context.Context, in *ListReadingListRequest, opts ...grpc.CallOption) (*ListReadingListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReadingListResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ListReadingList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMiniBlogServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+
+func (c *miniBlogClient) ListMentions(ctx context.Context, in *ListMentionsRequest, opts ...grpc.CallOption) (*ListMentionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMentionsResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ListMentions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMiniBlogServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+
+func (c *miniBlogClient) ListRelatedPosts(ctx context.Context, in *ListRelatedPostsRequest, opts ...grpc.CallOption) (*ListRelatedPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRelatedPostsResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ListRelatedPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMiniBlogServer) ListUser(context.Context, *ListUserRequest) (*ListUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListUser not implemented")
+
+func (c *miniBlogClient) PinPost(ctx context.Context, in *PinPostRequest, opts ...grpc.CallOption) (*PinPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PinPostResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_PinPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMiniBlogServer) CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePost not implemented")
+
+func (c *miniBlogClient) UnpinPost(ctx context.Context, in *UnpinPostRequest, opts ...grpc.CallOption) (*UnpinPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnpinPostResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_UnpinPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMiniBlogServer) UpdatePost(context.Context, *UpdatePostRequest) (*UpdatePostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdatePost not implemented")
+
+func (c *miniBlogClient) FeaturePost(ctx context.Context, in *FeaturePostRequest, opts ...grpc.CallOption) (*FeaturePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeaturePostResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_FeaturePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMiniBlogServer) DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeletePost not implemented")
+
+func (c *miniBlogClient) UnfeaturePost(ctx context.Context, in *UnfeaturePostRequest, opts ...grpc.CallOption) (*UnfeaturePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnfeaturePostResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_UnfeaturePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) ListPostActivity(ctx context.Context, in *ListPostActivityRequest, opts ...grpc.CallOption) (*ListPostActivityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPostActivityResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ListPostActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) ListTopAuthors(ctx context.Context, in *ListTopAuthorsRequest, opts ...grpc.CallOption) (*ListTopAuthorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTopAuthorsResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ListTopAuthors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) ListSiteStats(ctx context.Context, in *ListSiteStatsRequest, opts ...grpc.CallOption) (*ListSiteStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSiteStatsResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ListSiteStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) GetMyPostStats(ctx context.Context, in *GetMyPostStatsRequest, opts ...grpc.CallOption) (*GetMyPostStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMyPostStatsResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_GetMyPostStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) CreatePostTranslation(ctx context.Context, in *CreatePostTranslationRequest, opts ...grpc.CallOption) (*CreatePostTranslationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePostTranslationResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_CreatePostTranslation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) UpdatePostTranslation(ctx context.Context, in *UpdatePostTranslationRequest, opts ...grpc.CallOption) (*UpdatePostTranslationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePostTranslationResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_UpdatePostTranslation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) DeletePostTranslation(ctx context.Context, in *DeletePostTranslationRequest, opts ...grpc.CallOption) (*DeletePostTranslationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePostTranslationResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_DeletePostTranslation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) CreatePostTemplate(ctx context.Context, in *CreatePostTemplateRequest, opts ...grpc.CallOption) (*CreatePostTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePostTemplateResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_CreatePostTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) UpdatePostTemplate(ctx context.Context, in *UpdatePostTemplateRequest, opts ...grpc.CallOption) (*UpdatePostTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePostTemplateResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_UpdatePostTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) DeletePostTemplate(ctx context.Context, in *DeletePostTemplateRequest, opts ...grpc.CallOption) (*DeletePostTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePostTemplateResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_DeletePostTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) GetPostTemplate(ctx context.Context, in *GetPostTemplateRequest, opts ...grpc.CallOption) (*GetPostTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPostTemplateResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_GetPostTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) ListPostTemplate(ctx context.Context, in *ListPostTemplateRequest, opts ...grpc.CallOption) (*ListPostTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPostTemplateResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ListPostTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) CreatePostFromTemplate(ctx context.Context, in *CreatePostFromTemplateRequest, opts ...grpc.CallOption) (*CreatePostFromTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePostFromTemplateResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_CreatePostFromTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) CreatePreviewLink(ctx context.Context, in *CreatePreviewLinkRequest, opts ...grpc.CallOption) (*CreatePreviewLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePreviewLinkResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_CreatePreviewLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) ListPreviewLinks(ctx context.Context, in *ListPreviewLinksRequest, opts ...grpc.CallOption) (*ListPreviewLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPreviewLinksResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ListPreviewLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) RevokePreviewLink(ctx context.Context, in *RevokePreviewLinkRequest, opts ...grpc.CallOption) (*RevokePreviewLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokePreviewLinkResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_RevokePreviewLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) GetPreview(ctx context.Context, in *GetPreviewRequest, opts ...grpc.CallOption) (*GetPreviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPreviewResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_GetPreview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) SendVerificationEmail(ctx context.Context, in *SendVerificationEmailRequest, opts ...grpc.CallOption) (*SendVerificationEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendVerificationEmailResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_SendVerificationEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyEmailResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_VerifyEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestPasswordResetResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_RequestPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetPasswordResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ResetPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...grpc.CallOption) (*UnlockUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlockUserResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_UnlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) EnrollTwoFactor(ctx context.Context, in *EnrollTwoFactorRequest, opts ...grpc.CallOption) (*EnrollTwoFactorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnrollTwoFactorResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_EnrollTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) ConfirmTwoFactor(ctx context.Context, in *ConfirmTwoFactorRequest, opts ...grpc.CallOption) (*ConfirmTwoFactorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmTwoFactorResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ConfirmTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) VerifyTwoFactor(ctx context.Context, in *VerifyTwoFactorRequest, opts ...grpc.CallOption) (*VerifyTwoFactorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyTwoFactorResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_VerifyTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) ResetTwoFactor(ctx context.Context, in *ResetTwoFactorRequest, opts ...grpc.CallOption) (*ResetTwoFactorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetTwoFactorResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ResetTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) RevokeAllOtherSessions(ctx context.Context, in *RevokeAllOtherSessionsRequest, opts ...grpc.CallOption) (*RevokeAllOtherSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAllOtherSessionsResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_RevokeAllOtherSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) CreateAccessToken(ctx context.Context, in *CreateAccessTokenRequest, opts ...grpc.CallOption) (*CreateAccessTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAccessTokenResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_CreateAccessToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) ListAccessTokens(ctx context.Context, in *ListAccessTokensRequest, opts ...grpc.CallOption) (*ListAccessTokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccessTokensResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ListAccessTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) RevokeAccessToken(ctx context.Context, in *RevokeAccessTokenRequest, opts ...grpc.CallOption) (*RevokeAccessTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAccessTokenResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_RevokeAccessToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) StartOIDCLogin(ctx context.Context, in *StartOIDCLoginRequest, opts ...grpc.CallOption) (*StartOIDCLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartOIDCLoginResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_StartOIDCLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) CompleteOIDCLogin(ctx context.Context, in *CompleteOIDCLoginRequest, opts ...grpc.CallOption) (*CompleteOIDCLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteOIDCLoginResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_CompleteOIDCLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) ListPolicies(ctx context.Context, in *ListPoliciesRequest, opts ...grpc.CallOption) (*ListPoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPoliciesResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ListPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) CreatePolicy(ctx context.Context, in *CreatePolicyRequest, opts ...grpc.CallOption) (*CreatePolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePolicyResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_CreatePolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) DeletePolicy(ctx context.Context, in *DeletePolicyRequest, opts ...grpc.CallOption) (*DeletePolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePolicyResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_DeletePolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) ReplacePolicies(ctx context.Context, in *ReplacePoliciesRequest, opts ...grpc.CallOption) (*ReplacePoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplacePoliciesResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ReplacePolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) ListRoleAssignments(ctx context.Context, in *ListRoleAssignmentsRequest, opts ...grpc.CallOption) (*ListRoleAssignmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRoleAssignmentsResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ListRoleAssignments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) AssignRole(ctx context.Context, in *AssignRoleRequest, opts ...grpc.CallOption) (*AssignRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignRoleResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_AssignRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) UnassignRole(ctx context.Context, in *UnassignRoleRequest, opts ...grpc.CallOption) (*UnassignRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnassignRoleResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_UnassignRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) ListUserRoles(ctx context.Context, in *ListUserRolesRequest, opts ...grpc.CallOption) (*ListUserRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserRolesResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ListUserRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) ListRoleUsers(ctx context.Context, in *ListRoleUsersRequest, opts ...grpc.CallOption) (*ListRoleUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRoleUsersResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ListRoleUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MiniBlogServer is the server API for MiniBlog service.
+// All implementations must embed UnimplementedMiniBlogServer
+// for forward compatibility.
+//
+// MiniBlog 定义了一个 MiniBlog RPC 服务
+type MiniBlogServer interface {
+	// Healthz 健康检查
+	Healthz(context.Context, *emptypb.Empty) (*HealthzResponse, error)
+	// Login 用户登录
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	// RefreshToken 刷新令牌
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
+	// Logout 退出登录
+	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
+	// ChangePassword 修改密码
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+	// CreateUser 创建用户
+	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
+	// UpdateUser 更新用户信息
+	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
+	// DeleteUser 删除用户
+	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	// GetUser 获取用户信息
+	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
+	// ListUser 列出所有用户
+	ListUser(context.Context, *ListUserRequest) (*ListUserResponse, error)
+	// CreatePost 创建文章
+	CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error)
+	// UpdatePost 更新文章
+	UpdatePost(context.Context, *UpdatePostRequest) (*UpdatePostResponse, error)
+	// DeletePost 删除文章
+	DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error)
+	// GetPost 获取文章信息
+	GetPost(context.Context, *GetPostRequest) (*GetPostResponse, error)
+	// ListPost 列出所有文章
+	ListPost(context.Context, *ListPostRequest) (*ListPostResponse, error)
+	// AddReaction 对文章添加反应，重复添加同一反应不会产生副作用
+	AddReaction(context.Context, *AddReactionRequest) (*AddReactionResponse, error)
+	// RemoveReaction 移除对文章的反应，移除不存在的反应不会产生副作用
+	RemoveReaction(context.Context, *RemoveReactionRequest) (*RemoveReactionResponse, error)
+	// ListReactors 列出对文章做出反应的用户
+	ListReactors(context.Context, *ListReactorsRequest) (*ListReactorsResponse, error)
+	// AddBookmark 收藏文章，文章已收藏时会移动到指定的阅读列表
+	AddBookmark(context.Context, *AddBookmarkRequest) (*AddBookmarkResponse, error)
+	// RemoveBookmark 取消收藏文章
+	RemoveBookmark(context.Context, *RemoveBookmarkRequest) (*RemoveBookmarkResponse, error)
+	// ListBookmark 列出当前用户的书签
+	ListBookmark(context.Context, *ListBookmarkRequest) (*ListBookmarkResponse, error)
+	// ReorderBookmarks 调整阅读列表中书签的顺序
+	ReorderBookmarks(context.Context, *ReorderBookmarksRequest) (*ReorderBookmarksResponse, error)
+	// ListReadingList 列出当前用户的阅读列表
+	ListReadingList(context.Context, *ListReadingListRequest) (*ListReadingListResponse, error)
+	// ListMentions 列出提及当前用户的文章
+	ListMentions(context.Context, *ListMentionsRequest) (*ListMentionsResponse, error)
+	// ListRelatedPosts 列出和指定文章相关的文章
+	ListRelatedPosts(context.Context, *ListRelatedPostsRequest) (*ListRelatedPostsResponse, error)
+	// PinPost 置顶文章，已置顶的文章会移动到指定位置
+	PinPost(context.Context, *PinPostRequest) (*PinPostResponse, error)
+	// UnpinPost 取消置顶文章
+	UnpinPost(context.Context, *UnpinPostRequest) (*UnpinPostResponse, error)
+	// FeaturePost 将文章设为精选，仅管理员可用
+	FeaturePost(context.Context, *FeaturePostRequest) (*FeaturePostResponse, error)
+	// UnfeaturePost 取消精选文章，仅管理员可用
+	UnfeaturePost(context.Context, *UnfeaturePostRequest) (*UnfeaturePostResponse, error)
+	// ListPostActivity 获取用户每天或每周创建的博客数量，仅管理员可用
+	ListPostActivity(context.Context, *ListPostActivityRequest) (*ListPostActivityResponse, error)
+	// ListTopAuthors 获取一段时间内创建博客最多的作者，仅管理员可用
+	ListTopAuthors(context.Context, *ListTopAuthorsRequest) (*ListTopAuthorsResponse, error)
+	// ListSiteStats 获取全站用户和博客数量随时间的变化，仅管理员可用
+	ListSiteStats(context.Context, *ListSiteStatsRequest) (*ListSiteStatsResponse, error)
+	// GetMyPostStats 获取当前用户的博客统计数据
+	GetMyPostStats(context.Context, *GetMyPostStatsRequest) (*GetMyPostStatsResponse, error)
+	// CreatePostTranslation 为文章添加其他语言的译文
+	CreatePostTranslation(context.Context, *CreatePostTranslationRequest) (*CreatePostTranslationResponse, error)
+	// UpdatePostTranslation 更新文章的译文
+	UpdatePostTranslation(context.Context, *UpdatePostTranslationRequest) (*UpdatePostTranslationResponse, error)
+	// DeletePostTranslation 删除文章的译文
+	DeletePostTranslation(context.Context, *DeletePostTranslationRequest) (*DeletePostTranslationResponse, error)
+	// CreatePostTemplate 创建博客模板
+	CreatePostTemplate(context.Context, *CreatePostTemplateRequest) (*CreatePostTemplateResponse, error)
+	// UpdatePostTemplate 更新博客模板
+	UpdatePostTemplate(context.Context, *UpdatePostTemplateRequest) (*UpdatePostTemplateResponse, error)
+	// DeletePostTemplate 删除博客模板
+	DeletePostTemplate(context.Context, *DeletePostTemplateRequest) (*DeletePostTemplateResponse, error)
+	// GetPostTemplate 获取博客模板
+	GetPostTemplate(context.Context, *GetPostTemplateRequest) (*GetPostTemplateResponse, error)
+	// ListPostTemplate 列出当前用户的博客模板
+	ListPostTemplate(context.Context, *ListPostTemplateRequest) (*ListPostTemplateResponse, error)
+	// CreatePostFromTemplate 渲染模板中的占位符，并使用渲染结果创建文章
+	CreatePostFromTemplate(context.Context, *CreatePostFromTemplateRequest) (*CreatePostFromTemplateResponse, error)
+	// CreatePreviewLink 为博客创建带签名的预览链接
+	CreatePreviewLink(context.Context, *CreatePreviewLinkRequest) (*CreatePreviewLinkResponse, error)
+	// ListPreviewLinks 列出博客未过期的预览链接
+	ListPreviewLinks(context.Context, *ListPreviewLinksRequest) (*ListPreviewLinksResponse, error)
+	// RevokePreviewLink 撤销博客的预览链接
+	RevokePreviewLink(context.Context, *RevokePreviewLinkRequest) (*RevokePreviewLinkResponse, error)
+	// GetPreview 通过预览链接查看博客，不需要认证
+	GetPreview(context.Context, *GetPreviewRequest) (*GetPreviewResponse, error)
+	// SendVerificationEmail 向当前用户的电子邮箱重新发送验证邮件
+	SendVerificationEmail(context.Context, *SendVerificationEmailRequest) (*SendVerificationEmailResponse, error)
+	// VerifyEmail 使用验证邮件中的一次性令牌验证电子邮箱，不需要认证
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error)
+	// RequestPasswordReset 向电子邮箱发送重置密码邮件，不需要认证。为避免泄露账号是否存在，响应总是成功
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error)
+	// ResetPassword 使用重置密码邮件中的一次性令牌设置新密码，不需要认证
+	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error)
+	// UnlockUser 清除用户的登录失败记录，解除登录锁定（仅管理员）
+	UnlockUser(context.Context, *UnlockUserRequest) (*UnlockUserResponse, error)
+	// EnrollTwoFactor 为当前用户生成新的 TOTP 密钥，返回验证器应用的配置 URI
+	EnrollTwoFactor(context.Context, *EnrollTwoFactorRequest) (*EnrollTwoFactorResponse, error)
+	// ConfirmTwoFactor 使用验证码确认并启用两步验证，返回一次性恢复码
+	ConfirmTwoFactor(context.Context, *ConfirmTwoFactorRequest) (*ConfirmTwoFactorResponse, error)
+	// VerifyTwoFactor 使用 Login 返回的挑战令牌和验证码完成登录，不需要认证
+	VerifyTwoFactor(context.Context, *VerifyTwoFactorRequest) (*VerifyTwoFactorResponse, error)
+	// ResetTwoFactor 关闭用户的两步验证并删除恢复码（仅管理员）
+	ResetTwoFactor(context.Context, *ResetTwoFactorRequest) (*ResetTwoFactorResponse, error)
+	// ListSessions 列出当前用户的登录会话
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	// RevokeSession 撤销当前用户的一个登录会话，该会话签发的令牌立即失效
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	// RevokeAllOtherSessions 撤销当前用户除当前会话以外的所有登录会话
+	RevokeAllOtherSessions(context.Context, *RevokeAllOtherSessionsRequest) (*RevokeAllOtherSessionsResponse, error)
+	// CreateAccessToken 为当前用户创建个人访问令牌
+	CreateAccessToken(context.Context, *CreateAccessTokenRequest) (*CreateAccessTokenResponse, error)
+	// ListAccessTokens 列出当前用户的个人访问令牌
+	ListAccessTokens(context.Context, *ListAccessTokensRequest) (*ListAccessTokensResponse, error)
+	// RevokeAccessToken 撤销当前用户的个人访问令牌，令牌立即失效
+	RevokeAccessToken(context.Context, *RevokeAccessTokenRequest) (*RevokeAccessTokenResponse, error)
+	// StartOIDCLogin 生成身份提供方的授权地址，发起单点登录，不需要认证
+	StartOIDCLogin(context.Context, *StartOIDCLoginRequest) (*StartOIDCLoginResponse, error)
+	// CompleteOIDCLogin 使用身份提供方返回的授权码完成单点登录，不需要认证
+	CompleteOIDCLogin(context.Context, *CompleteOIDCLoginRequest) (*CompleteOIDCLoginResponse, error)
+	// ListPolicies 列出授权策略，可以按角色或者用户过滤（仅管理员）
+	ListPolicies(context.Context, *ListPoliciesRequest) (*ListPoliciesResponse, error)
+	// CreatePolicy 添加一条授权策略（仅管理员）
+	CreatePolicy(context.Context, *CreatePolicyRequest) (*CreatePolicyResponse, error)
+	// DeletePolicy 删除一条授权策略（仅管理员）
+	DeletePolicy(context.Context, *DeletePolicyRequest) (*DeletePolicyResponse, error)
+	// ReplacePolicies 在一个事务中将所有授权策略替换为请求中的策略（仅管理员）
+	ReplacePolicies(context.Context, *ReplacePoliciesRequest) (*ReplacePoliciesResponse, error)
+	// ListRoleAssignments 列出所有用户的角色分配（仅管理员）
+	ListRoleAssignments(context.Context, *ListRoleAssignmentsRequest) (*ListRoleAssignmentsResponse, error)
+	// AssignRole 为用户分配角色（仅管理员）
+	AssignRole(context.Context, *AssignRoleRequest) (*AssignRoleResponse, error)
+	// UnassignRole 移除用户的角色（仅管理员）
+	UnassignRole(context.Context, *UnassignRoleRequest) (*UnassignRoleResponse, error)
+	// ListUserRoles 列出用户拥有的角色（仅管理员）
+	ListUserRoles(context.Context, *ListUserRolesRequest) (*ListUserRolesResponse, error)
+	// ListRoleUsers 列出拥有某个角色的用户（仅管理员）
+	ListRoleUsers(context.Context, *ListRoleUsersRequest) (*ListRoleUsersResponse, error)
+	mustEmbedUnimplementedMiniBlogServer()
+}
+
+// UnimplementedMiniBlogServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMiniBlogServer struct{}
+
+func (UnimplementedMiniBlogServer) Healthz(context.Context, *emptypb.Empty) (*HealthzResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Healthz not implemented")
+}
+func (UnimplementedMiniBlogServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedMiniBlogServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedMiniBlogServer) Logout(context.Context, *LogoutRequest) (*LogoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedMiniBlogServer) ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedMiniBlogServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedMiniBlogServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedMiniBlogServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedMiniBlogServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedMiniBlogServer) ListUser(context.Context, *ListUserRequest) (*ListUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUser not implemented")
+}
+func (UnimplementedMiniBlogServer) CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePost not implemented")
+}
+func (UnimplementedMiniBlogServer) UpdatePost(context.Context, *UpdatePostRequest) (*UpdatePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePost not implemented")
+}
+func (UnimplementedMiniBlogServer) DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePost not implemented")
+}
+func (UnimplementedMiniBlogServer) GetPost(context.Context, *GetPostRequest) (*GetPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPost not implemented")
+}
+func (UnimplementedMiniBlogServer) ListPost(context.Context, *ListPostRequest) (*ListPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPost not implemented")
+}
+func (UnimplementedMiniBlogServer) AddReaction(context.Context, *AddReactionRequest) (*AddReactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddReaction not implemented")
+}
+func (UnimplementedMiniBlogServer) RemoveReaction(context.Context, *RemoveReactionRequest) (*RemoveReactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveReaction not implemented")
+}
+func (UnimplementedMiniBlogServer) ListReactors(context.Context, *ListReactorsRequest) (*ListReactorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReactors not implemented")
+}
+func (UnimplementedMiniBlogServer) AddBookmark(context.Context, *AddBookmarkRequest) (*AddBookmarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBookmark not implemented")
+}
+func (UnimplementedMiniBlogServer) RemoveBookmark(context.Context, *RemoveBookmarkRequest) (*RemoveBookmarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBookmark not implemented")
+}
+func (UnimplementedMiniBlogServer) ListBookmark(context.Context, *ListBookmarkRequest) (*ListBookmarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBookmark not implemented")
+}
+func (UnimplementedMiniBlogServer) ReorderBookmarks(context.Context, *ReorderBookmarksRequest) (*ReorderBookmarksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderBookmarks not implemented")
+}
+func (UnimplementedMiniBlogServer) ListReadingList(context.Context, *ListReadingListRequest) (*ListReadingListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReadingList not implemented")
+}
+func (UnimplementedMiniBlogServer) ListMentions(context.Context, *ListMentionsRequest) (*ListMentionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMentions not implemented")
+}
+func (UnimplementedMiniBlogServer) ListRelatedPosts(context.Context, *ListRelatedPostsRequest) (*ListRelatedPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRelatedPosts not implemented")
+}
+func (UnimplementedMiniBlogServer) PinPost(context.Context, *PinPostRequest) (*PinPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinPost not implemented")
+}
+func (UnimplementedMiniBlogServer) UnpinPost(context.Context, *UnpinPostRequest) (*UnpinPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinPost not implemented")
+}
+func (UnimplementedMiniBlogServer) FeaturePost(context.Context, *FeaturePostRequest) (*FeaturePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeaturePost not implemented")
+}
+func (UnimplementedMiniBlogServer) UnfeaturePost(context.Context, *UnfeaturePostRequest) (*UnfeaturePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfeaturePost not implemented")
+}
+func (UnimplementedMiniBlogServer) ListPostActivity(context.Context, *ListPostActivityRequest) (*ListPostActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPostActivity not implemented")
+}
+func (UnimplementedMiniBlogServer) ListTopAuthors(context.Context, *ListTopAuthorsRequest) (*ListTopAuthorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTopAuthors not implemented")
+}
+func (UnimplementedMiniBlogServer) ListSiteStats(context.Context, *ListSiteStatsRequest) (*ListSiteStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSiteStats not implemented")
+}
+func (UnimplementedMiniBlogServer) GetMyPostStats(context.Context, *GetMyPostStatsRequest) (*GetMyPostStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyPostStats not implemented")
+}
+func (UnimplementedMiniBlogServer) CreatePostTranslation(context.Context, *CreatePostTranslationRequest) (*CreatePostTranslationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePostTranslation not implemented")
+}
+func (UnimplementedMiniBlogServer) UpdatePostTranslation(context.Context, *UpdatePostTranslationRequest) (*UpdatePostTranslationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePostTranslation not implemented")
+}
+func (UnimplementedMiniBlogServer) DeletePostTranslation(context.Context, *DeletePostTranslationRequest) (*DeletePostTranslationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePostTranslation not implemented")
+}
+func (UnimplementedMiniBlogServer) CreatePostTemplate(context.Context, *CreatePostTemplateRequest) (*CreatePostTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePostTemplate not implemented")
+}
+func (UnimplementedMiniBlogServer) UpdatePostTemplate(context.Context, *UpdatePostTemplateRequest) (*UpdatePostTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePostTemplate not implemented")
+}
+func (UnimplementedMiniBlogServer) DeletePostTemplate(context.Context, *DeletePostTemplateRequest) (*DeletePostTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePostTemplate not implemented")
+}
+func (UnimplementedMiniBlogServer) GetPostTemplate(context.Context, *GetPostTemplateRequest) (*GetPostTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostTemplate not implemented")
+}
+func (UnimplementedMiniBlogServer) ListPostTemplate(context.Context, *ListPostTemplateRequest) (*ListPostTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPostTemplate not implemented")
+}
+func (UnimplementedMiniBlogServer) CreatePostFromTemplate(context.Context, *CreatePostFromTemplateRequest) (*CreatePostFromTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePostFromTemplate not implemented")
+}
+func (UnimplementedMiniBlogServer) CreatePreviewLink(context.Context, *CreatePreviewLinkRequest) (*CreatePreviewLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePreviewLink not implemented")
+}
+func (UnimplementedMiniBlogServer) ListPreviewLinks(context.Context, *ListPreviewLinksRequest) (*ListPreviewLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPreviewLinks not implemented")
+}
+func (UnimplementedMiniBlogServer) RevokePreviewLink(context.Context, *RevokePreviewLinkRequest) (*RevokePreviewLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokePreviewLink not implemented")
+}
+func (UnimplementedMiniBlogServer) GetPreview(context.Context, *GetPreviewRequest) (*GetPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPreview not implemented")
+}
+func (UnimplementedMiniBlogServer) SendVerificationEmail(context.Context, *SendVerificationEmailRequest) (*SendVerificationEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendVerificationEmail not implemented")
+}
+func (UnimplementedMiniBlogServer) VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
+}
+func (UnimplementedMiniBlogServer) RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedMiniBlogServer) ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (UnimplementedMiniBlogServer) UnlockUser(context.Context, *UnlockUserRequest) (*UnlockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockUser not implemented")
+}
+func (UnimplementedMiniBlogServer) EnrollTwoFactor(context.Context, *EnrollTwoFactorRequest) (*EnrollTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTwoFactor not implemented")
+}
+func (UnimplementedMiniBlogServer) ConfirmTwoFactor(context.Context, *ConfirmTwoFactorRequest) (*ConfirmTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTwoFactor not implemented")
+}
+func (UnimplementedMiniBlogServer) VerifyTwoFactor(context.Context, *VerifyTwoFactorRequest) (*VerifyTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyTwoFactor not implemented")
+}
+func (UnimplementedMiniBlogServer) ResetTwoFactor(context.Context, *ResetTwoFactorRequest) (*ResetTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetTwoFactor not implemented")
+}
+func (UnimplementedMiniBlogServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedMiniBlogServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedMiniBlogServer) RevokeAllOtherSessions(context.Context, *RevokeAllOtherSessionsRequest) (*RevokeAllOtherSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllOtherSessions not implemented")
+}
+func (UnimplementedMiniBlogServer) CreateAccessToken(context.Context, *CreateAccessTokenRequest) (*CreateAccessTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccessToken not implemented")
+}
+func (UnimplementedMiniBlogServer) ListAccessTokens(context.Context, *ListAccessTokensRequest) (*ListAccessTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccessTokens not implemented")
+}
+func (UnimplementedMiniBlogServer) RevokeAccessToken(context.Context, *RevokeAccessTokenRequest) (*RevokeAccessTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAccessToken not implemented")
+}
+func (UnimplementedMiniBlogServer) StartOIDCLogin(context.Context, *StartOIDCLoginRequest) (*StartOIDCLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartOIDCLogin not implemented")
+}
+func (UnimplementedMiniBlogServer) CompleteOIDCLogin(context.Context, *CompleteOIDCLoginRequest) (*CompleteOIDCLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteOIDCLogin not implemented")
+}
+func (UnimplementedMiniBlogServer) ListPolicies(context.Context, *ListPoliciesRequest) (*ListPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPolicies not implemented")
+}
+func (UnimplementedMiniBlogServer) CreatePolicy(context.Context, *CreatePolicyRequest) (*CreatePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePolicy not implemented")
+}
+func (UnimplementedMiniBlogServer) DeletePolicy(context.Context, *DeletePolicyRequest) (*DeletePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePolicy not implemented")
+}
+func (UnimplementedMiniBlogServer) ReplacePolicies(context.Context, *ReplacePoliciesRequest) (*ReplacePoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplacePolicies not implemented")
+}
+func (UnimplementedMiniBlogServer) ListRoleAssignments(context.Context, *ListRoleAssignmentsRequest) (*ListRoleAssignmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoleAssignments not implemented")
+}
+func (UnimplementedMiniBlogServer) AssignRole(context.Context, *AssignRoleRequest) (*AssignRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignRole not implemented")
+}
+func (UnimplementedMiniBlogServer) UnassignRole(context.Context, *UnassignRoleRequest) (*UnassignRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnassignRole not implemented")
+}
+func (UnimplementedMiniBlogServer) ListUserRoles(context.Context, *ListUserRolesRequest) (*ListUserRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserRoles not implemented")
+}
+func (UnimplementedMiniBlogServer) ListRoleUsers(context.Context, *ListRoleUsersRequest) (*ListRoleUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoleUsers not implemented")
+}
+func (UnimplementedMiniBlogServer) mustEmbedUnimplementedMiniBlogServer() {}
+func (UnimplementedMiniBlogServer) testEmbeddedByValue()                  {}
+
+// UnsafeMiniBlogServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MiniBlogServer will
+// result in compilation errors.
+type UnsafeMiniBlogServer interface {
+	mustEmbedUnimplementedMiniBlogServer()
+}
+
+func RegisterMiniBlogServer(s grpc.ServiceRegistrar, srv MiniBlogServer) {
+	// If the following call pancis, it indicates UnimplementedMiniBlogServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MiniBlog_ServiceDesc, srv)
+}
+
+func _MiniBlog_Healthz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).Healthz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_Healthz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).Healthz(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_ListUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).ListUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_ListUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).ListUser(ctx, req.(*ListUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).CreatePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_CreatePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).CreatePost(ctx, req.(*CreatePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_UpdatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).UpdatePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_UpdatePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).UpdatePost(ctx, req.(*UpdatePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).DeletePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_DeletePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).DeletePost(ctx, req.(*DeletePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_GetPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).GetPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_GetPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).GetPost(ctx, req.(*GetPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_ListPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).ListPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_ListPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).ListPost(ctx, req.(*ListPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_AddReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).AddReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_AddReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).AddReaction(ctx, req.(*AddReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_RemoveReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).RemoveReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_RemoveReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).RemoveReaction(ctx, req.(*RemoveReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_ListReactors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReactorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).ListReactors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_ListReactors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).ListReactors(ctx, req.(*ListReactorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_AddBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBookmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).AddBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_AddBookmark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).AddBookmark(ctx, req.(*AddBookmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_RemoveBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveBookmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).RemoveBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_RemoveBookmark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).RemoveBookmark(ctx, req.(*RemoveBookmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_ListBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBookmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).ListBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_ListBookmark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).ListBookmark(ctx, req.(*ListBookmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_ReorderBookmarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderBookmarksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).ReorderBookmarks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_ReorderBookmarks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).ReorderBookmarks(ctx, req.(*ReorderBookmarksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_ListReadingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReadingListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).ListReadingList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_ListReadingList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).ListReadingList(ctx, req.(*ListReadingListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_ListMentions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMentionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).ListMentions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_ListMentions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).ListMentions(ctx, req.(*ListMentionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_ListRelatedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRelatedPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).ListRelatedPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_ListRelatedPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).ListRelatedPosts(ctx, req.(*ListRelatedPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_PinPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).PinPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_PinPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).PinPost(ctx, req.(*PinPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_UnpinPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).UnpinPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_UnpinPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).UnpinPost(ctx, req.(*UnpinPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_FeaturePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeaturePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).FeaturePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_FeaturePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).FeaturePost(ctx, req.(*FeaturePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_UnfeaturePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfeaturePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).UnfeaturePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_UnfeaturePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).UnfeaturePost(ctx, req.(*UnfeaturePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_ListPostActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPostActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).ListPostActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_ListPostActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).ListPostActivity(ctx, req.(*ListPostActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_ListTopAuthors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTopAuthorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).ListTopAuthors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_ListTopAuthors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).ListTopAuthors(ctx, req.(*ListTopAuthorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_ListSiteStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSiteStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).ListSiteStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_ListSiteStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).ListSiteStats(ctx, req.(*ListSiteStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_GetMyPostStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyPostStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).GetMyPostStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_GetMyPostStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).GetMyPostStats(ctx, req.(*GetMyPostStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_CreatePostTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePostTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).CreatePostTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_CreatePostTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).CreatePostTranslation(ctx, req.(*CreatePostTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_UpdatePostTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePostTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).UpdatePostTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_UpdatePostTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).UpdatePostTranslation(ctx, req.(*UpdatePostTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_DeletePostTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePostTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).DeletePostTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_DeletePostTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).DeletePostTranslation(ctx, req.(*DeletePostTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_CreatePostTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePostTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).CreatePostTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_CreatePostTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).CreatePostTemplate(ctx, req.(*CreatePostTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_UpdatePostTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePostTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).UpdatePostTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_UpdatePostTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).UpdatePostTemplate(ctx, req.(*UpdatePostTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_DeletePostTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePostTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).DeletePostTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_DeletePostTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).DeletePostTemplate(ctx, req.(*DeletePostTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_GetPostTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).GetPostTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_GetPostTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).GetPostTemplate(ctx, req.(*GetPostTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_ListPostTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPostTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).ListPostTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_ListPostTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).ListPostTemplate(ctx, req.(*ListPostTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_CreatePostFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePostFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).CreatePostFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_CreatePostFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).CreatePostFromTemplate(ctx, req.(*CreatePostFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_CreatePreviewLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePreviewLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).CreatePreviewLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_CreatePreviewLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).CreatePreviewLink(ctx, req.(*CreatePreviewLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_ListPreviewLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPreviewLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).ListPreviewLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_ListPreviewLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).ListPreviewLinks(ctx, req.(*ListPreviewLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_RevokePreviewLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokePreviewLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).RevokePreviewLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_RevokePreviewLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).RevokePreviewLink(ctx, req.(*RevokePreviewLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_GetPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).GetPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_GetPreview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).GetPreview(ctx, req.(*GetPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_SendVerificationEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendVerificationEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).SendVerificationEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_SendVerificationEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).SendVerificationEmail(ctx, req.(*SendVerificationEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_VerifyEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).VerifyEmail(ctx, req.(*VerifyEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_RequestPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_ResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_UnlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).UnlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_UnlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).UnlockUser(ctx, req.(*UnlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_EnrollTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).EnrollTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_EnrollTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).EnrollTwoFactor(ctx, req.(*EnrollTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_ConfirmTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).ConfirmTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_ConfirmTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).ConfirmTwoFactor(ctx, req.(*ConfirmTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_VerifyTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).VerifyTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_VerifyTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).VerifyTwoFactor(ctx, req.(*VerifyTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMiniBlogServer) GetPost(context.Context, *GetPostRequest) (*GetPostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPost not implemented")
+
+func _MiniBlog_ResetTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).ResetTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_ResetTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).ResetTwoFactor(ctx, req.(*ResetTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMiniBlogServer) ListPost(context.Context, *ListPostRequest) (*ListPostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPost not implemented")
+
+func _MiniBlog_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMiniBlogServer) mustEmbedUnimplementedMiniBlogServer() {}
-func (UnimplementedMiniBlogServer) testEmbeddedByValue()                  {}
 
-// UnsafeMiniBlogServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to MiniBlogServer will
-// result in compilation errors.
-type UnsafeMiniBlogServer interface {
-	mustEmbedUnimplementedMiniBlogServer()
+func _MiniBlog_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterMiniBlogServer(s grpc.ServiceRegistrar, srv MiniBlogServer) {
-	// If the following call pancis, it indicates UnimplementedMiniBlogServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _MiniBlog_RevokeAllOtherSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAllOtherSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&MiniBlog_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(MiniBlogServer).RevokeAllOtherSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_RevokeAllOtherSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).RevokeAllOtherSessions(ctx, req.(*RevokeAllOtherSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _MiniBlog_Healthz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _MiniBlog_CreateAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccessTokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MiniBlogServer).Healthz(ctx, in)
+		return srv.(MiniBlogServer).CreateAccessToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MiniBlog_Healthz_FullMethodName,
+		FullMethod: MiniBlog_CreateAccessToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MiniBlogServer).Healthz(ctx, req.(*emptypb.Empty))
+		return srv.(MiniBlogServer).CreateAccessToken(ctx, req.(*CreateAccessTokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MiniBlog_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _MiniBlog_ListAccessTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccessTokensRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MiniBlogServer).Login(ctx, in)
+		return srv.(MiniBlogServer).ListAccessTokens(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MiniBlog_Login_FullMethodName,
+		FullMethod: MiniBlog_ListAccessTokens_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MiniBlogServer).Login(ctx, req.(*LoginRequest))
+		return srv.(MiniBlogServer).ListAccessTokens(ctx, req.(*ListAccessTokensRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MiniBlog_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RefreshTokenRequest)
+func _MiniBlog_RevokeAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAccessTokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MiniBlogServer).RefreshToken(ctx, in)
+		return srv.(MiniBlogServer).RevokeAccessToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MiniBlog_RefreshToken_FullMethodName,
+		FullMethod: MiniBlog_RevokeAccessToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MiniBlogServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+		return srv.(MiniBlogServer).RevokeAccessToken(ctx, req.(*RevokeAccessTokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MiniBlog_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangePasswordRequest)
+func _MiniBlog_StartOIDCLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartOIDCLoginRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MiniBlogServer).ChangePassword(ctx, in)
+		return srv.(MiniBlogServer).StartOIDCLogin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MiniBlog_ChangePassword_FullMethodName,
+		FullMethod: MiniBlog_StartOIDCLogin_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MiniBlogServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+		return srv.(MiniBlogServer).StartOIDCLogin(ctx, req.(*StartOIDCLoginRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MiniBlog_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserRequest)
+func _MiniBlog_CompleteOIDCLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteOIDCLoginRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MiniBlogServer).CreateUser(ctx, in)
+		return srv.(MiniBlogServer).CompleteOIDCLogin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MiniBlog_CreateUser_FullMethodName,
+		FullMethod: MiniBlog_CompleteOIDCLogin_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MiniBlogServer).CreateUser(ctx, req.(*CreateUserRequest))
+		return srv.(MiniBlogServer).CompleteOIDCLogin(ctx, req.(*CompleteOIDCLoginRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MiniBlog_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserRequest)
+func _MiniBlog_ListPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPoliciesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MiniBlogServer).UpdateUser(ctx, in)
+		return srv.(MiniBlogServer).ListPolicies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MiniBlog_UpdateUser_FullMethodName,
+		FullMethod: MiniBlog_ListPolicies_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MiniBlogServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+		return srv.(MiniBlogServer).ListPolicies(ctx, req.(*ListPoliciesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MiniBlog_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteUserRequest)
+func _MiniBlog_CreatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MiniBlogServer).DeleteUser(ctx, in)
+		return srv.(MiniBlogServer).CreatePolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MiniBlog_DeleteUser_FullMethodName,
+		FullMethod: MiniBlog_CreatePolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MiniBlogServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+		return srv.(MiniBlogServer).CreatePolicy(ctx, req.(*CreatePolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MiniBlog_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserRequest)
+func _MiniBlog_DeletePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MiniBlogServer).GetUser(ctx, in)
+		return srv.(MiniBlogServer).DeletePolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MiniBlog_GetUser_FullMethodName,
+		FullMethod: MiniBlog_DeletePolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MiniBlogServer).GetUser(ctx, req.(*GetUserRequest))
+		return srv.(MiniBlogServer).DeletePolicy(ctx, req.(*DeletePolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MiniBlog_ListUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListUserRequest)
+func _MiniBlog_ReplacePolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplacePoliciesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MiniBlogServer).ListUser(ctx, in)
+		return srv.(MiniBlogServer).ReplacePolicies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MiniBlog_ListUser_FullMethodName,
+		FullMethod: MiniBlog_ReplacePolicies_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MiniBlogServer).ListUser(ctx, req.(*ListUserRequest))
+		return srv.(MiniBlogServer).ReplacePolicies(ctx, req.(*ReplacePoliciesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MiniBlog_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePostRequest)
+func _MiniBlog_ListRoleAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoleAssignmentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MiniBlogServer).CreatePost(ctx, in)
+		return srv.(MiniBlogServer).ListRoleAssignments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MiniBlog_CreatePost_FullMethodName,
+		FullMethod: MiniBlog_ListRoleAssignments_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MiniBlogServer).CreatePost(ctx, req.(*CreatePostRequest))
+		return srv.(MiniBlogServer).ListRoleAssignments(ctx, req.(*ListRoleAssignmentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MiniBlog_UpdatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdatePostRequest)
+func _MiniBlog_AssignRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MiniBlogServer).UpdatePost(ctx, in)
+		return srv.(MiniBlogServer).AssignRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MiniBlog_UpdatePost_FullMethodName,
+		FullMethod: MiniBlog_AssignRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MiniBlogServer).UpdatePost(ctx, req.(*UpdatePostRequest))
+		return srv.(MiniBlogServer).AssignRole(ctx, req.(*AssignRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MiniBlog_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeletePostRequest)
+func _MiniBlog_UnassignRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnassignRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MiniBlogServer).DeletePost(ctx, in)
+		return srv.(MiniBlogServer).UnassignRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MiniBlog_DeletePost_FullMethodName,
+		FullMethod: MiniBlog_UnassignRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MiniBlogServer).DeletePost(ctx, req.(*DeletePostRequest))
+		return srv.(MiniBlogServer).UnassignRole(ctx, req.(*UnassignRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MiniBlog_GetPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPostRequest)
+func _MiniBlog_ListUserRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserRolesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MiniBlogServer).GetPost(ctx, in)
+		return srv.(MiniBlogServer).ListUserRoles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MiniBlog_GetPost_FullMethodName,
+		FullMethod: MiniBlog_ListUserRoles_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MiniBlogServer).GetPost(ctx, req.(*GetPostRequest))
+		return srv.(MiniBlogServer).ListUserRoles(ctx, req.(*ListUserRolesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MiniBlog_ListPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPostRequest)
+func _MiniBlog_ListRoleUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoleUsersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MiniBlogServer).ListPost(ctx, in)
+		return srv.(MiniBlogServer).ListRoleUsers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MiniBlog_ListPost_FullMethodName,
+		FullMethod: MiniBlog_ListRoleUsers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MiniBlogServer).ListPost(ctx, req.(*ListPostRequest))
+		return srv.(MiniBlogServer).ListRoleUsers(ctx, req.(*ListRoleUsersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -605,6 +2693,10 @@ var MiniBlog_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RefreshToken",
 			Handler:    _MiniBlog_RefreshToken_Handler,
 		},
+		{
+			MethodName: "Logout",
+			Handler:    _MiniBlog_Logout_Handler,
+		},
 		{
 			MethodName: "ChangePassword",
 			Handler:    _MiniBlog_ChangePassword_Handler,
@@ -649,6 +2741,234 @@ var MiniBlog_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPost",
 			Handler:    _MiniBlog_ListPost_Handler,
 		},
+		{
+			MethodName: "AddReaction",
+			Handler:    _MiniBlog_AddReaction_Handler,
+		},
+		{
+			MethodName: "RemoveReaction",
+			Handler:    _MiniBlog_RemoveReaction_Handler,
+		},
+		{
+			MethodName: "ListReactors",
+			Handler:    _MiniBlog_ListReactors_Handler,
+		},
+		{
+			MethodName: "AddBookmark",
+			Handler:    _MiniBlog_AddBookmark_Handler,
+		},
+		{
+			MethodName: "RemoveBookmark",
+			Handler:    _MiniBlog_RemoveBookmark_Handler,
+		},
+		{
+			MethodName: "ListBookmark",
+			Handler:    _MiniBlog_ListBookmark_Handler,
+		},
+		{
+			MethodName: "ReorderBookmarks",
+			Handler:    _MiniBlog_ReorderBookmarks_Handler,
+		},
+		{
+			MethodName: "ListReadingList",
+			Handler:    _MiniBlog_ListReadingList_Handler,
+		},
+		{
+			MethodName: "ListMentions",
+			Handler:    _MiniBlog_ListMentions_Handler,
+		},
+		{
+			MethodName: "ListRelatedPosts",
+			Handler:    _MiniBlog_ListRelatedPosts_Handler,
+		},
+		{
+			MethodName: "PinPost",
+			Handler:    _MiniBlog_PinPost_Handler,
+		},
+		{
+			MethodName: "UnpinPost",
+			Handler:    _MiniBlog_UnpinPost_Handler,
+		},
+		{
+			MethodName: "FeaturePost",
+			Handler:    _MiniBlog_FeaturePost_Handler,
+		},
+		{
+			MethodName: "UnfeaturePost",
+			Handler:    _MiniBlog_UnfeaturePost_Handler,
+		},
+		{
+			MethodName: "ListPostActivity",
+			Handler:    _MiniBlog_ListPostActivity_Handler,
+		},
+		{
+			MethodName: "ListTopAuthors",
+			Handler:    _MiniBlog_ListTopAuthors_Handler,
+		},
+		{
+			MethodName: "ListSiteStats",
+			Handler:    _MiniBlog_ListSiteStats_Handler,
+		},
+		{
+			MethodName: "GetMyPostStats",
+			Handler:    _MiniBlog_GetMyPostStats_Handler,
+		},
+		{
+			MethodName: "CreatePostTranslation",
+			Handler:    _MiniBlog_CreatePostTranslation_Handler,
+		},
+		{
+			MethodName: "UpdatePostTranslation",
+			Handler:    _MiniBlog_UpdatePostTranslation_Handler,
+		},
+		{
+			MethodName: "DeletePostTranslation",
+			Handler:    _MiniBlog_DeletePostTranslation_Handler,
+		},
+		{
+			MethodName: "CreatePostTemplate",
+			Handler:    _MiniBlog_CreatePostTemplate_Handler,
+		},
+		{
+			MethodName: "UpdatePostTemplate",
+			Handler:    _MiniBlog_UpdatePostTemplate_Handler,
+		},
+		{
+			MethodName: "DeletePostTemplate",
+			Handler:    _MiniBlog_DeletePostTemplate_Handler,
+		},
+		{
+			MethodName: "GetPostTemplate",
+			Handler:    _MiniBlog_GetPostTemplate_Handler,
+		},
+		{
+			MethodName: "ListPostTemplate",
+			Handler:    _MiniBlog_ListPostTemplate_Handler,
+		},
+		{
+			MethodName: "CreatePostFromTemplate",
+			Handler:    _MiniBlog_CreatePostFromTemplate_Handler,
+		},
+		{
+			MethodName: "CreatePreviewLink",
+			Handler:    _MiniBlog_CreatePreviewLink_Handler,
+		},
+		{
+			MethodName: "ListPreviewLinks",
+			Handler:    _MiniBlog_ListPreviewLinks_Handler,
+		},
+		{
+			MethodName: "RevokePreviewLink",
+			Handler:    _MiniBlog_RevokePreviewLink_Handler,
+		},
+		{
+			MethodName: "GetPreview",
+			Handler:    _MiniBlog_GetPreview_Handler,
+		},
+		{
+			MethodName: "SendVerificationEmail",
+			Handler:    _MiniBlog_SendVerificationEmail_Handler,
+		},
+		{
+			MethodName: "VerifyEmail",
+			Handler:    _MiniBlog_VerifyEmail_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _MiniBlog_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _MiniBlog_ResetPassword_Handler,
+		},
+		{
+			MethodName: "UnlockUser",
+			Handler:    _MiniBlog_UnlockUser_Handler,
+		},
+		{
+			MethodName: "EnrollTwoFactor",
+			Handler:    _MiniBlog_EnrollTwoFactor_Handler,
+		},
+		{
+			MethodName: "ConfirmTwoFactor",
+			Handler:    _MiniBlog_ConfirmTwoFactor_Handler,
+		},
+		{
+			MethodName: "VerifyTwoFactor",
+			Handler:    _MiniBlog_VerifyTwoFactor_Handler,
+		},
+		{
+			MethodName: "ResetTwoFactor",
+			Handler:    _MiniBlog_ResetTwoFactor_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _MiniBlog_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _MiniBlog_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeAllOtherSessions",
+			Handler:    _MiniBlog_RevokeAllOtherSessions_Handler,
+		},
+		{
+			MethodName: "CreateAccessToken",
+			Handler:    _MiniBlog_CreateAccessToken_Handler,
+		},
+		{
+			MethodName: "ListAccessTokens",
+			Handler:    _MiniBlog_ListAccessTokens_Handler,
+		},
+		{
+			MethodName: "RevokeAccessToken",
+			Handler:    _MiniBlog_RevokeAccessToken_Handler,
+		},
+		{
+			MethodName: "StartOIDCLogin",
+			Handler:    _MiniBlog_StartOIDCLogin_Handler,
+		},
+		{
+			MethodName: "CompleteOIDCLogin",
+			Handler:    _MiniBlog_CompleteOIDCLogin_Handler,
+		},
+		{
+			MethodName: "ListPolicies",
+			Handler:    _MiniBlog_ListPolicies_Handler,
+		},
+		{
+			MethodName: "CreatePolicy",
+			Handler:    _MiniBlog_CreatePolicy_Handler,
+		},
+		{
+			MethodName: "DeletePolicy",
+			Handler:    _MiniBlog_DeletePolicy_Handler,
+		},
+		{
+			MethodName: "ReplacePolicies",
+			Handler:    _MiniBlog_ReplacePolicies_Handler,
+		},
+		{
+			MethodName: "ListRoleAssignments",
+			Handler:    _MiniBlog_ListRoleAssignments_Handler,
+		},
+		{
+			MethodName: "AssignRole",
+			Handler:    _MiniBlog_AssignRole_Handler,
+		},
+		{
+			MethodName: "UnassignRole",
+			Handler:    _MiniBlog_UnassignRole_Handler,
+		},
+		{
+			MethodName: "ListUserRoles",
+			Handler:    _MiniBlog_ListUserRoles_Handler,
+		},
+		{
+			MethodName: "ListRoleUsers",
+			Handler:    _MiniBlog_ListRoleUsers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "apiserver/v1/apiserver.proto",
@@ -19,6 +19,9 @@ var (
 func (x *Post) Default() {
 }
 
+func (x *Mention) Default() {
+}
+
 func (x *CreatePostRequest) Default() {
 }
 
@@ -48,3 +51,57 @@ func (x *ListPostRequest) Default() {
 
 func (x *ListPostResponse) Default() {
 }
+
+func (x *ListMentionsRequest) Default() {
+}
+
+func (x *ListMentionsResponse) Default() {
+}
+
+func (x *ListRelatedPostsRequest) Default() {
+}
+
+func (x *ListRelatedPostsResponse) Default() {
+}
+
+func (x *PinPostRequest) Default() {
+}
+
+func (x *PinPostResponse) Default() {
+}
+
+func (x *UnpinPostRequest) Default() {
+}
+
+func (x *UnpinPostResponse) Default() {
+}
+
+func (x *FeaturePostRequest) Default() {
+}
+
+func (x *FeaturePostResponse) Default() {
+}
+
+func (x *UnfeaturePostRequest) Default() {
+}
+
+func (x *UnfeaturePostResponse) Default() {
+}
+
+func (x *CreatePostTranslationRequest) Default() {
+}
+
+func (x *CreatePostTranslationResponse) Default() {
+}
+
+func (x *UpdatePostTranslationRequest) Default() {
+}
+
+func (x *UpdatePostTranslationResponse) Default() {
+}
+
+func (x *DeletePostTranslationRequest) Default() {
+}
+
+func (x *DeletePostTranslationResponse) Default() {
+}
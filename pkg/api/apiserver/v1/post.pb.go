@@ -41,6 +41,28 @@ type Post struct {
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	// updatedAt 表示博客最后更新时间
 	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	// reactionCounts 表示各类反应的数量，键为反应类型
+	ReactionCounts map[string]int64 `protobuf:"bytes,7,rep,name=reactionCounts,proto3" json:"reactionCounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// bookmarked 表示当前用户是否收藏了该博客
+	Bookmarked bool `protobuf:"varint,8,opt,name=bookmarked,proto3" json:"bookmarked,omitempty"`
+	// mentions 表示博客内容中提及的用户，不存在的用户不会出现在这里
+	Mentions []*Mention `protobuf:"bytes,9,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	// pinned 表示博客是否被作者置顶
+	Pinned bool `protobuf:"varint,10,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	// featured 表示博客是否被管理员设为精选
+	Featured bool `protobuf:"varint,11,opt,name=featured,proto3" json:"featured,omitempty"`
+	// wordCount 表示博客字数，中文和日文按字计数，其他文字按单词计数
+	WordCount int64 `protobuf:"varint,12,opt,name=wordCount,proto3" json:"wordCount,omitempty"`
+	// readingTime 表示预计阅读时间，单位为分钟
+	ReadingTime int64 `protobuf:"varint,13,opt,name=readingTime,proto3" json:"readingTime,omitempty"`
+	// excerpt 表示去掉 Markdown 语法后的纯文本摘要
+	Excerpt string `protobuf:"bytes,14,opt,name=excerpt,proto3" json:"excerpt,omitempty"`
+	// locale 表示返回的标题和内容所使用的语言
+	Locale string `protobuf:"bytes,15,opt,name=locale,proto3" json:"locale,omitempty"`
+	// defaultLocale 表示博客的默认语言，即创建博客时使用的语言
+	DefaultLocale string `protobuf:"bytes,16,opt,name=defaultLocale,proto3" json:"defaultLocale,omitempty"`
+	// availableLocales 表示博客所有可用的语言，包括默认语言和所有译文的语言
+	AvailableLocales []string `protobuf:"bytes,17,rep,name=availableLocales,proto3" json:"availableLocales,omitempty"`
 }
 
 func (x *Post) Reset() {
@@ -115,6 +137,157 @@ func (x *Post) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Post) GetReactionCounts() map[string]int64 {
+	if x != nil {
+		return x.ReactionCounts
+	}
+	return nil
+}
+
+func (x *Post) GetBookmarked() bool {
+	if x != nil {
+		return x.Bookmarked
+	}
+	return false
+}
+
+func (x *Post) GetMentions() []*Mention {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *Post) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *Post) GetFeatured() bool {
+	if x != nil {
+		return x.Featured
+	}
+	return false
+}
+
+func (x *Post) GetWordCount() int64 {
+	if x != nil {
+		return x.WordCount
+	}
+	return 0
+}
+
+func (x *Post) GetReadingTime() int64 {
+	if x != nil {
+		return x.ReadingTime
+	}
+	return 0
+}
+
+func (x *Post) GetExcerpt() string {
+	if x != nil {
+		return x.Excerpt
+	}
+	return ""
+}
+
+func (x *Post) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Post) GetDefaultLocale() string {
+	if x != nil {
+		return x.DefaultLocale
+	}
+	return ""
+}
+
+func (x *Post) GetAvailableLocales() []string {
+	if x != nil {
+		return x.AvailableLocales
+	}
+	return nil
+}
+
+// Mention 表示博客内容中对用户的一次提及（@username）
+type Mention struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// userID 表示被提及的用户 ID
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	// username 表示被提及的用户名
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// start 表示提及在博客内容中的起始偏移量（以 Unicode 码点计算，包含 @ 字符）
+	Start int64 `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	// end 表示提及在博客内容中的结束偏移量（不包含）
+	End int64 `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *Mention) Reset() {
+	*x = Mention{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mention) ProtoMessage() {}
+
+func (x *Mention) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mention.ProtoReflect.Descriptor instead.
+func (*Mention) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Mention) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *Mention) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Mention) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Mention) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
 // CreatePostRequest 表示创建文章请求
 type CreatePostRequest struct {
 	state         protoimpl.MessageState
@@ -125,11 +298,13 @@ type CreatePostRequest struct {
 	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
 	// content 表示博客内容
 	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// locale 表示博客的默认语言，即标题和内容所使用的语言，不指定时使用服务端配置的默认语言
+	Locale *string `protobuf:"bytes,3,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
 }
 
 func (x *CreatePostRequest) Reset() {
 	*x = CreatePostRequest{}
-	mi := &file_apiserver_v1_post_proto_msgTypes[1]
+	mi := &file_apiserver_v1_post_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -141,7 +316,7 @@ func (x *CreatePostRequest) String() string {
 func (*CreatePostRequest) ProtoMessage() {}
 
 func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_post_proto_msgTypes[1]
+	mi := &file_apiserver_v1_post_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -154,7 +329,7 @@ func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
 func (*CreatePostRequest) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{1}
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreatePostRequest) GetTitle() string {
@@ -171,6 +346,13 @@ func (x *CreatePostRequest) GetContent() string {
 	return ""
 }
 
+func (x *CreatePostRequest) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
 // CreatePostResponse 表示创建文章响应
 type CreatePostResponse struct {
 	state         protoimpl.MessageState
@@ -179,11 +361,13 @@ type CreatePostResponse struct {
 
 	// postID 表示创建的文章 ID
 	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
+	// duplicateOf 表示和新文章重复的已有文章 ID，仅在重复检测策略为 warn 时返回
+	DuplicateOf []string `protobuf:"bytes,2,rep,name=duplicateOf,proto3" json:"duplicateOf,omitempty"`
 }
 
 func (x *CreatePostResponse) Reset() {
 	*x = CreatePostResponse{}
-	mi := &file_apiserver_v1_post_proto_msgTypes[2]
+	mi := &file_apiserver_v1_post_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -195,7 +379,7 @@ func (x *CreatePostResponse) String() string {
 func (*CreatePostResponse) ProtoMessage() {}
 
 func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_post_proto_msgTypes[2]
+	mi := &file_apiserver_v1_post_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -208,7 +392,7 @@ func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePostResponse.ProtoReflect.Descriptor instead.
 func (*CreatePostResponse) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{2}
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreatePostResponse) GetPostID() string {
@@ -218,6 +402,13 @@ func (x *CreatePostResponse) GetPostID() string {
 	return ""
 }
 
+func (x *CreatePostResponse) GetDuplicateOf() []string {
+	if x != nil {
+		return x.DuplicateOf
+	}
+	return nil
+}
+
 // UpdatePostRequest 表示更新文章请求
 type UpdatePostRequest struct {
 	state         protoimpl.MessageState
@@ -230,11 +421,13 @@ type UpdatePostRequest struct {
 	Title *string `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
 	// content 表示更新后的博客内容
 	Content *string `protobuf:"bytes,3,opt,name=content,proto3,oneof" json:"content,omitempty"`
+	// locale 表示更新后的博客默认语言，不能和已有译文的语言相同
+	Locale *string `protobuf:"bytes,4,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
 }
 
 func (x *UpdatePostRequest) Reset() {
 	*x = UpdatePostRequest{}
-	mi := &file_apiserver_v1_post_proto_msgTypes[3]
+	mi := &file_apiserver_v1_post_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -246,7 +439,7 @@ func (x *UpdatePostRequest) String() string {
 func (*UpdatePostRequest) ProtoMessage() {}
 
 func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_post_proto_msgTypes[3]
+	mi := &file_apiserver_v1_post_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -259,7 +452,7 @@ func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePostRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePostRequest) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{3}
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *UpdatePostRequest) GetPostID() string {
@@ -283,6 +476,13 @@ func (x *UpdatePostRequest) GetContent() string {
 	return ""
 }
 
+func (x *UpdatePostRequest) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
 // UpdatePostResponse 表示更新文章响应
 type UpdatePostResponse struct {
 	state         protoimpl.MessageState
@@ -292,7 +492,7 @@ type UpdatePostResponse struct {
 
 func (x *UpdatePostResponse) Reset() {
 	*x = UpdatePostResponse{}
-	mi := &file_apiserver_v1_post_proto_msgTypes[4]
+	mi := &file_apiserver_v1_post_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -304,7 +504,7 @@ func (x *UpdatePostResponse) String() string {
 func (*UpdatePostResponse) ProtoMessage() {}
 
 func (x *UpdatePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_post_proto_msgTypes[4]
+	mi := &file_apiserver_v1_post_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -317,7 +517,7 @@ func (x *UpdatePostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePostResponse.ProtoReflect.Descriptor instead.
 func (*UpdatePostResponse) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{4}
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{5}
 }
 
 // DeletePostRequest 表示删除文章请求
@@ -332,7 +532,7 @@ type DeletePostRequest struct {
 
 func (x *DeletePostRequest) Reset() {
 	*x = DeletePostRequest{}
-	mi := &file_apiserver_v1_post_proto_msgTypes[5]
+	mi := &file_apiserver_v1_post_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -344,7 +544,7 @@ func (x *DeletePostRequest) String() string {
 func (*DeletePostRequest) ProtoMessage() {}
 
 func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_post_proto_msgTypes[5]
+	mi := &file_apiserver_v1_post_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -357,7 +557,7 @@ func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
 func (*DeletePostRequest) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{5}
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DeletePostRequest) GetPostIDs() []string {
@@ -376,7 +576,7 @@ type DeletePostResponse struct {
 
 func (x *DeletePostResponse) Reset() {
 	*x = DeletePostResponse{}
-	mi := &file_apiserver_v1_post_proto_msgTypes[6]
+	mi := &file_apiserver_v1_post_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -388,7 +588,7 @@ func (x *DeletePostResponse) String() string {
 func (*DeletePostResponse) ProtoMessage() {}
 
 func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_post_proto_msgTypes[6]
+	mi := &file_apiserver_v1_post_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -401,7 +601,7 @@ func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
 func (*DeletePostResponse) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{6}
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{7}
 }
 
 // GetPostRequest 表示获取文章请求
@@ -413,11 +613,15 @@ type GetPostRequest struct {
 	// postID 表示要获取的文章 ID
 	// @gotags: uri:"postID"
 	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty" uri:"postID"`
+	// locale 表示期望的语言，可以是单个语言标签，也可以是 Accept-Language 格式的语言列表。
+	// 不指定时使用 HTTP 请求的 Accept-Language 请求头，都没有时返回默认语言的版本
+	// @gotags: form:"locale"
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty" form:"locale"`
 }
 
 func (x *GetPostRequest) Reset() {
 	*x = GetPostRequest{}
-	mi := &file_apiserver_v1_post_proto_msgTypes[7]
+	mi := &file_apiserver_v1_post_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -429,7 +633,7 @@ func (x *GetPostRequest) String() string {
 func (*GetPostRequest) ProtoMessage() {}
 
 func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_post_proto_msgTypes[7]
+	mi := &file_apiserver_v1_post_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -442,7 +646,7 @@ func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPostRequest.ProtoReflect.Descriptor instead.
 func (*GetPostRequest) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{7}
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetPostRequest) GetPostID() string {
@@ -452,6 +656,13 @@ func (x *GetPostRequest) GetPostID() string {
 	return ""
 }
 
+func (x *GetPostRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
 // GetPostResponse 表示获取文章响应
 type GetPostResponse struct {
 	state         protoimpl.MessageState
@@ -464,7 +675,7 @@ type GetPostResponse struct {
 
 func (x *GetPostResponse) Reset() {
 	*x = GetPostResponse{}
-	mi := &file_apiserver_v1_post_proto_msgTypes[8]
+	mi := &file_apiserver_v1_post_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -476,7 +687,7 @@ func (x *GetPostResponse) String() string {
 func (*GetPostResponse) ProtoMessage() {}
 
 func (x *GetPostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_post_proto_msgTypes[8]
+	mi := &file_apiserver_v1_post_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -489,7 +700,7 @@ func (x *GetPostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPostResponse.ProtoReflect.Descriptor instead.
 func (*GetPostResponse) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{8}
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetPostResponse) GetPost() *Post {
@@ -513,11 +724,20 @@ type ListPostRequest struct {
 	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty" form:"limit"`
 	// title 表示可选的标题过滤
 	Title *string `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	// pinnedFirst 表示是否将置顶的博客排在最前面
+	// @gotags: form:"pinnedFirst"
+	PinnedFirst bool `protobuf:"varint,4,opt,name=pinnedFirst,proto3" json:"pinnedFirst,omitempty" form:"pinnedFirst"`
+	// omitContent 表示是否不返回博客内容，只返回摘要，适用于列表页
+	// @gotags: form:"omitContent"
+	OmitContent bool `protobuf:"varint,5,opt,name=omitContent,proto3" json:"omitContent,omitempty" form:"omitContent"`
+	// locale 表示只列出有该语言版本的文章，返回的标题和内容使用该语言
+	// @gotags: form:"locale"
+	Locale *string `protobuf:"bytes,6,opt,name=locale,proto3,oneof" json:"locale,omitempty" form:"locale"`
 }
 
 func (x *ListPostRequest) Reset() {
 	*x = ListPostRequest{}
-	mi := &file_apiserver_v1_post_proto_msgTypes[9]
+	mi := &file_apiserver_v1_post_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -529,7 +749,7 @@ func (x *ListPostRequest) String() string {
 func (*ListPostRequest) ProtoMessage() {}
 
 func (x *ListPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_post_proto_msgTypes[9]
+	mi := &file_apiserver_v1_post_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -542,7 +762,7 @@ func (x *ListPostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPostRequest.ProtoReflect.Descriptor instead.
 func (*ListPostRequest) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{9}
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListPostRequest) GetOffset() int64 {
@@ -566,6 +786,27 @@ func (x *ListPostRequest) GetTitle() string {
 	return ""
 }
 
+func (x *ListPostRequest) GetPinnedFirst() bool {
+	if x != nil {
+		return x.PinnedFirst
+	}
+	return false
+}
+
+func (x *ListPostRequest) GetOmitContent() bool {
+	if x != nil {
+		return x.OmitContent
+	}
+	return false
+}
+
+func (x *ListPostRequest) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
 // ListPostResponse 表示获取文章列表响应
 type ListPostResponse struct {
 	state         protoimpl.MessageState
@@ -580,7 +821,7 @@ type ListPostResponse struct {
 
 func (x *ListPostResponse) Reset() {
 	*x = ListPostResponse{}
-	mi := &file_apiserver_v1_post_proto_msgTypes[10]
+	mi := &file_apiserver_v1_post_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -592,7 +833,7 @@ func (x *ListPostResponse) String() string {
 func (*ListPostResponse) ProtoMessage() {}
 
 func (x *ListPostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_post_proto_msgTypes[10]
+	mi := &file_apiserver_v1_post_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -605,7 +846,7 @@ func (x *ListPostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPostResponse.ProtoReflect.Descriptor instead.
 func (*ListPostResponse) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{10}
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListPostResponse) GetTotalCount() int64 {
@@ -622,14 +863,913 @@ func (x *ListPostResponse) GetPosts() []*Post {
 	return nil
 }
 
+// ListMentionsRequest 表示获取提及当前用户的文章列表请求
+type ListMentionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// offset 表示偏移量
+	// @gotags: form:"offset"
+	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty" form:"offset"`
+	// limit 表示每页数量
+	// @gotags: form:"limit"
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty" form:"limit"`
+}
+
+func (x *ListMentionsRequest) Reset() {
+	*x = ListMentionsRequest{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMentionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMentionsRequest) ProtoMessage() {}
+
+func (x *ListMentionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMentionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMentionsRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListMentionsRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListMentionsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListMentionsResponse 表示获取提及当前用户的文章列表响应
+type ListMentionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// total_count 表示总文章数
+	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// posts 表示文章列表，按最近提及时间倒序排列
+	Posts []*Post `protobuf:"bytes,2,rep,name=posts,proto3" json:"posts,omitempty"`
+}
+
+func (x *ListMentionsResponse) Reset() {
+	*x = ListMentionsResponse{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMentionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMentionsResponse) ProtoMessage() {}
+
+func (x *ListMentionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMentionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMentionsResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListMentionsResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListMentionsResponse) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+// ListRelatedPostsRequest 表示获取相关文章列表请求
+type ListRelatedPostsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// postID 表示文章 ID
+	// @gotags: uri:"postID"
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty" uri:"postID"`
+	// limit 表示返回的最大数量，不指定时返回 5 篇
+	// @gotags: form:"limit"
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty" form:"limit"`
+}
+
+func (x *ListRelatedPostsRequest) Reset() {
+	*x = ListRelatedPostsRequest{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRelatedPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRelatedPostsRequest) ProtoMessage() {}
+
+func (x *ListRelatedPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRelatedPostsRequest.ProtoReflect.Descriptor instead.
+func (*ListRelatedPostsRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListRelatedPostsRequest) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *ListRelatedPostsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListRelatedPostsResponse 表示获取相关文章列表响应
+type ListRelatedPostsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// posts 表示相关文章列表，按相关度从高到低排列
+	Posts []*Post `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+}
+
+func (x *ListRelatedPostsResponse) Reset() {
+	*x = ListRelatedPostsResponse{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRelatedPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRelatedPostsResponse) ProtoMessage() {}
+
+func (x *ListRelatedPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRelatedPostsResponse.ProtoReflect.Descriptor instead.
+func (*ListRelatedPostsResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListRelatedPostsResponse) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+// PinPostRequest 表示置顶文章请求
+type PinPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// postID 表示要置顶的文章 ID
+	// @gotags: uri:"postID"
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty" uri:"postID"`
+	// position 表示置顶后的位置，从 0 开始，不指定时排在已置顶文章的最后。
+	// 对已置顶的文章再次置顶可以调整其位置
+	Position *int64 `protobuf:"varint,2,opt,name=position,proto3,oneof" json:"position,omitempty"`
+}
+
+func (x *PinPostRequest) Reset() {
+	*x = PinPostRequest{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinPostRequest) ProtoMessage() {}
+
+func (x *PinPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinPostRequest.ProtoReflect.Descriptor instead.
+func (*PinPostRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PinPostRequest) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *PinPostRequest) GetPosition() int64 {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return 0
+}
+
+// PinPostResponse 表示置顶文章响应
+type PinPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PinPostResponse) Reset() {
+	*x = PinPostResponse{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinPostResponse) ProtoMessage() {}
+
+func (x *PinPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinPostResponse.ProtoReflect.Descriptor instead.
+func (*PinPostResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{17}
+}
+
+// UnpinPostRequest 表示取消置顶文章请求
+type UnpinPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// postID 表示要取消置顶的文章 ID
+	// @gotags: uri:"postID"
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty" uri:"postID"`
+}
+
+func (x *UnpinPostRequest) Reset() {
+	*x = UnpinPostRequest{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpinPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinPostRequest) ProtoMessage() {}
+
+func (x *UnpinPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinPostRequest.ProtoReflect.Descriptor instead.
+func (*UnpinPostRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UnpinPostRequest) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+// UnpinPostResponse 表示取消置顶文章响应
+type UnpinPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnpinPostResponse) Reset() {
+	*x = UnpinPostResponse{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpinPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinPostResponse) ProtoMessage() {}
+
+func (x *UnpinPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinPostResponse.ProtoReflect.Descriptor instead.
+func (*UnpinPostResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{19}
+}
+
+// FeaturePostRequest 表示精选文章请求
+type FeaturePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// postID 表示要设为精选的文章 ID
+	// @gotags: uri:"postID"
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty" uri:"postID"`
+	// position 表示精选后的位置，从 0 开始，不指定时排在已精选文章的最后。
+	// 对已精选的文章再次精选可以调整其位置
+	Position *int64 `protobuf:"varint,2,opt,name=position,proto3,oneof" json:"position,omitempty"`
+}
+
+func (x *FeaturePostRequest) Reset() {
+	*x = FeaturePostRequest{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeaturePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeaturePostRequest) ProtoMessage() {}
+
+func (x *FeaturePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeaturePostRequest.ProtoReflect.Descriptor instead.
+func (*FeaturePostRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *FeaturePostRequest) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *FeaturePostRequest) GetPosition() int64 {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return 0
+}
+
+// FeaturePostResponse 表示精选文章响应
+type FeaturePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FeaturePostResponse) Reset() {
+	*x = FeaturePostResponse{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeaturePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeaturePostResponse) ProtoMessage() {}
+
+func (x *FeaturePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeaturePostResponse.ProtoReflect.Descriptor instead.
+func (*FeaturePostResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{21}
+}
+
+// UnfeaturePostRequest 表示取消精选文章请求
+type UnfeaturePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// postID 表示要取消精选的文章 ID
+	// @gotags: uri:"postID"
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty" uri:"postID"`
+}
+
+func (x *UnfeaturePostRequest) Reset() {
+	*x = UnfeaturePostRequest{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfeaturePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfeaturePostRequest) ProtoMessage() {}
+
+func (x *UnfeaturePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfeaturePostRequest.ProtoReflect.Descriptor instead.
+func (*UnfeaturePostRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UnfeaturePostRequest) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+// UnfeaturePostResponse 表示取消精选文章响应
+type UnfeaturePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnfeaturePostResponse) Reset() {
+	*x = UnfeaturePostResponse{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfeaturePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfeaturePostResponse) ProtoMessage() {}
+
+func (x *UnfeaturePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfeaturePostResponse.ProtoReflect.Descriptor instead.
+func (*UnfeaturePostResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{23}
+}
+
+// CreatePostTranslationRequest 表示创建文章译文请求
+type CreatePostTranslationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// postID 表示文章 ID
+	// @gotags: uri:"postID"
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty" uri:"postID"`
+	// locale 表示译文的语言，不能和文章的默认语言相同
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	// title 表示译文标题
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// content 表示译文内容
+	Content string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *CreatePostTranslationRequest) Reset() {
+	*x = CreatePostTranslationRequest{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePostTranslationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePostTranslationRequest) ProtoMessage() {}
+
+func (x *CreatePostTranslationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePostTranslationRequest.ProtoReflect.Descriptor instead.
+func (*CreatePostTranslationRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreatePostTranslationRequest) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *CreatePostTranslationRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *CreatePostTranslationRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreatePostTranslationRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// CreatePostTranslationResponse 表示创建文章译文响应
+type CreatePostTranslationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CreatePostTranslationResponse) Reset() {
+	*x = CreatePostTranslationResponse{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePostTranslationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePostTranslationResponse) ProtoMessage() {}
+
+func (x *CreatePostTranslationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePostTranslationResponse.ProtoReflect.Descriptor instead.
+func (*CreatePostTranslationResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{25}
+}
+
+// UpdatePostTranslationRequest 表示更新文章译文请求
+type UpdatePostTranslationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// postID 表示文章 ID
+	// @gotags: uri:"postID"
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty" uri:"postID"`
+	// locale 表示要更新的译文语言
+	// @gotags: uri:"locale"
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty" uri:"locale"`
+	// title 表示更新后的译文标题
+	Title *string `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	// content 表示更新后的译文内容
+	Content *string `protobuf:"bytes,4,opt,name=content,proto3,oneof" json:"content,omitempty"`
+}
+
+func (x *UpdatePostTranslationRequest) Reset() {
+	*x = UpdatePostTranslationRequest{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePostTranslationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePostTranslationRequest) ProtoMessage() {}
+
+func (x *UpdatePostTranslationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePostTranslationRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePostTranslationRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdatePostTranslationRequest) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *UpdatePostTranslationRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *UpdatePostTranslationRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdatePostTranslationRequest) GetContent() string {
+	if x != nil && x.Content != nil {
+		return *x.Content
+	}
+	return ""
+}
+
+// UpdatePostTranslationResponse 表示更新文章译文响应
+type UpdatePostTranslationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdatePostTranslationResponse) Reset() {
+	*x = UpdatePostTranslationResponse{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePostTranslationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePostTranslationResponse) ProtoMessage() {}
+
+func (x *UpdatePostTranslationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePostTranslationResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePostTranslationResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{27}
+}
+
+// DeletePostTranslationRequest 表示删除文章译文请求
+type DeletePostTranslationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// postID 表示文章 ID
+	// @gotags: uri:"postID"
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty" uri:"postID"`
+	// locale 表示要删除的译文语言
+	// @gotags: uri:"locale"
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty" uri:"locale"`
+}
+
+func (x *DeletePostTranslationRequest) Reset() {
+	*x = DeletePostTranslationRequest{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostTranslationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostTranslationRequest) ProtoMessage() {}
+
+func (x *DeletePostTranslationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostTranslationRequest.ProtoReflect.Descriptor instead.
+func (*DeletePostTranslationRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeletePostTranslationRequest) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *DeletePostTranslationRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+// DeletePostTranslationResponse 表示删除文章译文响应
+type DeletePostTranslationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeletePostTranslationResponse) Reset() {
+	*x = DeletePostTranslationResponse{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostTranslationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostTranslationResponse) ProtoMessage() {}
+
+func (x *DeletePostTranslationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostTranslationResponse.ProtoReflect.Descriptor instead.
+func (*DeletePostTranslationResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{29}
+}
+
 var File_apiserver_v1_post_proto protoreflect.FileDescriptor
 
 var file_apiserver_v1_post_proto_rawDesc = []byte{
 	0x0a, 0x17, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x70,
 	0x6f, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x76, 0x31, 0x1a, 0x1f, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xda,
-	0x01, 0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa4,
+	0x05, 0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
 	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12,
 	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
@@ -642,49 +1782,169 @@ var file_apiserver_v1_post_proto_rawDesc = []byte{
 	0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x43, 0x0a, 0x11, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x44, 0x0a, 0x0e, 0x72,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0e, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x64, 0x12, 0x27, 0x0a, 0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x69,
+	0x6e, 0x6e, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x69, 0x6e, 0x6e,
+	0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x78, 0x63, 0x65, 0x72, 0x70, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x65, 0x78, 0x63, 0x65, 0x72, 0x70, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65,
+	0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x73, 0x1a, 0x41, 0x0a, 0x13, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x65, 0x0a, 0x07, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x6b, 0x0a, 0x11,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x12, 0x1b, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x4e, 0x0a, 0x12, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x75, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x75,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x22, 0xa3, 0x01, 0x0a, 0x11, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x19, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x02, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22,
+	0x14, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6f,
+	0x73, 0x74, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x73,
+	0x74, 0x49, 0x44, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f,
+	0x73, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x2f, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1c, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0xd0, 0x01,
+	0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x19, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x69,
+	0x6e, 0x6e, 0x65, 0x64, 0x46, 0x69, 0x72, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x46, 0x69, 0x72, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x6f, 0x6d, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x6f, 0x6d, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1b,
+	0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65,
+	0x22, 0x53, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05,
+	0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x43, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x57, 0x0a, 0x14, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f,
+	0x73, 0x74, 0x73, 0x22, 0x47, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x3a, 0x0a, 0x18,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x56, 0x0a, 0x0e, 0x50, 0x69, 0x6e, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f,
+	0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74,
+	0x49, 0x44, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x11, 0x0a, 0x0f, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2a, 0x0a, 0x10, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x22,
+	0x13, 0x0a, 0x11, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5a, 0x0a, 0x12, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f,
+	0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74,
+	0x49, 0x44, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x15, 0x0a, 0x13, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x0a, 0x14, 0x55, 0x6e, 0x66, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x22, 0x17, 0x0a, 0x15, 0x55, 0x6e, 0x66, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x7e, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x22, 0x2c, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x22, 0x7b,
-	0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x22, 0x1f, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x9e, 0x01, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x65, 0x12, 0x19, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06,
+	0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x22, 0x1f, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x4e, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x19, 0x0a, 0x05, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x74, 0x69,
-	0x74, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x42,
-	0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x2d, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x73,
-	0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44,
-	0x22, 0x2f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73,
-	0x74, 0x22, 0x64, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x12, 0x19, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a,
-	0x06, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x53, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x50,
-	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x05,
-	0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x32, 0x5a, 0x30,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x61, 0x31, 0x6e, 0x36,
-	0x6f, 0x77, 0x2f, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x65, 0x22, 0x1f, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x72, 0x61, 0x31, 0x6e, 0x36, 0x6f, 0x77, 0x2f, 0x6d, 0x69, 0x6e, 0x69, 0x62,
+	0x6c, 0x6f, 0x67, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x69, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -699,31 +1959,55 @@ func file_apiserver_v1_post_proto_rawDescGZIP() []byte {
 	return file_apiserver_v1_post_proto_rawDescData
 }
 
-var file_apiserver_v1_post_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_apiserver_v1_post_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_apiserver_v1_post_proto_goTypes = []any{
-	(*Post)(nil),                  // 0: v1.Post
-	(*CreatePostRequest)(nil),     // 1: v1.CreatePostRequest
-	(*CreatePostResponse)(nil),    // 2: v1.CreatePostResponse
-	(*UpdatePostRequest)(nil),     // 3: v1.UpdatePostRequest
-	(*UpdatePostResponse)(nil),    // 4: v1.UpdatePostResponse
-	(*DeletePostRequest)(nil),     // 5: v1.DeletePostRequest
-	(*DeletePostResponse)(nil),    // 6: v1.DeletePostResponse
-	(*GetPostRequest)(nil),        // 7: v1.GetPostRequest
-	(*GetPostResponse)(nil),       // 8: v1.GetPostResponse
-	(*ListPostRequest)(nil),       // 9: v1.ListPostRequest
-	(*ListPostResponse)(nil),      // 10: v1.ListPostResponse
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(*Post)(nil),                          // 0: v1.Post
+	(*Mention)(nil),                       // 1: v1.Mention
+	(*CreatePostRequest)(nil),             // 2: v1.CreatePostRequest
+	(*CreatePostResponse)(nil),            // 3: v1.CreatePostResponse
+	(*UpdatePostRequest)(nil),             // 4: v1.UpdatePostRequest
+	(*UpdatePostResponse)(nil),            // 5: v1.UpdatePostResponse
+	(*DeletePostRequest)(nil),             // 6: v1.DeletePostRequest
+	(*DeletePostResponse)(nil),            // 7: v1.DeletePostResponse
+	(*GetPostRequest)(nil),                // 8: v1.GetPostRequest
+	(*GetPostResponse)(nil),               // 9: v1.GetPostResponse
+	(*ListPostRequest)(nil),               // 10: v1.ListPostRequest
+	(*ListPostResponse)(nil),              // 11: v1.ListPostResponse
+	(*ListMentionsRequest)(nil),           // 12: v1.ListMentionsRequest
+	(*ListMentionsResponse)(nil),          // 13: v1.ListMentionsResponse
+	(*ListRelatedPostsRequest)(nil),       // 14: v1.ListRelatedPostsRequest
+	(*ListRelatedPostsResponse)(nil),      // 15: v1.ListRelatedPostsResponse
+	(*PinPostRequest)(nil),                // 16: v1.PinPostRequest
+	(*PinPostResponse)(nil),               // 17: v1.PinPostResponse
+	(*UnpinPostRequest)(nil),              // 18: v1.UnpinPostRequest
+	(*UnpinPostResponse)(nil),             // 19: v1.UnpinPostResponse
+	(*FeaturePostRequest)(nil),            // 20: v1.FeaturePostRequest
+	(*FeaturePostResponse)(nil),           // 21: v1.FeaturePostResponse
+	(*UnfeaturePostRequest)(nil),          // 22: v1.UnfeaturePostRequest
+	(*UnfeaturePostResponse)(nil),         // 23: v1.UnfeaturePostResponse
+	(*CreatePostTranslationRequest)(nil),  // 24: v1.CreatePostTranslationRequest
+	(*CreatePostTranslationResponse)(nil), // 25: v1.CreatePostTranslationResponse
+	(*UpdatePostTranslationRequest)(nil),  // 26: v1.UpdatePostTranslationRequest
+	(*UpdatePostTranslationResponse)(nil), // 27: v1.UpdatePostTranslationResponse
+	(*DeletePostTranslationRequest)(nil),  // 28: v1.DeletePostTranslationRequest
+	(*DeletePostTranslationResponse)(nil), // 29: v1.DeletePostTranslationResponse
+	nil,                                   // 30: v1.Post.ReactionCountsEntry
+	(*timestamppb.Timestamp)(nil),         // 31: google.protobuf.Timestamp
 }
 var file_apiserver_v1_post_proto_depIdxs = []int32{
-	11, // 0: v1.Post.createdAt:type_name -> google.protobuf.Timestamp
-	11, // 1: v1.Post.updatedAt:type_name -> google.protobuf.Timestamp
-	0,  // 2: v1.GetPostResponse.post:type_name -> v1.Post
-	0,  // 3: v1.ListPostResponse.posts:type_name -> v1.Post
-	4,  // [4:4] is the sub-list for method output_type
-	4,  // [4:4] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	31, // 0: v1.Post.createdAt:type_name -> google.protobuf.Timestamp
+	31, // 1: v1.Post.updatedAt:type_name -> google.protobuf.Timestamp
+	30, // 2: v1.Post.reactionCounts:type_name -> v1.Post.ReactionCountsEntry
+	1,  // 3: v1.Post.mentions:type_name -> v1.Mention
+	0,  // 4: v1.GetPostResponse.post:type_name -> v1.Post
+	0,  // 5: v1.ListPostResponse.posts:type_name -> v1.Post
+	0,  // 6: v1.ListMentionsResponse.posts:type_name -> v1.Post
+	0,  // 7: v1.ListRelatedPostsResponse.posts:type_name -> v1.Post
+	8,  // [8:8] is the sub-list for method output_type
+	8,  // [8:8] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_apiserver_v1_post_proto_init() }
@@ -731,15 +2015,19 @@ func file_apiserver_v1_post_proto_init() {
 	if File_apiserver_v1_post_proto != nil {
 		return
 	}
-	file_apiserver_v1_post_proto_msgTypes[3].OneofWrappers = []any{}
-	file_apiserver_v1_post_proto_msgTypes[9].OneofWrappers = []any{}
+	file_apiserver_v1_post_proto_msgTypes[2].OneofWrappers = []any{}
+	file_apiserver_v1_post_proto_msgTypes[4].OneofWrappers = []any{}
+	file_apiserver_v1_post_proto_msgTypes[10].OneofWrappers = []any{}
+	file_apiserver_v1_post_proto_msgTypes[16].OneofWrappers = []any{}
+	file_apiserver_v1_post_proto_msgTypes[20].OneofWrappers = []any{}
+	file_apiserver_v1_post_proto_msgTypes[26].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_apiserver_v1_post_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
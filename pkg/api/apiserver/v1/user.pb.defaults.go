@@ -31,6 +31,12 @@ func (x *RefreshTokenRequest) Default() {
 func (x *RefreshTokenResponse) Default() {
 }
 
+func (x *LogoutRequest) Default() {
+}
+
+func (x *LogoutResponse) Default() {
+}
+
 func (x *ChangePasswordRequest) Default() {
 }
 
@@ -70,3 +76,33 @@ func (x *ListUserRequest) Default() {
 
 func (x *ListUserResponse) Default() {
 }
+
+func (x *SendVerificationEmailRequest) Default() {
+}
+
+func (x *SendVerificationEmailResponse) Default() {
+}
+
+func (x *VerifyEmailRequest) Default() {
+}
+
+func (x *VerifyEmailResponse) Default() {
+}
+
+func (x *RequestPasswordResetRequest) Default() {
+}
+
+func (x *RequestPasswordResetResponse) Default() {
+}
+
+func (x *ResetPasswordRequest) Default() {
+}
+
+func (x *ResetPasswordResponse) Default() {
+}
+
+func (x *UnlockUserRequest) Default() {
+}
+
+func (x *UnlockUserResponse) Default() {
+}
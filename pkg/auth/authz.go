@@ -122,3 +122,13 @@ func (a *Authz) Authorize(sub, obj, act string) (bool, error) {
 	// 调用 Enforce 方法进行授权检查
 	return a.Enforce(sub, obj, act)
 }
+
+// ReplacePolicies 将所有授权策略替换为 rules，删除旧策略和写入新策略在同一个数据库事务中完成.
+// 角色分配不受影响. 替换完成后重新加载策略，保证内存中的策略和数据库一致.
+func (a *Authz) ReplacePolicies(rules [][]string) error {
+	// 不指定过滤条件时匹配所有策略
+	if _, err := a.UpdateFilteredPolicies(rules, 0); err != nil {
+		return err
+	}
+	return a.LoadPolicy()
+}
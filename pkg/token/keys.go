@@ -0,0 +1,250 @@
+package token
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"slices"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+)
+
+// minRSAKeyBits 为 RSA 密钥的最小长度.
+const minRSAKeyBits = 2048
+
+// Key 为签发 token 使用的非对称密钥. 密钥从 NotBefore 开始用于签名，直到下一个密钥开始签名为止.
+type Key struct {
+	// ID 为密钥的唯一标识，签发的 token 头部中的 kid 为该值.
+	ID string
+	// Algorithm 为密钥使用的签名算法：RS256、ES256 或 EdDSA，由密钥类型决定.
+	Algorithm string
+	// NotBefore 为密钥开始用于签名的时间.
+	NotBefore time.Time
+
+	signer crypto.Signer
+}
+
+// NewKey 使用私钥创建签名密钥. 支持 2048 位及以上的 RSA 密钥、P-256 曲线的 ECDSA 密钥和 Ed25519 密钥.
+func NewKey(id string, signer crypto.Signer, notBefore time.Time) (*Key, error) {
+	if id == "" {
+		return nil, errors.New("key id cannot be empty")
+	}
+
+	var alg string
+	switch k := signer.(type) {
+	case *rsa.PrivateKey:
+		if k.N.BitLen() < minRSAKeyBits {
+			return nil, fmt.Errorf("rsa key %s must be at least %d bits", id, minRSAKeyBits)
+		}
+		alg = jwt.SigningMethodRS256.Alg()
+	case *ecdsa.PrivateKey:
+		if k.Curve != elliptic.P256() {
+			return nil, fmt.Errorf("ecdsa key %s must use the P-256 curve", id)
+		}
+		alg = jwt.SigningMethodES256.Alg()
+	case ed25519.PrivateKey:
+		alg = jwt.SigningMethodEdDSA.Alg()
+	default:
+		return nil, fmt.Errorf("unsupported key type %T of key %s", signer, id)
+	}
+
+	return &Key{ID: id, Algorithm: alg, NotBefore: notBefore, signer: signer}, nil
+}
+
+// LoadKey 从 PEM 文件中加载私钥并创建签名密钥. 支持 PKCS #8、PKCS #1（RSA）和 SEC 1（ECDSA）格式.
+func LoadKey(id string, path string, notBefore time.Time) (*Key, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := ParsePrivateKeyPEM(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse key %s from %s: %w", id, path, err)
+	}
+
+	return NewKey(id, signer, notBefore)
+}
+
+// ParsePrivateKeyPEM 解析 PEM 编码的私钥.
+func ParsePrivateKeyPEM(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("unsupported private key type %T", key)
+		}
+		return signer, nil
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q", block.Type)
+	}
+}
+
+// method 返回密钥对应的 JWT 签名方法.
+func (k *Key) method() jwt.SigningMethod {
+	return jwt.GetSigningMethod(k.Algorithm)
+}
+
+// KeySet 为按时间轮换的签名密钥集合. 每个时刻使用已经生效的密钥中 NotBefore 最晚的一个签名；
+// 被替换的密钥在 overlap 时间内仍然可以用于校验 token，overlap 不应短于 token 的有效期.
+// 尚未生效的密钥会提前发布在 JWKS 中，方便其他服务在密钥生效之前更新缓存.
+type KeySet struct {
+	// keys 按 NotBefore 升序排列.
+	keys    []*Key
+	overlap time.Duration
+}
+
+// NewKeySet 创建签名密钥集合.
+func NewKeySet(overlap time.Duration, keys ...*Key) (*KeySet, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("at least one signing key is required")
+	}
+
+	sorted := slices.Clone(keys)
+	slices.SortStableFunc(sorted, func(a, b *Key) int {
+		return a.NotBefore.Compare(b.NotBefore)
+	})
+	ids := make(map[string]struct{}, len(sorted))
+	for _, k := range sorted {
+		if _, ok := ids[k.ID]; ok {
+			return nil, fmt.Errorf("duplicate key id %q", k.ID)
+		}
+		ids[k.ID] = struct{}{}
+	}
+
+	return &KeySet{keys: sorted, overlap: overlap}, nil
+}
+
+// SigningKey 返回 now 时刻用于签名的密钥，没有已经生效的密钥时返回 false.
+func (s *KeySet) SigningKey(now time.Time) (*Key, bool) {
+	for i := len(s.keys) - 1; i >= 0; i-- {
+		if !s.keys[i].NotBefore.After(now) {
+			return s.keys[i], true
+		}
+	}
+	return nil, false
+}
+
+// retiredAt 返回第 i 个密钥被下一个密钥替换的时间，最后一个密钥不会被替换.
+func (s *KeySet) retiredAt(i int) (time.Time, bool) {
+	if i == len(s.keys)-1 {
+		return time.Time{}, false
+	}
+	return s.keys[i+1].NotBefore, true
+}
+
+// expired 返回第 i 个密钥在 now 时刻是否已经超过重叠期，不能再用于校验 token.
+func (s *KeySet) expired(i int, now time.Time) bool {
+	retiredAt, ok := s.retiredAt(i)
+	return ok && !now.Before(retiredAt.Add(s.overlap))
+}
+
+// VerificationKey 返回 now 时刻可以用于校验 kid 签名的 token 的密钥.
+func (s *KeySet) VerificationKey(kid string, now time.Time) (*Key, bool) {
+	for i, k := range s.keys {
+		if k.ID != kid {
+			continue
+		}
+		if k.NotBefore.After(now) || s.expired(i, now) {
+			return nil, false
+		}
+		return k, true
+	}
+	return nil, false
+}
+
+// jsonWebKey 为 JWKS 中的一个公钥，见 RFC 7517、RFC 7518 和 RFC 8037.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Crv string `json:"crv,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// jsonWebKeySet 为 /.well-known/jwks.json 返回的公钥集合.
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+// JWKS 返回 now 时刻需要发布的公钥，包括尚未生效的密钥和仍在重叠期内的密钥.
+func (s *KeySet) JWKS(now time.Time) []byte {
+	set := jsonWebKeySet{Keys: []jsonWebKey{}}
+	// 最新的密钥排在前面
+	for i := len(s.keys) - 1; i >= 0; i-- {
+		if s.expired(i, now) {
+			continue
+		}
+		set.Keys = append(set.Keys, s.keys[i].jwk())
+	}
+
+	data, _ := json.Marshal(&set)
+	return data
+}
+
+// jwk 将密钥的公钥转换为 JWK.
+func (k *Key) jwk() jsonWebKey {
+	jwk := jsonWebKey{Kid: k.ID, Use: "sig", Alg: k.Algorithm}
+	switch pub := k.signer.Public().(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	case *ecdsa.PublicKey:
+		// 坐标需要填充为曲线的字节长度，见 RFC 7518 6.2.1.2 节
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		jwk.Kty = "EC"
+		jwk.Crv = pub.Curve.Params().Name
+		jwk.X = base64.RawURLEncoding.EncodeToString(pub.X.FillBytes(make([]byte, size)))
+		jwk.Y = base64.RawURLEncoding.EncodeToString(pub.Y.FillBytes(make([]byte, size)))
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+	}
+	return jwk
+}
+
+// JWKSHandler 返回发布包级别配置的签名公钥的 HTTP 处理器，未配置非对称密钥时返回空的公钥集合.
+func JWKSHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data := []byte(`{"keys":[]}`)
+		if config.keys != nil {
+			data = config.keys.JWKS(time.Now())
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		// 允许其他服务短时间缓存公钥，新密钥会在生效之前提前发布
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		_, _ = w.Write(data)
+	})
+}
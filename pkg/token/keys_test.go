@@ -0,0 +1,170 @@
+package token
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSigner(t *testing.T, alg string) crypto.Signer {
+	t.Helper()
+	var (
+		signer crypto.Signer
+		err    error
+	)
+	switch alg {
+	case "RS256":
+		signer, err = rsa.GenerateKey(rand.Reader, 2048)
+	case "ES256":
+		signer, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case "EdDSA":
+		_, signer, err = ed25519.GenerateKey(rand.Reader)
+	}
+	require.NoError(t, err)
+	return signer
+}
+
+// withKeys 在测试期间使用指定的密钥集合作为包级别配置.
+func withKeys(t *testing.T, key string, keys *KeySet) {
+	t.Helper()
+	saved := config
+	config.key, config.keys = key, keys
+	t.Cleanup(func() { config = saved })
+}
+
+func TestSignAndParse(t *testing.T) {
+	for _, alg := range []string{"RS256", "ES256", "EdDSA"} {
+		t.Run(alg, func(t *testing.T) {
+			key, err := NewKey("k1", newSigner(t, alg), time.Now().Add(-time.Hour))
+			require.NoError(t, err)
+			assert.Equal(t, alg, key.Algorithm)
+			ks, err := NewKeySet(time.Hour, key)
+			require.NoError(t, err)
+			withKeys(t, "", ks)
+
+			tokenString, _, err := Sign("user-1")
+			require.NoError(t, err)
+			claims, err := ParseString(tokenString)
+			require.NoError(t, err)
+			assert.Equal(t, "user-1", claims.Identity)
+
+			jwks := ks.JWKS(time.Now())
+			var set jsonWebKeySet
+			require.NoError(t, json.Unmarshal(jwks, &set))
+			require.Len(t, set.Keys, 1)
+			assert.Equal(t, "k1", set.Keys[0].Kid)
+			assert.Equal(t, alg, set.Keys[0].Alg)
+		})
+	}
+}
+
+func TestRejectWeakKeys(t *testing.T) {
+	weak, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	_, err = NewKey("weak", weak, time.Now())
+	assert.Error(t, err)
+
+	p384, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	_, err = NewKey("p384", p384, time.Now())
+	assert.Error(t, err)
+}
+
+func TestRotation(t *testing.T) {
+	now := time.Now()
+	oldKey, _ := NewKey("old", newSigner(t, "ES256"), now.Add(-48*time.Hour))
+	curKey, _ := NewKey("current", newSigner(t, "ES256"), now.Add(-30*time.Minute))
+	nextKey, _ := NewKey("next", newSigner(t, "EdDSA"), now.Add(24*time.Hour))
+	ks, err := NewKeySet(time.Hour, nextKey, oldKey, curKey)
+	require.NoError(t, err)
+
+	k, ok := ks.SigningKey(now)
+	require.True(t, ok)
+	assert.Equal(t, "current", k.ID)
+
+	// 被替换的密钥在重叠期内仍然可以校验 token，尚未生效的密钥不能校验 token
+	_, ok = ks.VerificationKey("old", now)
+	assert.True(t, ok)
+	_, ok = ks.VerificationKey("next", now)
+	assert.False(t, ok)
+	_, ok = ks.VerificationKey("old", now.Add(time.Hour))
+	assert.False(t, ok)
+
+	// 尚未生效的密钥提前发布，超过重叠期的密钥不再发布
+	var set jsonWebKeySet
+	require.NoError(t, json.Unmarshal(ks.JWKS(now.Add(time.Hour)), &set))
+	var kids []string
+	for _, k := range set.Keys {
+		kids = append(kids, k.Kid)
+	}
+	assert.Equal(t, []string{"next", "current"}, kids)
+
+	// 密钥生效后开始使用新密钥签名
+	k, ok = ks.SigningKey(now.Add(25 * time.Hour))
+	require.True(t, ok)
+	assert.Equal(t, "next", k.ID)
+
+	_, err = NewKeySet(time.Hour, oldKey, oldKey)
+	assert.Error(t, err)
+}
+
+func TestParseRejectsUnknownKeys(t *testing.T) {
+	key, _ := NewKey("k1", newSigner(t, "RS256"), time.Now().Add(-time.Hour))
+	ks, _ := NewKeySet(time.Hour, key)
+	withKeys(t, "", ks)
+
+	// 使用其他密钥签名但 kid 相同的 token
+	other := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{"identityKey": "user-1"})
+	other.Header["kid"] = "k1"
+	forged, err := other.SignedString(newSigner(t, "RS256"))
+	require.NoError(t, err)
+	_, err = ParseString(forged)
+	assert.Error(t, err)
+
+	// 未配置 HS256 密钥时不接受 HS256 token
+	hs, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"identityKey": "user-1"}).SignedString([]byte(""))
+	require.NoError(t, err)
+	_, err = ParseString(hs)
+	assert.Error(t, err)
+}
+
+func TestLoadKey(t *testing.T) {
+	dir := t.TempDir()
+
+	ecKey := newSigner(t, "ES256").(*ecdsa.PrivateKey)
+	der, err := x509.MarshalECPrivateKey(ecKey)
+	require.NoError(t, err)
+	ecPath := filepath.Join(dir, "ec.pem")
+	require.NoError(t, os.WriteFile(ecPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), 0o600))
+
+	edKey := newSigner(t, "EdDSA")
+	der, err = x509.MarshalPKCS8PrivateKey(edKey)
+	require.NoError(t, err)
+	edPath := filepath.Join(dir, "ed.pem")
+	require.NoError(t, os.WriteFile(edPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600))
+
+	key, err := LoadKey("ec", ecPath, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, "ES256", key.Algorithm)
+
+	key, err = LoadKey("ed", edPath, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, "EdDSA", key.Algorithm)
+
+	_, err = LoadKey("missing", filepath.Join(dir, "missing.pem"), time.Now())
+	assert.Error(t, err)
+}
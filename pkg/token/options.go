@@ -0,0 +1,51 @@
+package token
+
+import (
+	"fmt"
+	"time"
+)
+
+// KeyOptions 包含一个签名密钥的配置选项.
+// 轮换密钥时先添加 NotBefore 为将来时间的新密钥，新密钥会在生效之前发布在 JWKS 中，其他服务可以提前获取.
+type KeyOptions struct {
+	// ID 定义密钥的唯一标识，即 token 头部中的 kid.
+	ID string `json:"id" mapstructure:"id"`
+	// File 定义 PEM 编码的私钥文件路径，支持 RSA、ECDSA P-256 和 Ed25519 私钥.
+	File string `json:"file" mapstructure:"file"`
+	// NotBefore 定义密钥开始用于签名的时间，格式为 RFC 3339，为空表示立即生效.
+	NotBefore string `json:"not-before" mapstructure:"not-before"`
+}
+
+// Validate 校验 KeyOptions 中的选项是否合法.
+func (o *KeyOptions) Validate() []error {
+	errs := []error{}
+
+	if o.ID == "" {
+		errs = append(errs, fmt.Errorf("id of jwt signing key cannot be empty"))
+	}
+	if o.File == "" {
+		errs = append(errs, fmt.Errorf("file of jwt signing key %s cannot be empty", o.ID))
+	}
+	if _, err := o.notBefore(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid not-before of jwt signing key %s: %w", o.ID, err))
+	}
+
+	return errs
+}
+
+// Load 从私钥文件中加载签名密钥.
+func (o *KeyOptions) Load() (*Key, error) {
+	notBefore, err := o.notBefore()
+	if err != nil {
+		return nil, err
+	}
+	return LoadKey(o.ID, o.File, notBefore)
+}
+
+// notBefore 解析密钥开始用于签名的时间.
+func (o *KeyOptions) notBefore() (time.Time, error) {
+	if o.NotBefore == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, o.NotBefore)
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"sync"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	jwt "github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/auth"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -16,21 +18,41 @@ import (
 
 // Config 包括 token 包的配置选项.
 type Config struct {
-	// key 用于签发和解析 token 的密钥.
+	// key 用于签发和解析 HS256 token 的密钥. 配置了非对称密钥时只用于解析旧的 token，为空时不接受 HS256 token.
 	key string
 	// identityKey 是 token 中用户身份的键.
 	identityKey string
 	// expiration 是签发的 token 过期时间
 	expiration time.Duration
+	// keys 为签发和解析 token 的非对称密钥，为 nil 时使用 key 签发 HS256 token.
+	keys *KeySet
 }
 
 var (
-	config = Config{"Rtg8BPKNEf2mB4mgvKONGPZZQSaJWNLijxR42qRgq0iBb5", "identityKey", 2 * time.Hour}
+	config = Config{identityKey: "identityKey", expiration: 2 * time.Hour}
 	once   sync.Once // 确保配置只被初始化一次
 )
 
+// validMethods 为允许的 token 签名算法.
+var validMethods = []string{
+	jwt.SigningMethodHS256.Alg(),
+	jwt.SigningMethodRS256.Alg(),
+	jwt.SigningMethodES256.Alg(),
+	jwt.SigningMethodEdDSA.Alg(),
+}
+
+// Option 定义初始化 token 包时的可选项.
+type Option func(cfg *Config)
+
+// WithKeySet 使用非对称密钥签发和解析 token.
+func WithKeySet(keys *KeySet) Option {
+	return func(cfg *Config) {
+		cfg.keys = keys
+	}
+}
+
 // Init 设置包级别的配置 config, config 会用于本包后面的 token 签发和解析.
-func Init(key string, identityKey string, expiration time.Duration) {
+func Init(key string, identityKey string, expiration time.Duration, opts ...Option) {
 	once.Do(func() {
 		if key != "" {
 			config.key = key // 设置密钥
@@ -41,43 +63,87 @@ func Init(key string, identityKey string, expiration time.Duration) {
 		if expiration != 0 {
 			config.expiration = expiration
 		}
+		for _, opt := range opts {
+			opt(&config)
+		}
 	})
 }
 
-// Parse 使用指定的密钥 key 解析 token，解析成功返回 token 上下文，否则报错.
-func Parse(tokenString string, key string) (string, error) {
-	// 解析 token
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		// 确保 token 加密算法是预期的加密算法
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, jwt.ErrSignatureInvalid
-		}
+// Claims 为从 token 中解析出的声明.
+type Claims struct {
+	// Identity 为 token 中存放的用户身份.
+	Identity string
+	// ID 为 token 的唯一标识（jti），用于撤销单个 token. 旧版本签发的 token 中没有该字段.
+	ID string
+	// SessionID 为 token 所属的登录会话（sid），撤销会话后该会话签发的 token 全部失效.
+	SessionID string
+	// Username 为签发 token 时用户的用户名. 旧版本签发的 token 中没有该字段.
+	Username string
+	// Roles 为签发 token 时用户拥有的角色. 旧版本签发的 token 中没有该字段.
+	Roles []string
+	// IssuedAt 为 token 的签发时间，精确到毫秒. 旧版本签发的 token 只精确到秒.
+	IssuedAt time.Time
+	// ExpiresAt 为 token 的过期时间.
+	ExpiresAt time.Time
+}
 
-		return []byte(key), nil // 返回密钥
+// Parse 使用指定的密钥 key 解析 token，解析成功返回 token 中的声明，否则报错.
+// 使用非对称算法签名的 token 使用包级别配置的密钥集合校验.
+func Parse(tokenString string, key string) (*Claims, error) {
+	// 解析 token
+	parser := jwt.NewParser(jwt.WithValidMethods(validMethods))
+	token, err := parser.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		return verificationKey(token, key, time.Now())
 	})
 	// 解析失败
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+
+	mapClaims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid {
+		return nil, jwt.ErrSignatureInvalid
 	}
 
-	var identityKey string
-	// 如果解析成功，从 token 中取出 token 的主题
-	if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-		if key, exists := claims[config.identityKey]; exists {
-			if identity, valid := key.(string); valid {
-				identityKey = identity // 获取身份键
+	// 如果解析成功，从 token 中取出用户身份
+	claims := &Claims{}
+	claims.Identity, _ = mapClaims[config.identityKey].(string)
+	if claims.Identity == "" {
+		return nil, jwt.ErrSignatureInvalid
+	}
+	claims.ID, _ = mapClaims["jti"].(string)
+	claims.SessionID, _ = mapClaims["sid"].(string)
+	claims.Username, _ = mapClaims["username"].(string)
+	if roles, ok := mapClaims["roles"].([]interface{}); ok {
+		claims.Roles = make([]string, 0, len(roles))
+		for _, role := range roles {
+			if role, ok := role.(string); ok {
+				claims.Roles = append(claims.Roles, role)
 			}
 		}
 	}
-	if identityKey == "" {
-		return "", jwt.ErrSignatureInvalid
+	if iat, ok := mapClaims["iat"].(float64); ok {
+		claims.IssuedAt = time.UnixMilli(int64(math.Round(iat * 1000)))
+	}
+	if exp, ok := mapClaims["exp"].(float64); ok {
+		claims.ExpiresAt = time.Unix(int64(exp), 0)
 	}
 
-	return identityKey, nil
+	return claims, nil
 }
 
 // ParseRequest 从请求头中获取令牌，并将其传递给 Parse 函数以解析令牌.
-func ParseRequest(ctx context.Context) (string, error) {
+func ParseRequest(ctx context.Context) (*Claims, error) {
+	token, err := FromRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(token, config.key) // 解析 token
+}
+
+// FromRequest 从请求头中获取 Bearer 令牌，不对令牌做任何校验.
+func FromRequest(ctx context.Context) (string, error) {
 	var (
 		token string
 		err   error
@@ -102,27 +168,100 @@ func ParseRequest(ctx context.Context) (string, error) {
 		}
 	}
 
-	return Parse(token, config.key) // 解析 token
+	return token, nil
+}
+
+// ParseString 使用包级别配置的密钥解析 token 字符串，适用于从 Cookie 等位置获取的 token.
+func ParseString(tokenString string) (*Claims, error) {
+	return Parse(tokenString, config.key)
+}
+
+// SignOption 定义签发 token 时的可选项.
+type SignOption func(claims jwt.MapClaims)
+
+// WithSessionID 在 token 中存放登录会话 ID.
+func WithSessionID(sessionID string) SignOption {
+	return func(claims jwt.MapClaims) {
+		claims["sid"] = sessionID
+	}
+}
+
+// WithUsername 在 token 中存放用户名，认证时可直接从 token 中获取，无需查询用户.
+func WithUsername(username string) SignOption {
+	return func(claims jwt.MapClaims) {
+		claims["username"] = username
+	}
+}
+
+// WithRoles 在 token 中存放用户拥有的角色.
+func WithRoles(roles []string) SignOption {
+	return func(claims jwt.MapClaims) {
+		claims["roles"] = roles
+	}
 }
 
 // Sign 使用 jwtSecret 签发 token，token 的 claims 中会存放传入的 subject.
-func Sign(identityKey string) (string, time.Time, error) {
+func Sign(identityKey string, opts ...SignOption) (string, time.Time, error) {
 	// 计算过期时间
-	expireAt := time.Now().Add(config.expiration)
+	now := time.Now()
+	expireAt := now.Add(config.expiration)
 
 	// Token 的内容
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		config.identityKey: identityKey,       // 存放用户身份
-		"nbf":              time.Now().Unix(), // token 生效时间
-		"iat":              time.Now().Unix(), // token 签发时间
-		"exp":              expireAt.Unix(),   // token 过期时间
-	})
+	claims := jwt.MapClaims{
+		config.identityKey: identityKey,                     // 存放用户身份
+		"nbf":              now.Unix(),                      // token 生效时间
+		"iat":              float64(now.UnixMilli()) / 1000, // token 签发时间，精确到毫秒，用于和用户的令牌撤销时间比较
+		"exp":              expireAt.Unix(),                 // token 过期时间
+		"jti":              uuid.New().String(),             // token 唯一标识
+	}
+	for _, opt := range opts {
+		opt(claims)
+	}
 
 	// 签发 token
-	tokenString, err := token.SignedString([]byte(config.key))
+	tokenString, err := sign(claims, now)
 	if err != nil {
 		return "", time.Time{}, err
 	}
 
 	return tokenString, expireAt, nil // 返回 token 字符串、过期时间和错误
 }
+
+// sign 使用 now 时刻生效的密钥签名 token. 配置了非对称密钥时 token 头部中会带上密钥的 kid.
+func sign(claims jwt.MapClaims, now time.Time) (string, error) {
+	if config.keys == nil {
+		if config.key == "" {
+			return "", errors.New("no signing key configured")
+		}
+		return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(config.key))
+	}
+
+	key, ok := config.keys.SigningKey(now)
+	if !ok {
+		return "", errors.New("no signing key is active")
+	}
+	token := jwt.NewWithClaims(key.method(), claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.signer)
+}
+
+// verificationKey 返回校验 token 签名使用的密钥. HS256 token 使用 key 校验，其余 token 按 kid 在密钥集合中查找.
+func verificationKey(token *jwt.Token, key string, now time.Time) (any, error) {
+	if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok {
+		if key == "" {
+			return nil, jwt.ErrSignatureInvalid
+		}
+		return []byte(key), nil
+	}
+
+	if config.keys == nil {
+		return nil, jwt.ErrSignatureInvalid
+	}
+	kid, _ := token.Header["kid"].(string)
+	k, ok := config.keys.VerificationKey(kid, now)
+	// 确保 token 加密算法和密钥的算法一致
+	if !ok || token.Method.Alg() != k.Algorithm {
+		return nil, jwt.ErrSignatureInvalid
+	}
+	return k.signer.Public(), nil
+}
@@ -0,0 +1,57 @@
+package token
+
+import (
+	"testing"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignWithUserClaims(t *testing.T) {
+	withKeys(t, "secret", nil)
+
+	tokenString, _, err := Sign("user-1", WithSessionID("s1"), WithUsername("alice"), WithRoles([]string{"role::user"}))
+	require.NoError(t, err)
+	claims, err := ParseString(tokenString)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", claims.Username)
+	assert.Equal(t, []string{"role::user"}, claims.Roles)
+	assert.Equal(t, "s1", claims.SessionID)
+
+	// 用户没有任何角色时仍然可以和旧版本签发的 token 区分
+	tokenString, _, err = Sign("user-1", WithRoles([]string{}))
+	require.NoError(t, err)
+	claims, err = ParseString(tokenString)
+	require.NoError(t, err)
+	assert.NotNil(t, claims.Roles)
+	assert.Empty(t, claims.Roles)
+
+	// 旧版本签发的 token 中没有用户名和角色
+	legacy, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{config.identityKey: "user-1"}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+	claims, err = ParseString(legacy)
+	require.NoError(t, err)
+	assert.Empty(t, claims.Username)
+	assert.Nil(t, claims.Roles)
+}
+
+func TestIssuedAtMilliseconds(t *testing.T) {
+	withKeys(t, "secret", nil)
+
+	before := time.Now().Truncate(time.Millisecond)
+	tokenString, _, err := Sign("user-1")
+	require.NoError(t, err)
+	claims, err := ParseString(tokenString)
+	require.NoError(t, err)
+	assert.False(t, claims.IssuedAt.Before(before))
+	assert.WithinDuration(t, time.Now(), claims.IssuedAt, time.Second)
+
+	// 旧版本签发的 token 中签发时间只精确到秒
+	legacy, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{config.identityKey: "user-1", "iat": 1700000000}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+	claims, err = ParseString(legacy)
+	require.NoError(t, err)
+	assert.Equal(t, time.Unix(1700000000, 0), claims.IssuedAt)
+}
@@ -12,8 +12,8 @@ HEADER='{"alg":"HS256","typ":"JWT"}'
 # 定义Payload
 PAYLOAD='{"exp":1739078005,"iat":1735478005,"nbf":1735478005,"x-user-id":"user-w6irkg"}'
 
-# 定义Secret（用于签名）
-SECRET="Rtg8BPKNEf2mB4mgvKONGPZZQSaJWNLijxR42qRgq0iBb5"
+# 定义Secret（用于签名），需要和服务端配置的 jwt-key 一致
+SECRET="${JWT_KEY:?JWT_KEY must be set to the jwt-key configured for mb-apiserver}"
 
 # 1. Base64编码Header
 HEADER_BASE64=$(echo -n "${HEADER}" | openssl base64 | tr -d '=' | tr '/+' '_-' | tr -d '\n')